@@ -0,0 +1,304 @@
+// Package battle records every battle from the commands a reliquary.Sniffer reads: the commands from the one
+// that starts it up to its result, the lineup, the enemies, the statistics the client reports and the drops.
+//
+//	recorder := battle.NewRecorder(func(b battle.Battle) {
+//		_ = battle.WriteJSON(os.Stdout, b)
+//	})
+//	for packet := range capture.Packets {
+//		_ = recorder.Apply(packet.Commands...)
+//	}
+package battle
+
+import (
+	"encoding/json"
+	"github.com/Fesaa/go-reliquary"
+	"github.com/Fesaa/go-reliquary/internal/battleinfo"
+	"github.com/Fesaa/go-reliquary/internal/dispatch"
+	"github.com/Fesaa/go-reliquary/pb"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"io"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// MAX_BATTLES is how many finished battles a Recorder keeps
+const MAX_BATTLES = 256
+
+// Result is the BattleEndStatus of PVEBattleResultScRsp
+type Result string
+
+const (
+	// UNFINISHED battles were replaced by another one, or ended without a result
+	UNFINISHED Result = "unfinished"
+	WIN        Result = "win"
+	LOSE       Result = "lose"
+	QUIT       Result = "quit"
+)
+
+var results = map[uint32]Result{
+	1: WIN,
+	2: LOSE,
+	3: QUIT,
+}
+
+type Avatar struct {
+	Id        uint32 `json:"id"`
+	Type      uint32 `json:"type"`
+	Level     uint32 `json:"level"`
+	Promotion uint32 `json:"promotion"`
+	Rank      uint32 `json:"rank"`
+	Index     uint32 `json:"index"`
+	Hp        uint32 `json:"hp"`
+}
+
+type Wave struct {
+	StageId  uint32   `json:"stage_id"`
+	Monsters []uint32 `json:"monsters"`
+}
+
+// AvatarStats are reported by the client with the result
+type AvatarStats struct {
+	Id          uint32  `json:"id"`
+	Turns       uint32  `json:"turns"`
+	Damage      float64 `json:"damage"`
+	Healing     float64 `json:"healing"`
+	DamageTaken float64 `json:"damage_taken"`
+}
+
+type Drop struct {
+	ItemId uint32 `json:"item_id"`
+	Count  uint32 `json:"count"`
+}
+
+// Command is a command seen during a battle
+type Command struct {
+	Id      uint16          `json:"id"`
+	Name    string          `json:"name"`
+	Message json.RawMessage `json:"message,omitempty"`
+}
+
+type Battle struct {
+	Id      uint32 `json:"battle_id"`
+	StageId uint32 `json:"stage_id"`
+	// StartedBy and EndedBy are the names of the commands that started and ended the battle
+	StartedBy string    `json:"started_by"`
+	EndedBy   string    `json:"ended_by,omitempty"`
+	Start     time.Time `json:"start"`
+	End       time.Time `json:"end"`
+	// Duration is the time between the start and the end in seconds, as captured
+	Duration float64 `json:"duration"`
+	// CostTime is the time the client reports in seconds, 0 when it didn't
+	CostTime float64 `json:"cost_time,omitempty"`
+	Result   Result  `json:"result"`
+
+	Lineup []Avatar `json:"lineup"`
+	Waves  []Wave   `json:"waves"`
+
+	Turns       uint32        `json:"turns,omitempty"`
+	AutoTurns   uint32        `json:"auto_turns,omitempty"`
+	Rounds      uint32        `json:"rounds,omitempty"`
+	Ultimates   uint32        `json:"ultimates,omitempty"`
+	AvatarStats []AvatarStats `json:"avatar_stats,omitempty"`
+
+	Drops    []Drop    `json:"drops"`
+	Commands []Command `json:"commands"`
+}
+
+// Recorder groups commands into battles, safe for concurrent use
+type Recorder struct {
+	// OnBattle is called with each battle once it ends
+	OnBattle func(battle Battle)
+
+	mu      sync.RWMutex
+	current *Battle
+	battles []Battle
+	// stats holds the statistics of the PVEBattleResultCsReq until its response
+	stats *pb.PVEBattleResultCsReq
+	// finished are collected while applying, and passed to OnBattle once done
+	finished dispatch.Queue[Battle]
+}
+
+func NewRecorder(onBattle func(battle Battle)) *Recorder {
+	return &Recorder{
+		OnBattle: onBattle,
+	}
+}
+
+// Apply records the commands of the battles
+func (r *Recorder) Apply(commands ...reliquary.GameCommand) error {
+	r.mu.Lock()
+	// Every command belongs to the battle, packets without a known proto are kept without their message
+	err := dispatch.Each(commands, dispatch.All, r.apply)
+	finished := r.finished.Take()
+	r.mu.Unlock()
+
+	dispatch.Notify(finished, r.OnBattle)
+	return err
+}
+
+// apply takes a command, msg is nil when it didn't unmarshal
+func (r *Recorder) apply(command reliquary.GameCommand, msg proto.Message) {
+	if info := battleinfo.Of(msg); info != nil {
+		switch {
+		case r.current == nil:
+			r.start(command, info)
+		case r.current.Id == info.GetBattleId():
+			// The game sends the battle again when it's resumed or the lineup changes
+			r.refresh(info)
+		default:
+			r.finish(UNFINISHED, "", command.Timestamp)
+			r.start(command, info)
+		}
+	}
+	if r.current == nil {
+		return
+	}
+
+	r.current.Commands = append(r.current.Commands, newCommand(command, msg))
+	switch typed := msg.(type) {
+	case *pb.PVEBattleResultCsReq:
+		r.stats = typed
+	case *pb.PVEBattleResultScRsp:
+		r.applyResult(command, typed)
+	case *pb.QuitBattleScNotify:
+		r.finish(UNFINISHED, command.Name, command.Timestamp)
+	default:
+		if strings.HasSuffix(command.Name, "BattleEndScNotify") {
+			r.finish(UNFINISHED, command.Name, command.Timestamp)
+		}
+	}
+}
+
+func newCommand(command reliquary.GameCommand, msg proto.Message) Command {
+	c := Command{Id: command.Id, Name: command.Name}
+	if msg != nil {
+		if data, err := protojson.Marshal(msg); err == nil {
+			c.Message = data
+		}
+	}
+	return c
+}
+
+func (r *Recorder) start(command reliquary.GameCommand, info *pb.SceneBattleInfo) {
+	r.current = &Battle{
+		Id:        info.GetBattleId(),
+		StageId:   info.GetStageId(),
+		StartedBy: command.Name,
+		Start:     command.Timestamp,
+		Drops:     []Drop{},
+		Commands:  []Command{},
+	}
+	r.stats = nil
+	r.refresh(info)
+}
+
+// refresh replaces the lineup and waves of the current battle
+func (r *Recorder) refresh(info *pb.SceneBattleInfo) {
+	battle := r.current
+	battle.Lineup = make([]Avatar, 0, len(info.GetPveAvatarList()))
+	battle.Waves = make([]Wave, 0, len(info.GetMonsterWaveList()))
+	for _, avatar := range info.GetPveAvatarList() {
+		battle.Lineup = append(battle.Lineup, Avatar{
+			Id:        avatar.GetId(),
+			Type:      uint32(avatar.GetAvatarType()),
+			Level:     avatar.GetLevel(),
+			Promotion: avatar.GetPromotion(),
+			Rank:      avatar.GetRank(),
+			Index:     avatar.GetIndex(),
+			Hp:        avatar.GetHp(),
+		})
+	}
+	for _, wave := range info.GetMonsterWaveList() {
+		monsters := make([]uint32, 0, len(wave.GetMonsterList()))
+		for _, monster := range wave.GetMonsterList() {
+			monsters = append(monsters, monster.GetMonsterId())
+		}
+		battle.Waves = append(battle.Waves, Wave{StageId: wave.GetBattleStageId(), Monsters: monsters})
+	}
+}
+
+func (r *Recorder) applyResult(command reliquary.GameCommand, rsp *pb.PVEBattleResultScRsp) {
+	if rsp.GetRetcode() != 0 || rsp.GetBattleId() != r.current.Id {
+		return
+	}
+
+	battle := r.current
+	if rsp.GetStageId() != 0 {
+		battle.StageId = rsp.GetStageId()
+	}
+	for _, item := range rsp.GetDropData().GetItemList() {
+		battle.Drops = append(battle.Drops, Drop{ItemId: item.GetItemId(), Count: item.GetNum()})
+	}
+
+	if req := r.stats; req.GetBattleId() == battle.Id {
+		stt := req.GetStt()
+		battle.CostTime = stt.GetCostTime()
+		battle.Turns = stt.GetTotalBattleTurns()
+		battle.AutoTurns = stt.GetTotalAutoTurns()
+		battle.Rounds = stt.GetRoundCnt()
+		battle.Ultimates = stt.GetUltraCnt()
+		for _, avatar := range stt.GetBattleAvatarList() {
+			battle.AvatarStats = append(battle.AvatarStats, AvatarStats{
+				Id:          avatar.GetId(),
+				Turns:       avatar.GetTotalTurns(),
+				Damage:      avatar.GetTotalDamage(),
+				Healing:     avatar.GetTotalHeal(),
+				DamageTaken: avatar.GetTotalDamageTaken(),
+			})
+		}
+	}
+
+	result, ok := results[uint32(rsp.GetEndStatus())]
+	if !ok {
+		result = UNFINISHED
+	}
+	r.finish(result, command.Name, command.Timestamp)
+}
+
+// finish ends the current battle at the capture time of the command that ended it
+func (r *Recorder) finish(result Result, endedBy string, at time.Time) {
+	battle := *r.current
+	battle.Result = result
+	battle.EndedBy = endedBy
+	battle.End = at
+	battle.Duration = battle.End.Sub(battle.Start).Seconds()
+
+	r.current = nil
+	r.stats = nil
+	r.battles = append(r.battles, battle)
+	if len(r.battles) > MAX_BATTLES {
+		r.battles = slices.Delete(r.battles, 0, len(r.battles)-MAX_BATTLES)
+	}
+	r.finished.Push(battle)
+}
+
+// Battles returns the finished battles, oldest first
+func (r *Recorder) Battles() []Battle {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return slices.Clone(r.battles)
+}
+
+// Current returns the battle in progress, false when there is none
+func (r *Recorder) Current() (Battle, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if r.current == nil {
+		return Battle{}, false
+	}
+	return *r.current, true
+}
+
+// WriteJSON writes battles as an indented JSON array
+func WriteJSON(w io.Writer, battles ...Battle) error {
+	if battles == nil {
+		battles = []Battle{}
+	}
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(battles)
+}
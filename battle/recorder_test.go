@@ -0,0 +1,198 @@
+package battle_test
+
+import (
+	"fmt"
+	"github.com/Fesaa/go-reliquary"
+	"github.com/Fesaa/go-reliquary/battle"
+	"github.com/Fesaa/go-reliquary/pb"
+	"github.com/Fesaa/go-reliquary/reliquarytest"
+	"slices"
+	"testing"
+	"time"
+)
+
+func start(s *reliquarytest.Session, battleId uint32, avatars ...uint32) {
+	info := &pb.SceneBattleInfo{
+		BattleId: battleId,
+		StageId:  100,
+		MonsterWaveList: []*pb.SceneMonsterWave{
+			{BattleStageId: 101, MonsterList: []*pb.SceneMonster{{MonsterId: 3001}, {MonsterId: 3002}}},
+		},
+	}
+	for i, id := range avatars {
+		info.PveAvatarList = append(info.PveAvatarList, &pb.BattleAvatar{Id: id, Level: 80, Index: uint32(i)})
+	}
+	s.FromServer(reliquary.SceneEnterStageScRsp, &pb.SceneEnterStageScRsp{BattleInfo: info})
+}
+
+func result(s *reliquarytest.Session, battleId uint32, endStatus uint32) {
+	s.FromServer(reliquary.PVEBattleResultScRsp, &pb.PVEBattleResultScRsp{BattleId: battleId, EndStatus: endStatus})
+}
+
+// describe writes a battle as <id> <result> <started by>-<ended by> <commands>
+func describe(b battle.Battle) string {
+	return fmt.Sprintf("%d %s %s-%s %d", b.Id, b.Result, b.StartedBy, b.EndedBy, len(b.Commands))
+}
+
+func TestRecorderBattles(t *testing.T) {
+	tests := []struct {
+		name    string
+		script  func(s *reliquarytest.Session)
+		battles []string
+		// current is the id of the battle in progress, 0 for none
+		current uint32
+	}{
+		{"win", func(s *reliquarytest.Session) {
+			start(s, 1, 1102)
+			s.FromClientData(reliquary.PlayerHeartBeatCsReq, nil)
+			result(s, 1, 1)
+		}, []string{"1 win SceneEnterStageScRsp-PVEBattleResultScRsp 3"}, 0},
+		{"lose", func(s *reliquarytest.Session) {
+			start(s, 1, 1102)
+			result(s, 1, 2)
+		}, []string{"1 lose SceneEnterStageScRsp-PVEBattleResultScRsp 2"}, 0},
+		{"commands outside battles", func(s *reliquarytest.Session) {
+			s.FromClientData(reliquary.PlayerHeartBeatCsReq, nil)
+			start(s, 1, 1102)
+			result(s, 1, 1)
+			s.FromClientData(reliquary.PlayerHeartBeatCsReq, nil)
+		}, []string{"1 win SceneEnterStageScRsp-PVEBattleResultScRsp 2"}, 0},
+		{"resumed", func(s *reliquarytest.Session) {
+			start(s, 1, 1102)
+			start(s, 1, 1102, 1005)
+			result(s, 1, 3)
+		}, []string{"1 quit SceneEnterStageScRsp-PVEBattleResultScRsp 3"}, 0},
+		{"replaced", func(s *reliquarytest.Session) {
+			start(s, 1, 1102)
+			start(s, 2, 1102)
+		}, []string{"1 unfinished SceneEnterStageScRsp- 1"}, 2},
+		{"quit", func(s *reliquarytest.Session) {
+			start(s, 1, 1102)
+			s.FromServer(reliquary.QuitBattleScNotify, &pb.QuitBattleScNotify{})
+		}, []string{"1 unfinished SceneEnterStageScRsp-QuitBattleScNotify 2"}, 0},
+		{"activity end", func(s *reliquarytest.Session) {
+			start(s, 1, 1102)
+			s.FromServerData(reliquary.ClockParkBattleEndScNotify, nil)
+		}, []string{"1 unfinished SceneEnterStageScRsp-ClockParkBattleEndScNotify 2"}, 0},
+		{"failed start", func(s *reliquarytest.Session) {
+			s.FromServer(reliquary.SceneEnterStageScRsp, &pb.SceneEnterStageScRsp{
+				Retcode:    1,
+				BattleInfo: &pb.SceneBattleInfo{BattleId: 1},
+			})
+		}, nil, 0},
+		{"result of another battle", func(s *reliquarytest.Session) {
+			start(s, 1, 1102)
+			result(s, 2, 1)
+		}, nil, 1},
+		{"failed result", func(s *reliquarytest.Session) {
+			start(s, 1, 1102)
+			s.FromServer(reliquary.PVEBattleResultScRsp, &pb.PVEBattleResultScRsp{Retcode: 1, BattleId: 1, EndStatus: 1})
+		}, nil, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var notified []string
+			recorder := battle.NewRecorder(func(b battle.Battle) {
+				notified = append(notified, describe(b))
+			})
+			session := reliquarytest.NewSession()
+			tt.script(session)
+			if err := recorder.Apply(session.GameCommands()...); err != nil {
+				t.Fatal(err)
+			}
+
+			var battles []string
+			for _, b := range recorder.Battles() {
+				battles = append(battles, describe(b))
+			}
+			if !slices.Equal(battles, tt.battles) {
+				t.Errorf("battles %q, want %q", battles, tt.battles)
+			}
+			if !slices.Equal(notified, tt.battles) {
+				t.Errorf("notified %q, want %q", notified, tt.battles)
+			}
+			current, ok := recorder.Current()
+			if ok != (tt.current != 0) || current.Id != tt.current {
+				t.Errorf("current battle %d, want %d", current.Id, tt.current)
+			}
+		})
+	}
+}
+
+func TestRecorderResult(t *testing.T) {
+	recorder := battle.NewRecorder(nil)
+	session := reliquarytest.NewSession()
+	start(session, 1, 1102)
+	start(session, 1, 1102, 1005)
+	session.Wait(90 * time.Second)
+	session.FromClient(reliquary.PVEBattleResultCsReq, &pb.PVEBattleResultCsReq{
+		BattleId:  1,
+		EndStatus: 1,
+		Stt: &pb.BattleStatistics{
+			TotalBattleTurns: 12,
+			TotalAutoTurns:   4,
+			UltraCnt:         3,
+			CostTime:         85.5,
+			RoundCnt:         2,
+			BattleAvatarList: []*pb.AvatarBattleInfo{{Id: 1102, TotalTurns: 7, TotalDamage: 1000, TotalDamageTaken: 20}},
+		},
+	})
+	session.FromServer(reliquary.PVEBattleResultScRsp, &pb.PVEBattleResultScRsp{
+		BattleId:  1,
+		StageId:   102,
+		EndStatus: 1,
+		DropData:  &pb.ItemList{ItemList: []*pb.Item{{ItemId: 2, Num: 1500}}},
+	})
+	if err := recorder.Apply(session.GameCommands()...); err != nil {
+		t.Fatal(err)
+	}
+
+	battles := recorder.Battles()
+	if len(battles) != 1 {
+		t.Fatalf("%d battles, want 1", len(battles))
+	}
+	b := battles[0]
+	commands := session.Commands()
+	if !b.Start.Equal(commands[0].Time) || !b.End.Equal(commands[3].Time) {
+		t.Errorf("from %v to %v, want %v to %v", b.Start, b.End, commands[0].Time, commands[3].Time)
+	}
+	if want := commands[3].Time.Sub(commands[0].Time).Seconds(); b.Duration != want || b.Duration < 90 {
+		t.Errorf("duration %g, want %g", b.Duration, want)
+	}
+	if b.StageId != 102 || b.CostTime != 85.5 || b.Turns != 12 || b.AutoTurns != 4 || b.Rounds != 2 || b.Ultimates != 3 {
+		t.Errorf("battle %+v", b)
+	}
+	if len(b.Lineup) != 2 || b.Lineup[1].Id != 1005 || b.Lineup[1].Index != 1 {
+		t.Errorf("lineup %+v", b.Lineup)
+	}
+	if len(b.Waves) != 1 || b.Waves[0].StageId != 101 || !slices.Equal(b.Waves[0].Monsters, []uint32{3001, 3002}) {
+		t.Errorf("waves %+v", b.Waves)
+	}
+	if want := []battle.AvatarStats{{Id: 1102, Turns: 7, Damage: 1000, DamageTaken: 20}}; !slices.Equal(b.AvatarStats, want) {
+		t.Errorf("avatar stats %+v, want %+v", b.AvatarStats, want)
+	}
+	if want := []battle.Drop{{ItemId: 2, Count: 1500}}; !slices.Equal(b.Drops, want) {
+		t.Errorf("drops %+v, want %+v", b.Drops, want)
+	}
+	if len(b.Commands) != 4 || b.Commands[2].Name != "PVEBattleResultCsReq" || len(b.Commands[2].Message) == 0 {
+		t.Errorf("commands %+v", b.Commands)
+	}
+}
+
+func TestRecorderMaxBattles(t *testing.T) {
+	recorder := battle.NewRecorder(nil)
+	session := reliquarytest.NewSession()
+	for id := uint32(1); id <= battle.MAX_BATTLES+1; id++ {
+		start(session, id)
+		result(session, id, 1)
+	}
+	if err := recorder.Apply(session.GameCommands()...); err != nil {
+		t.Fatal(err)
+	}
+
+	battles := recorder.Battles()
+	if len(battles) != battle.MAX_BATTLES || battles[0].Id != 2 {
+		t.Errorf("kept %d battles from %d, want %d from 2", len(battles), battles[0].Id, battle.MAX_BATTLES)
+	}
+}
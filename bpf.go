@@ -0,0 +1,145 @@
+package reliquary
+
+import (
+	"fmt"
+	"golang.org/x/net/bpf"
+)
+
+const (
+	ipProtocolUDP          = 17
+	ipv6NextHeaderFragment = 44
+)
+
+// compilePortFilter builds the BPF equivalent of PcapFilter for captures without libpcap.
+// linkHeaderLen is 14 for Ethernet frames, and 0 for raw IP (tun/wireguard) interfaces.
+// IP fragments are always accepted, as only the first fragment carries the UDP header
+func compilePortFilter(linkHeaderLen uint32, ranges []PortRange) ([]bpf.RawInstruction, error) {
+	b := &bpfBuilder{labels: make(map[string]int)}
+	l := linkHeaderLen
+
+	if l > 0 {
+		b.emit(bpf.LoadAbsolute{Off: 12, Size: 2})
+		b.jump(bpf.JumpEqual, 0x0800, "v4", "")
+		b.jump(bpf.JumpEqual, 0x86DD, "v6", "drop")
+	} else {
+		b.emit(bpf.LoadAbsolute{Off: 0, Size: 1})
+		b.emit(bpf.ALUOpConstant{Op: bpf.ALUOpShiftRight, Val: 4})
+		b.jump(bpf.JumpEqual, 4, "v4", "")
+		b.jump(bpf.JumpEqual, 6, "v6", "drop")
+	}
+
+	b.label("v4")
+	b.emit(bpf.LoadAbsolute{Off: l + 9, Size: 1})
+	b.jump(bpf.JumpEqual, ipProtocolUDP, "", "drop")
+	b.emit(bpf.LoadAbsolute{Off: l + 6, Size: 2})
+	b.jump(bpf.JumpBitsSet, 0x1FFF, "accept", "")
+	b.emit(bpf.LoadMemShift{Off: l})
+	b.ports(bpf.LoadIndirect{Off: l, Size: 2}, bpf.LoadIndirect{Off: l + 2, Size: 2}, ranges)
+
+	b.label("v6")
+	b.emit(bpf.LoadAbsolute{Off: l + 6, Size: 1})
+	b.jump(bpf.JumpEqual, ipv6NextHeaderFragment, "accept", "")
+	b.jump(bpf.JumpEqual, ipProtocolUDP, "", "drop")
+	b.ports(bpf.LoadAbsolute{Off: l + 40, Size: 2}, bpf.LoadAbsolute{Off: l + 42, Size: 2}, ranges)
+
+	b.label("accept")
+	b.emit(bpf.RetConstant{Val: 0x40000})
+	b.label("drop")
+	b.emit(bpf.RetConstant{Val: 0})
+
+	insts, err := b.resolve()
+	if err != nil {
+		return nil, err
+	}
+	return bpf.Assemble(insts)
+}
+
+type bpfJump struct {
+	idx        int
+	trueLabel  string
+	falseLabel string
+}
+
+// bpfBuilder emits instructions with symbolic jump targets, an empty label is the next instruction
+type bpfBuilder struct {
+	insts  []bpf.Instruction
+	labels map[string]int
+	jumps  []bpfJump
+	ranges int
+}
+
+func (b *bpfBuilder) emit(inst bpf.Instruction) {
+	b.insts = append(b.insts, inst)
+}
+
+func (b *bpfBuilder) label(name string) {
+	b.labels[name] = len(b.insts)
+}
+
+func (b *bpfBuilder) jump(cond bpf.JumpTest, val uint32, trueLabel, falseLabel string) {
+	b.jumps = append(b.jumps, bpfJump{idx: len(b.insts), trueLabel: trueLabel, falseLabel: falseLabel})
+	b.emit(bpf.JumpIf{Cond: cond, Val: val})
+}
+
+// ports accepts the packet if either port is in one of the ranges, and drops it otherwise
+func (b *bpfBuilder) ports(src, dst bpf.Instruction, ranges []PortRange) {
+	if len(ranges) == 0 {
+		b.emit(bpf.Jump{Skip: 0})
+		b.jumps = append(b.jumps, bpfJump{idx: len(b.insts) - 1, trueLabel: "accept"})
+		return
+	}
+
+	for _, load := range []bpf.Instruction{src, dst} {
+		b.emit(load)
+		for _, pr := range ranges {
+			b.ranges++
+			next := fmt.Sprintf("range%d", b.ranges)
+			b.jump(bpf.JumpLessThan, uint32(pr.Start), next, "")
+			b.jump(bpf.JumpLessOrEqual, uint32(pr.End), "accept", next)
+			b.label(next)
+		}
+	}
+	b.emit(bpf.Jump{Skip: 0})
+	b.jumps = append(b.jumps, bpfJump{idx: len(b.insts) - 1, trueLabel: "drop"})
+}
+
+func (b *bpfBuilder) resolve() ([]bpf.Instruction, error) {
+	skip := func(from int, label string) (int, error) {
+		if label == "" {
+			return 0, nil
+		}
+		to, ok := b.labels[label]
+		if !ok {
+			return 0, fmt.Errorf("unknown bpf label %s", label)
+		}
+		if to <= from {
+			return 0, fmt.Errorf("bpf label %s jumps backwards", label)
+		}
+		return to - from - 1, nil
+	}
+
+	for _, j := range b.jumps {
+		skipTrue, err := skip(j.idx, j.trueLabel)
+		if err != nil {
+			return nil, err
+		}
+		skipFalse, err := skip(j.idx, j.falseLabel)
+		if err != nil {
+			return nil, err
+		}
+
+		switch inst := b.insts[j.idx].(type) {
+		case bpf.Jump:
+			inst.Skip = uint32(skipTrue)
+			b.insts[j.idx] = inst
+		case bpf.JumpIf:
+			if skipTrue > 255 || skipFalse > 255 {
+				return nil, fmt.Errorf("bpf filter too large, jump of %d/%d", skipTrue, skipFalse)
+			}
+			inst.SkipTrue = uint8(skipTrue)
+			inst.SkipFalse = uint8(skipFalse)
+			b.insts[j.idx] = inst
+		}
+	}
+	return b.insts, nil
+}
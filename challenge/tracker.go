@@ -0,0 +1,478 @@
+// Package challenge tracks endgame progress from the commands a reliquary.Sniffer reads: stars and scores per floor,
+// every settled attempt with the teams used, and the statistics the game keeps per season. Progress is kept in a file,
+// so clears can be compared across sessions and seasons.
+//
+//	tracker, err := challenge.Open("challenges.json", data)
+//	for packet := range capture.Packets {
+//		_ = tracker.Apply(packet.Commands...)
+//	}
+//	for _, season := range tracker.Seasons() {
+//		fmt.Println(season.GroupId, season.Stars)
+//	}
+package challenge
+
+import (
+	"cmp"
+	"errors"
+	"github.com/Fesaa/go-reliquary"
+	"github.com/Fesaa/go-reliquary/gamedata"
+	"github.com/Fesaa/go-reliquary/internal/accountfile"
+	"github.com/Fesaa/go-reliquary/internal/dispatch"
+	"github.com/Fesaa/go-reliquary/pb"
+	"maps"
+	"math/bits"
+	"slices"
+	"sync"
+	"time"
+)
+
+// FILE_VERSION is written to the progress file, files from a newer version are refused
+const FILE_VERSION = 1
+
+var UnsupportedFile = errors.New("unsupported challenge progress file")
+
+// Floor is the best result on a floor
+type Floor struct {
+	ChallengeId uint32                 `json:"challenge_id"`
+	GroupId     uint32                 `json:"group_id,omitempty"`
+	Floor       uint32                 `json:"floor,omitempty"`
+	Mode        gamedata.ChallengeMode `json:"mode"`
+	Stars       uint32                 `json:"stars"`
+	// Score is the sum of both halves in Pure Fiction and Apocalyptic Shadow
+	Score uint32 `json:"score,omitempty"`
+	// Cycles is the fewest cycles of the clears seen, 0 when none were
+	Cycles  uint32    `json:"cycles,omitempty"`
+	Updated time.Time `json:"updated"`
+}
+
+// Phase is a settled half of a floor
+type Phase struct {
+	Phase uint32 `json:"phase"`
+	Win   bool   `json:"win"`
+	Stars uint32 `json:"stars"`
+	Score uint32 `json:"score"`
+}
+
+// Clear is a settled attempt, won or not
+type Clear struct {
+	ChallengeId uint32                 `json:"challenge_id"`
+	GroupId     uint32                 `json:"group_id,omitempty"`
+	Floor       uint32                 `json:"floor,omitempty"`
+	Mode        gamedata.ChallengeMode `json:"mode"`
+	Win         bool                   `json:"win"`
+	Stars       uint32                 `json:"stars"`
+	Score       uint32                 `json:"score,omitempty"`
+	Cycles      uint32                 `json:"cycles,omitempty"`
+	// Teams are the avatar ids of every team, in the order of the halves
+	Teams  [][]uint32 `json:"teams"`
+	Phases []Phase    `json:"phases,omitempty"`
+	Time   time.Time  `json:"time"`
+}
+
+// Statistics is the best record of a season as the game keeps it
+type Statistics struct {
+	GroupId uint32                 `json:"group_id"`
+	Mode    gamedata.ChallengeMode `json:"mode"`
+	// Floor is the highest floor cleared
+	Floor   uint32     `json:"floor"`
+	Cycles  uint32     `json:"cycles,omitempty"`
+	Score   uint32     `json:"score,omitempty"`
+	Teams   [][]uint32 `json:"teams"`
+	Updated time.Time  `json:"updated"`
+}
+
+// Season sums up the floors of a group
+type Season struct {
+	GroupId uint32
+	Mode    gamedata.ChallengeMode
+	Stars   uint32
+	Score   uint32
+	Floors  []Floor
+}
+
+type account struct {
+	Floors     map[uint32]Floor      `json:"floors"`
+	Clears     []Clear               `json:"clears"`
+	Statistics map[uint32]Statistics `json:"statistics"`
+}
+
+var progressFile = accountfile.File[*account]{Version: FILE_VERSION, Unsupported: UnsupportedFile}
+
+// attempt is the floor being played, until it settles
+type attempt struct {
+	challengeId uint32
+	cycles      uint32
+	// teams are keyed by the extra lineup type, one per half
+	teams  map[uint32][]uint32
+	phases []Phase
+}
+
+var handlers = dispatch.Handlers[*Tracker]{
+	reliquary.PlayerGetTokenScRsp:              dispatch.On((*Tracker).applyLogin),
+	reliquary.GetChallengeScRsp:                dispatch.At((*Tracker).applyChallenges),
+	reliquary.StartChallengeScRsp:              dispatch.On((*Tracker).applyStart),
+	reliquary.GetCurChallengeScRsp:             dispatch.On((*Tracker).applyCurChallenge),
+	reliquary.SyncLineupNotify:                 dispatch.On((*Tracker).applyLineup),
+	reliquary.ChallengeBossPhaseSettleNotify:   dispatch.On((*Tracker).applyPhaseSettle),
+	reliquary.ChallengeSettleNotify:            dispatch.At((*Tracker).applySettle),
+	reliquary.GetChallengeGroupStatisticsScRsp: dispatch.At((*Tracker).applyStatistics),
+	reliquary.ChallengePeakSettleScNotify:      dispatch.At((*Tracker).applyPeakSettle),
+}
+
+// Tracker keeps the endgame progress of every account seen in a file, safe for concurrent use
+type Tracker struct {
+	mu       sync.RWMutex
+	path     string
+	data     *gamedata.Resolver
+	uid      uint32
+	accounts map[uint32]*account
+	current  *attempt
+}
+
+// Open reads the progress at path, a missing file starts an empty one. The game data gives floors
+// their season and number, it may be nil
+func Open(path string, data *gamedata.Resolver) (*Tracker, error) {
+	t := &Tracker{
+		path:     path,
+		data:     data,
+		accounts: make(map[uint32]*account),
+	}
+
+	accounts, err := progressFile.Read(path)
+	if err != nil {
+		return nil, err
+	}
+	for uid, a := range accounts {
+		if a == nil {
+			continue
+		}
+		if a.Floors == nil {
+			a.Floors = make(map[uint32]Floor)
+		}
+		if a.Statistics == nil {
+			a.Statistics = make(map[uint32]Statistics)
+		}
+		t.accounts[uid] = a
+	}
+	return t, nil
+}
+
+// Apply updates the progress, and saves it when it changed
+func (t *Tracker) Apply(commands ...reliquary.GameCommand) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return handlers.Apply(t, commands)
+}
+
+func (t *Tracker) applyLogin(rsp *pb.PlayerGetTokenScRsp) error {
+	if rsp.GetRetcode() == 0 {
+		t.uid = rsp.GetUid()
+		t.current = nil
+	}
+	return nil
+}
+
+func (t *Tracker) applyChallenges(rsp *pb.GetChallengeScRsp, at time.Time) error {
+	if rsp.GetRetcode() != 0 {
+		return nil
+	}
+
+	a := t.account()
+	for _, challenge := range rsp.GetChallengeList() {
+		floor := t.floor(a, challenge.GetChallengeId())
+		floor.Stars = stars(challenge.GetStar())
+		floor.Score = challenge.GetScoreId() + challenge.GetScoreTwo()
+		floor.Updated = at
+		a.Floors[floor.ChallengeId] = floor
+	}
+	return t.save()
+}
+
+func (t *Tracker) applyStart(rsp *pb.StartChallengeScRsp) error {
+	if rsp.GetRetcode() == 0 {
+		t.track(rsp.GetCurChallenge(), rsp.GetLineupList())
+	}
+	return nil
+}
+
+func (t *Tracker) applyCurChallenge(rsp *pb.GetCurChallengeScRsp) error {
+	if rsp.GetRetcode() == 0 {
+		t.track(rsp.GetCurChallenge(), rsp.GetLineupList())
+	}
+	return nil
+}
+
+// track starts an attempt, or continues it when it's the floor already being played
+func (t *Tracker) track(cur *pb.CurChallenge, lineups []*pb.LineupInfo) {
+	if cur.GetChallengeId() == 0 {
+		return
+	}
+	if t.current == nil || t.current.challengeId != cur.GetChallengeId() {
+		t.current = &attempt{
+			challengeId: cur.GetChallengeId(),
+			teams:       make(map[uint32][]uint32),
+		}
+	}
+	t.current.cycles = cur.GetRoundCount()
+	for _, lineup := range lineups {
+		t.current.teams[uint32(lineup.GetExtraLineupType())] = avatars(lineup)
+	}
+}
+
+// applyLineup follows the teams while a floor is played, challenges use lineups apart from the saved teams
+func (t *Tracker) applyLineup(notify *pb.SyncLineupNotify) error {
+	lineup := notify.GetLineup()
+	if t.current != nil && lineup != nil && lineup.GetExtraLineupType() != 0 {
+		t.current.teams[uint32(lineup.GetExtraLineupType())] = avatars(lineup)
+	}
+	return nil
+}
+
+func (t *Tracker) applyPhaseSettle(notify *pb.ChallengeBossPhaseSettleNotify) error {
+	if t.current == nil || t.current.challengeId != notify.GetChallengeId() {
+		return nil
+	}
+	t.current.phases = append(t.current.phases, Phase{
+		Phase: notify.GetPhase(),
+		Win:   notify.GetIsWin(),
+		Stars: stars(notify.GetStar()),
+		Score: notify.GetScoreId() + notify.GetScoreTwo(),
+	})
+	return nil
+}
+
+func (t *Tracker) applySettle(notify *pb.ChallengeSettleNotify, at time.Time) error {
+	a := t.account()
+	floor := t.floor(a, notify.GetChallengeId())
+	settled := Clear{
+		ChallengeId: floor.ChallengeId,
+		GroupId:     floor.GroupId,
+		Floor:       floor.Floor,
+		Mode:        floor.Mode,
+		Win:         notify.GetIsWin(),
+		Stars:       stars(notify.GetStar()),
+		Score:       notify.GetScoreId() + notify.GetScoreTwo(),
+		Cycles:      notify.GetCurChallenge().GetRoundCount(),
+		Teams:       [][]uint32{},
+		Time:        at,
+	}
+	if current := t.current; current != nil && current.challengeId == settled.ChallengeId {
+		for _, lineupType := range slices.Sorted(maps.Keys(current.teams)) {
+			settled.Teams = append(settled.Teams, current.teams[lineupType])
+		}
+		settled.Phases = current.phases
+		if settled.Cycles == 0 {
+			settled.Cycles = current.cycles
+		}
+	}
+	t.current = nil
+
+	a.Clears = append(a.Clears, settled)
+	if settled.Win {
+		floor.Stars = max(floor.Stars, settled.Stars)
+		floor.Score = max(floor.Score, settled.Score)
+		if settled.Cycles != 0 && (floor.Cycles == 0 || settled.Cycles < floor.Cycles) {
+			floor.Cycles = settled.Cycles
+		}
+		floor.Updated = settled.Time
+		a.Floors[floor.ChallengeId] = floor
+	}
+	return t.save()
+}
+
+func (t *Tracker) applyStatistics(rsp *pb.GetChallengeGroupStatisticsScRsp, at time.Time) error {
+	if rsp.GetRetcode() != 0 {
+		return nil
+	}
+
+	statistics := Statistics{GroupId: rsp.GetGroupId(), Teams: [][]uint32{}, Updated: at}
+	var lineups []*pb.ChallengeLineupList
+	switch {
+	case rsp.GetChallengeDefault() != nil:
+		record := rsp.GetChallengeDefault()
+		statistics.Mode = gamedata.MEMORY_OF_CHAOS
+		statistics.Floor, statistics.Cycles = record.GetLevel(), record.GetRoundCount()
+		lineups = record.GetLineupList()
+	case rsp.GetChallengeStory() != nil:
+		record := rsp.GetChallengeStory()
+		statistics.Mode = gamedata.PURE_FICTION
+		statistics.Floor, statistics.Score = record.GetLevel(), record.GetScore()
+		lineups = record.GetLineupList()
+	case rsp.GetChallengeBoss() != nil:
+		record := rsp.GetChallengeBoss()
+		statistics.Mode = gamedata.APOCALYPTIC_SHADOW
+		statistics.Floor, statistics.Score = record.GetLevel(), record.GetScore()
+		lineups = record.GetLineupList()
+	default:
+		return nil
+	}
+	for _, lineup := range lineups {
+		team := make([]uint32, 0, len(lineup.GetAvatarList()))
+		for _, avatar := range lineup.GetAvatarList() {
+			team = append(team, avatar.GetId())
+		}
+		statistics.Teams = append(statistics.Teams, team)
+	}
+
+	t.account().Statistics[statistics.GroupId] = statistics
+	return t.save()
+}
+
+// applyPeakSettle records Anomaly Arbitration, it has no floors and keeps to its own ids
+func (t *Tracker) applyPeakSettle(notify *pb.ChallengePeakSettleScNotify, at time.Time) error {
+	a := t.account()
+	a.Clears = append(a.Clears, Clear{
+		ChallengeId: notify.GetPeakId(),
+		Mode:        gamedata.ANOMALY_ARBITRATION,
+		Win:         notify.GetIsWin(),
+		Stars:       stars(notify.GetStar()),
+		Cycles:      notify.GetRoundCnt(),
+		Teams:       [][]uint32{slices.Clone(notify.GetAvatarIdList())},
+		Time:        at,
+	})
+	return t.save()
+}
+
+// account returns the progress of the logged in account, creating it if needed
+func (t *Tracker) account() *account {
+	a, ok := t.accounts[t.uid]
+	if !ok {
+		a = &account{
+			Floors:     make(map[uint32]Floor),
+			Statistics: make(map[uint32]Statistics),
+		}
+		t.accounts[t.uid] = a
+	}
+	return a
+}
+
+// floor returns the known progress on a floor, or a new floor described by the game data
+func (t *Tracker) floor(a *account, challengeId uint32) Floor {
+	if floor, ok := a.Floors[challengeId]; ok {
+		return floor
+	}
+
+	floor := Floor{ChallengeId: challengeId, Mode: ModeOf(challengeId)}
+	if t.data != nil {
+		if challenge, ok := t.data.Challenge(challengeId); ok {
+			floor.GroupId, floor.Floor, floor.Mode = challenge.GroupId, challenge.Floor, challenge.Mode
+		}
+	}
+	return floor
+}
+
+func (t *Tracker) save() error {
+	return progressFile.Write(t.path, t.accounts)
+}
+
+// ModeOf returns the mode of a challenge by its id, use the game data when it's loaded
+func ModeOf(challengeId uint32) gamedata.ChallengeMode {
+	switch {
+	case challengeId >= 30000:
+		return gamedata.APOCALYPTIC_SHADOW
+	case challengeId >= 20000:
+		return gamedata.PURE_FICTION
+	}
+	return gamedata.MEMORY_OF_CHAOS
+}
+
+// stars counts the stars of a floor, the game sends one bit per star
+func stars(mask uint32) uint32 {
+	return uint32(bits.OnesCount32(mask))
+}
+
+func avatars(lineup *pb.LineupInfo) []uint32 {
+	members := slices.Clone(lineup.GetAvatarList())
+	slices.SortFunc(members, func(a, b *pb.LineupAvatar) int {
+		return cmp.Compare(a.GetSlot(), b.GetSlot())
+	})
+
+	ids := make([]uint32, 0, len(members))
+	for _, member := range members {
+		ids = append(ids, member.GetId())
+	}
+	return ids
+}
+
+// Uid returns the logged in account, 0 before the login was seen
+func (t *Tracker) Uid() uint32 {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.uid
+}
+
+// Floors returns the floors of the logged in account, ordered by id
+func (t *Tracker) Floors() []Floor {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	a, ok := t.accounts[t.uid]
+	if !ok {
+		return nil
+	}
+	floors := make([]Floor, 0, len(a.Floors))
+	for _, id := range slices.Sorted(maps.Keys(a.Floors)) {
+		floors = append(floors, a.Floors[id])
+	}
+	return floors
+}
+
+// Clears returns the settled attempts of the logged in account, oldest first
+func (t *Tracker) Clears() []Clear {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	a, ok := t.accounts[t.uid]
+	if !ok {
+		return nil
+	}
+	return slices.Clone(a.Clears)
+}
+
+// Statistics returns the season records of the logged in account, ordered by group id
+func (t *Tracker) Statistics() []Statistics {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	a, ok := t.accounts[t.uid]
+	if !ok {
+		return nil
+	}
+	statistics := make([]Statistics, 0, len(a.Statistics))
+	for _, groupId := range slices.Sorted(maps.Keys(a.Statistics)) {
+		statistics = append(statistics, a.Statistics[groupId])
+	}
+	return statistics
+}
+
+// Seasons groups the floors of the logged in account by mode and season, ordered by group id.
+// Floors the game data doesn't know share group 0 of their mode
+func (t *Tracker) Seasons() []Season {
+	type key struct {
+		groupId uint32
+		mode    gamedata.ChallengeMode
+	}
+
+	seasons := make(map[key]*Season)
+	for _, floor := range t.Floors() {
+		k := key{floor.GroupId, floor.Mode}
+		season, ok := seasons[k]
+		if !ok {
+			season = &Season{GroupId: floor.GroupId, Mode: floor.Mode}
+			seasons[k] = season
+		}
+		season.Stars += floor.Stars
+		season.Score += floor.Score
+		season.Floors = append(season.Floors, floor)
+	}
+
+	keys := slices.SortedFunc(maps.Keys(seasons), func(a, b key) int {
+		return cmp.Or(cmp.Compare(a.groupId, b.groupId), cmp.Compare(a.mode, b.mode))
+	})
+	list := make([]Season, 0, len(seasons))
+	for _, k := range keys {
+		list = append(list, *seasons[k])
+	}
+	return list
+}
@@ -0,0 +1,258 @@
+package challenge_test
+
+import (
+	"errors"
+	"fmt"
+	"github.com/Fesaa/go-reliquary"
+	"github.com/Fesaa/go-reliquary/challenge"
+	"github.com/Fesaa/go-reliquary/gamedata"
+	"github.com/Fesaa/go-reliquary/pb"
+	"github.com/Fesaa/go-reliquary/reliquarytest"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+)
+
+func team(avatars ...uint32) *pb.LineupInfo {
+	l := &pb.LineupInfo{}
+	for slot, id := range avatars {
+		l.AvatarList = append(l.AvatarList, &pb.LineupAvatar{Id: id, Slot: uint32(slot)})
+	}
+	return l
+}
+
+// firstHalf and secondHalf build the lineups of a floor, ExtraLineupType is an enum in the game's protos
+func firstHalf(avatars ...uint32) *pb.LineupInfo {
+	l := team(avatars...)
+	l.ExtraLineupType = 1
+	return l
+}
+
+func secondHalf(avatars ...uint32) *pb.LineupInfo {
+	l := team(avatars...)
+	l.ExtraLineupType = 3
+	return l
+}
+
+func settle(s *reliquarytest.Session, challengeId uint32, win bool, star uint32, cycles uint32) {
+	s.FromServer(reliquary.ChallengeSettleNotify, &pb.ChallengeSettleNotify{
+		ChallengeId:  challengeId,
+		IsWin:        win,
+		Star:         star,
+		CurChallenge: &pb.CurChallenge{ChallengeId: challengeId, RoundCount: cycles},
+	})
+}
+
+// describe writes a floor as <id> <mode> <stars>* <score> <cycles>c
+func describe(floor challenge.Floor) string {
+	return fmt.Sprintf("%d %s %d* %d %dc", floor.ChallengeId, floor.Mode, floor.Stars, floor.Score, floor.Cycles)
+}
+
+func open(t *testing.T, path string) *challenge.Tracker {
+	t.Helper()
+
+	tracker, err := challenge.Open(path, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tracker
+}
+
+func apply(t *testing.T, tracker *challenge.Tracker, s *reliquarytest.Session) {
+	t.Helper()
+
+	if err := s.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if err := tracker.Apply(s.GameCommands()...); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestTrackerFloors(t *testing.T) {
+	tests := []struct {
+		name   string
+		script func(s *reliquarytest.Session)
+		floors []string
+		clears int
+	}{
+		{"win", func(s *reliquarytest.Session) {
+			settle(s, 1001, true, 0b111, 5)
+		}, []string{"1001 MemoryOfChaos 3* 0 5c"}, 1},
+		{"loss", func(s *reliquarytest.Session) {
+			settle(s, 1001, false, 0, 30)
+		}, nil, 1},
+		{"best of two wins", func(s *reliquarytest.Session) {
+			settle(s, 1001, true, 0b011, 4)
+			settle(s, 1001, true, 0b111, 9)
+		}, []string{"1001 MemoryOfChaos 3* 0 4c"}, 2},
+		{"loss keeps the best", func(s *reliquarytest.Session) {
+			settle(s, 1001, true, 0b111, 5)
+			settle(s, 1001, false, 0, 30)
+		}, []string{"1001 MemoryOfChaos 3* 0 5c"}, 2},
+		{"score of both halves", func(s *reliquarytest.Session) {
+			s.FromServer(reliquary.ChallengeSettleNotify, &pb.ChallengeSettleNotify{
+				ChallengeId: 20001,
+				IsWin:       true,
+				Star:        0b001,
+				ScoreId:     20000,
+				ScoreTwo:    15000,
+			})
+		}, []string{"20001 PureFiction 1* 35000 0c"}, 1},
+		{"progress from the game", func(s *reliquarytest.Session) {
+			s.FromServer(reliquary.GetChallengeScRsp, &pb.GetChallengeScRsp{ChallengeList: []*pb.Challenge{
+				{ChallengeId: 1002, Star: 0b101},
+				{ChallengeId: 30001, Star: 0b011, ScoreId: 2000, ScoreTwo: 1500},
+			}})
+		}, []string{"1002 MemoryOfChaos 2* 0 0c", "30001 ApocalypticShadow 2* 3500 0c"}, 0},
+		{"failed progress", func(s *reliquarytest.Session) {
+			s.FromServer(reliquary.GetChallengeScRsp, &pb.GetChallengeScRsp{
+				Retcode:       1,
+				ChallengeList: []*pb.Challenge{{ChallengeId: 1002, Star: 0b101}},
+			})
+		}, nil, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tracker := open(t, filepath.Join(t.TempDir(), "challenges.json"))
+			session := reliquarytest.NewSession()
+			session.Login(1)
+			tt.script(session)
+			apply(t, tracker, session)
+
+			var floors []string
+			for _, floor := range tracker.Floors() {
+				floors = append(floors, describe(floor))
+			}
+			if !slices.Equal(floors, tt.floors) {
+				t.Errorf("floors %q, want %q", floors, tt.floors)
+			}
+			if clears := tracker.Clears(); len(clears) != tt.clears {
+				t.Errorf("%d clears, want %d", len(clears), tt.clears)
+			}
+		})
+	}
+}
+
+func TestTrackerClear(t *testing.T) {
+	tracker := open(t, filepath.Join(t.TempDir(), "challenges.json"))
+	session := reliquarytest.NewSession()
+	session.Login(1)
+	session.FromServer(reliquary.StartChallengeScRsp, &pb.StartChallengeScRsp{
+		CurChallenge: &pb.CurChallenge{ChallengeId: 30001, RoundCount: 2},
+		LineupList:   []*pb.LineupInfo{secondHalf(1005, 1309), firstHalf(1102, 1001)},
+	})
+	session.FromServer(reliquary.SyncLineupNotify, &pb.SyncLineupNotify{Lineup: secondHalf(1005, 1309, 1217)})
+	// Saved teams changing mid floor aren't part of the attempt
+	session.FromServer(reliquary.SyncLineupNotify, &pb.SyncLineupNotify{Lineup: team(8001)})
+	session.FromServer(reliquary.ChallengeBossPhaseSettleNotify, &pb.ChallengeBossPhaseSettleNotify{
+		ChallengeId: 30001, Phase: 1, IsWin: true, Star: 0b011, ScoreId: 2000,
+	})
+	session.FromServer(reliquary.ChallengeBossPhaseSettleNotify, &pb.ChallengeBossPhaseSettleNotify{
+		ChallengeId: 30001, Phase: 2, IsWin: true, Star: 0b111, ScoreTwo: 1800,
+	})
+	session.Wait(time.Minute)
+	session.FromServer(reliquary.ChallengeSettleNotify, &pb.ChallengeSettleNotify{
+		ChallengeId: 30001, IsWin: true, Star: 0b111, ScoreId: 2000, ScoreTwo: 1800,
+	})
+	apply(t, tracker, session)
+
+	clears := tracker.Clears()
+	if len(clears) != 1 {
+		t.Fatalf("%d clears, want 1", len(clears))
+	}
+	c := clears[0]
+	if c.ChallengeId != 30001 || c.Mode != gamedata.APOCALYPTIC_SHADOW || !c.Win || c.Stars != 3 || c.Score != 3800 {
+		t.Errorf("clear %+v", c)
+	}
+	if c.Cycles != 2 {
+		t.Errorf("%d cycles, want the 2 of the start", c.Cycles)
+	}
+	if !c.Time.Equal(session.Commands()[len(session.Commands())-1].Time) {
+		t.Errorf("settled at %v", c.Time)
+	}
+	if want := [][]uint32{{1102, 1001}, {1005, 1309, 1217}}; !slices.EqualFunc(c.Teams, want, slices.Equal) {
+		t.Errorf("teams %v, want %v", c.Teams, want)
+	}
+	want := []challenge.Phase{{Phase: 1, Win: true, Stars: 2, Score: 2000}, {Phase: 2, Win: true, Stars: 3, Score: 1800}}
+	if !slices.Equal(c.Phases, want) {
+		t.Errorf("phases %+v, want %+v", c.Phases, want)
+	}
+
+	// The next attempt starts over
+	next := reliquarytest.NewSession()
+	settle(next, 30001, false, 0, 0)
+	apply(t, tracker, next)
+	if c := tracker.Clears()[1]; len(c.Teams) != 0 || len(c.Phases) != 0 {
+		t.Errorf("second clear %+v", c)
+	}
+}
+
+func TestOpen(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		fails   bool
+		want    error
+	}{
+		{"missing", "", false, nil},
+		{"current version", `{"version": 1, "accounts": {"100000001": {"clears": []}}}`, false, nil},
+		{"newer version", `{"version": 2, "accounts": {}}`, true, challenge.UnsupportedFile},
+		{"malformed", `{"version": 1, "accounts": [`, true, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "challenges.json")
+			if tt.content != "" {
+				if err := os.WriteFile(path, []byte(tt.content), 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			_, err := challenge.Open(path, nil)
+			if (err != nil) != tt.fails || tt.want != nil && !errors.Is(err, tt.want) {
+				t.Fatalf("error %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestOpenRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "challenges.json")
+	tracker := open(t, path)
+	session := reliquarytest.NewSession()
+	session.Login(1)
+	session.FromServer(reliquary.StartChallengeScRsp, &pb.StartChallengeScRsp{
+		CurChallenge: &pb.CurChallenge{ChallengeId: 1001},
+		LineupList:   []*pb.LineupInfo{firstHalf(1102, 1001)},
+	})
+	settle(session, 1001, true, 0b011, 6)
+	settle(session, 1002, false, 0, 30)
+	apply(t, tracker, session)
+
+	reopened := open(t, path)
+	login := reliquarytest.NewSession()
+	login.Login(1)
+	apply(t, reopened, login)
+
+	want, got := tracker.Floors(), reopened.Floors()
+	if len(got) != len(want) || len(got) != 1 || describe(got[0]) != describe(want[0]) || !got[0].Updated.Equal(want[0].Updated) {
+		t.Errorf("floors %+v, want %+v", got, want)
+	}
+	clears := reopened.Clears()
+	if len(clears) != 2 || !slices.EqualFunc(clears[0].Teams, [][]uint32{{1102, 1001}}, slices.Equal) || clears[1].Win {
+		t.Errorf("clears %+v", clears)
+	}
+
+	// Progress keeps counting from the saved floors
+	later := reliquarytest.NewSession()
+	settle(later, 1001, true, 0b111, 8)
+	apply(t, reopened, later)
+	if floors := reopened.Floors(); describe(floors[0]) != "1001 MemoryOfChaos 3* 0 6c" {
+		t.Errorf("floor %s after another clear", describe(floors[0]))
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/binary"
 	"errors"
 	"google.golang.org/protobuf/proto"
+	"time"
 )
 
 var CannotInferProtoType = errors.New("cannot infer proto type from id")
@@ -12,6 +13,8 @@ type CommandsPacket struct {
 	_conn     *ConnectionPacket
 	Direction Direction
 	Commands  []GameCommand
+	// Timestamp is the capture time of the packet
+	Timestamp time.Time
 }
 
 type GameCommand struct {
@@ -21,6 +24,12 @@ type GameCommand struct {
 	DataLen    uint32
 	HeaderData []byte
 	ProtoData  []byte
+	// Raw is the command as received, still encrypted
+	Raw []byte
+	// KeySource is the key Raw was decrypted with
+	KeySource KeySource
+	// Timestamp is the capture time of the packet that completed the command
+	Timestamp time.Time
 }
 
 func (CommandsPacket) isGamePacket() {}
@@ -85,6 +94,13 @@ func gameCommandFromData(data []byte) (*GameCommand, error) {
 	dataLen := binary.BigEndian.Uint32(data[8:12])
 
 	finalIdx := 12 + (uint)(dataLen) + (uint)(headerLen)
+	if finalIdx > uint(len(data)-TAIL_LEN) {
+		logger.Warn().
+			Uint("wanted", finalIdx+TAIL_LEN).
+			Int("got", len(data)).
+			Msg("command lengths exceed data")
+		return nil, errors.New("command lengths exceed data")
+	}
 	header := data[12 : 12+headerLen]
 	commandData := data[12+headerLen : finalIdx]
 
@@ -4,12 +4,18 @@ import (
 	"errors"
 	"github.com/google/gopacket"
 	"github.com/google/gopacket/layers"
+	"net/netip"
 )
 
 type ConnectionPacket struct {
 	Type      ConnectionType
 	Direction Direction
 	Payload   []byte
+	// Src and Dst are the addresses of the innermost IPv4/IPv6 and UDP layers
+	Src Endpoint
+	Dst Endpoint
+	// Handshake is set for HandshakeRequested, HandshakeEstablished and Disconnected packets
+	Handshake *Handshake
 }
 
 func (ConnectionPacket) isGamePacket() {}
@@ -27,27 +33,75 @@ const (
 	SegmentData          ConnectionType = "SegmentData"
 )
 
-func parseConnectionPacket(packet gopacket.Packet) (*ConnectionPacket, error) {
-	updLayer := packet.Layer(layers.LayerTypeUDP)
-	if updLayer == nil {
+func parseConnectionPacket(packet gopacket.Packet, matcher *serverMatcher) (*ConnectionPacket, error) {
+	udp, src, dst := innermostUdp(packet)
+	if udp == nil {
 		return nil, errors.New("no udp packet found")
 	}
-	udp := updLayer.(*layers.UDP)
-	direction := directionFromUdp(udp)
-
-	if len(udp.Payload) <= 20 {
-		switch packetCode(udp.Payload) {
-		case 0xFF:
-			logger.Debug().Msg("handshake requested")
-			return &ConnectionPacket{Type: HandshakeRequested, Direction: direction}, nil
-		case 404:
-			logger.Debug().Msg("disconnected")
-			return &ConnectionPacket{Type: Disconnected, Direction: direction}, nil
-		default:
-			logger.Debug().Msg("handshake established")
-			return &ConnectionPacket{Type: HandshakeEstablished, Direction: direction}, nil
+
+	if len(udp.Payload) <= HANDSHAKE_LEN {
+		handshake, err := parseHandshake(udp.Payload)
+		if err != nil {
+			return nil, err
+		}
+		connectionType, err := handshake.Type()
+		if err != nil {
+			return nil, err
+		}
+
+		logger.Debug().
+			Str("type", string(connectionType)).
+			Uint32("conv", handshake.Conv).
+			Uint32("token", handshake.Token).
+			Uint32("data", handshake.Data).
+			Msg("handshake packet")
+		if connectionType == HandshakeRequested {
+			matcher.learn(dst)
+		}
+		direction := matcher.direction(src, dst)
+		return &ConnectionPacket{
+			Type:      connectionType,
+			Direction: direction,
+			Src:       src,
+			Dst:       dst,
+			Handshake: handshake,
+		}, nil
+	}
+
+	direction := matcher.direction(src, dst)
+	return &ConnectionPacket{
+		Type:      SegmentData,
+		Direction: direction,
+		Src:       src,
+		Dst:       dst,
+		Payload:   udp.Payload,
+	}, nil
+}
+
+// innermostUdp returns the last UDP layer of the packet and the endpoints from it and the network layer enclosing it.
+// Tunnels (GRE, VXLAN, ...) are decoded by gopacket into nested layers, so the outer UDP/IP layers belong to the tunnel
+func innermostUdp(packet gopacket.Packet) (*layers.UDP, Endpoint, Endpoint) {
+	var udp *layers.UDP
+	var src, dst Endpoint
+	var network gopacket.NetworkLayer
+
+	for _, layer := range packet.Layers() {
+		switch l := layer.(type) {
+		case *layers.IPv4:
+			network = l
+		case *layers.IPv6:
+			network = l
+		case *layers.UDP:
+			udp = l
+			src, dst = Endpoint{Port: uint16(l.SrcPort)}, Endpoint{Port: uint16(l.DstPort)}
+			if network != nil {
+				flow := network.NetworkFlow()
+				src.Addr, _ = netip.AddrFromSlice(flow.Src().Raw())
+				dst.Addr, _ = netip.AddrFromSlice(flow.Dst().Raw())
+				src.Addr, dst.Addr = src.Addr.Unmap(), dst.Addr.Unmap()
+			}
 		}
 	}
 
-	return &ConnectionPacket{Type: SegmentData, Direction: direction, Payload: udp.Payload}, nil
+	return udp, src, dst
 }
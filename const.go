@@ -3,16 +3,28 @@ package reliquary
 import "errors"
 
 const (
+	// PCAP_FILTER matches DEFAULT_PORTS but drops IP fragments, use Sniffer.PcapFilter instead
 	PCAP_FILTER string = "udp portrange 23301-23302"
 
 	HEADER_LEN = 12
 	TAIL_LEN   = 4
 
 	HEADER_OVERHEAD = HEADER_LEN + TAIL_LEN
+
+	// HEAD_MAGIC and TAIL_MAGIC frame every decrypted command
+	HEAD_MAGIC uint32 = 0x9D74C714
+	TAIL_MAGIC uint32 = 0xD7A152C8
+
+	// KEY_LEN is the length of both dispatch and session keys
+	KEY_LEN = 4096
 )
 
 var (
-	PORTS = []string{"23301", "23302"}
+	DEFAULT_PORTS = []PortRange{{Start: 23301, End: 23302}}
+	// Deprecated: PORTS lists every port of DEFAULT_PORTS, use DEFAULT_PORTS or Sniffer.SetPorts instead
+	PORTS = portStrings(DEFAULT_PORTS)
 
 	PacketNotFromConversation = errors.New("packet not from conversation")
+	TruncatedSegment          = errors.New("kcp segment truncated")
+	UnknownDirection          = errors.New("cannot read segment with an unknown direction")
 )
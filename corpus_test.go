@@ -0,0 +1,93 @@
+package reliquary_test
+
+import (
+	"encoding/binary"
+	"fmt"
+	"github.com/Fesaa/go-reliquary"
+	"github.com/Fesaa/go-reliquary/reliquarytest"
+	"github.com/google/gopacket/layers"
+	"net/netip"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// corpusSessions are written into the seed corpus of the fuzz targets in fuzz_test.go
+var corpusSessions = []struct {
+	name   string
+	script func(s *reliquarytest.Session)
+}{
+	{"login", func(s *reliquarytest.Session) {
+		s.Handshake().Login(goldenSeed).
+			FromClientData(reliquary.GetBagCsReq, nil).
+			FromServerData(reliquary.GetBagScRsp, payload(100)).
+			Disconnect(5)
+	}},
+	{"impaired", func(s *reliquarytest.Session) {
+		s.Handshake().Login(goldenSeed).
+			FromServerData(reliquary.GetBagScRsp, payload(2000), reliquarytest.Reordered(), reliquarytest.Lost(0), reliquarytest.Fragmented(600))
+	}},
+	{"ipv6", func(s *reliquarytest.Session) {
+		s.Client = netip.MustParseAddrPort("[2001:db8::10]:51234")
+		s.Server = netip.MustParseAddrPort("[2001:db8::1]:23301")
+		s.Handshake().Login(goldenSeed).
+			FromServerData(reliquary.GetBagScRsp, payload(2000), reliquarytest.Fragmented(600))
+	}},
+}
+
+func TestFuzzCorpus(t *testing.T) {
+	if !*update {
+		t.Skip("run with -update to rewrite the fuzz corpus")
+	}
+
+	for _, cs := range corpusSessions {
+		session := reliquarytest.NewSession()
+		cs.script(session)
+		frames, err := session.Frames()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var stream []byte
+		for i, frame := range frames {
+			name := fmt.Sprintf("%s_%02d", cs.name, i)
+			writeCorpus(t, "FuzzConnectionPacket", name, frame.Data)
+			stream = binary.BigEndian.AppendUint16(stream, uint16(len(frame.Data)))
+			stream = append(stream, frame.Data...)
+
+			udp, ok := packet(frame.Data).Layer(layers.LayerTypeUDP).(*layers.UDP)
+			if !ok {
+				continue
+			}
+			if len(udp.Payload) == reliquary.HANDSHAKE_LEN {
+				writeCorpus(t, "FuzzHandshake", name, udp.Payload)
+			} else {
+				writeCorpus(t, "FuzzKcpSegments", name, udp.Payload)
+				writeCorpus(t, "FuzzValidateKcpSegment", name, udp.Payload)
+			}
+		}
+		writeCorpus(t, "FuzzReadPacket", cs.name, stream)
+
+		for i, command := range session.Commands() {
+			framed, err := reliquary.EncodeCommandData(command.Id, command.HeaderData, command.ProtoData)
+			if err != nil {
+				t.Fatal(err)
+			}
+			writeCorpus(t, "FuzzGameCommand", fmt.Sprintf("%s_%02d", cs.name, i), framed)
+		}
+	}
+}
+
+// writeCorpus writes a single []byte input in the format of go test's fuzz corpus
+func writeCorpus(t *testing.T, target string, name string, data []byte) {
+	t.Helper()
+
+	dir := filepath.Join("testdata", "fuzz", target)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	content := fmt.Sprintf("go test fuzz v1\n[]byte(%q)\n", data)
+	if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -9,6 +9,8 @@ type Key struct {
 	_bytes []byte
 }
 
+// decryptCommand decrypts into a new buffer, leaving encrypted untouched.
+// The GameCommand parsed from it owns the buffer, its HeaderData and ProtoData point into it
 func (k *Key) decryptCommand(encrypted []byte) []byte {
 
 	if isTraceEnabled() {
@@ -18,18 +20,33 @@ func (k *Key) decryptCommand(encrypted []byte) []byte {
 			Msg("data before decrypt")
 	}
 
+	decrypted := make([]byte, len(encrypted))
 	for i := 0; i < len(encrypted); i++ {
-		encrypted[i] ^= k._bytes[i%len(k._bytes)]
+		decrypted[i] = encrypted[i] ^ k._bytes[i%len(k._bytes)]
 	}
 
 	if isTraceEnabled() {
 		logger.Trace().
-			Str("bytes", bytesAsHex(encrypted)).
-			Int("len", len(encrypted)).
+			Str("bytes", bytesAsHex(decrypted)).
+			Int("len", len(decrypted)).
 			Msg("data after decrypt")
 	}
 
-	return encrypted
+	return decrypted
+}
+
+// Encrypt returns an encrypted copy of a command framed by EncodeCommand
+func (k *Key) Encrypt(command []byte) []byte {
+	out := make([]byte, len(command))
+	for i := range command {
+		out[i] = command[i] ^ k._bytes[i%len(k._bytes)]
+	}
+	return out
+}
+
+// Decrypt returns a decrypted copy of an encrypted command, the cipher is a plain XOR so this is the same as Encrypt
+func (k *Key) Decrypt(command []byte) []byte {
+	return k.Encrypt(command)
 }
 
 func KeyFromSeed(seed uint64) []byte {
@@ -0,0 +1,240 @@
+package reliquary
+
+import (
+	"errors"
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/ip4defrag"
+	"github.com/google/gopacket/layers"
+	"sort"
+	"time"
+)
+
+const (
+	// FRAGMENT_TIMEOUT is how long fragments of an incomplete datagram are kept
+	FRAGMENT_TIMEOUT = 30 * time.Second
+
+	maxIPv6Fragments    = 64
+	maxIPv6Datagrams    = 1024
+	maxIPv6DatagramSize = 65535
+)
+
+var InvalidFragment = errors.New("invalid ip fragment")
+
+// Defragmenter reassembles fragmented IPv4 and IPv6 datagrams before they're handed to the Sniffer
+// Only the innermost network layer is considered, outer (tunnel) layers are dropped from reassembled packets
+type Defragmenter struct {
+	v4       *ip4defrag.IPv4Defragmenter
+	v6       map[ipv6FragmentKey]*ipv6Fragments
+	lastSeen time.Time
+}
+
+type ipv6FragmentKey struct {
+	flow gopacket.Flow
+	id   uint32
+}
+
+type ipv6Fragments struct {
+	first     *layers.IPv6
+	fragments []ipv6Fragment
+	total     int
+	seen      time.Time
+}
+
+type ipv6Fragment struct {
+	offset int
+	data   []byte
+}
+
+func NewDefragmenter() *Defragmenter {
+	return &Defragmenter{
+		v4: ip4defrag.NewIPv4Defragmenter(),
+		v6: make(map[ipv6FragmentKey]*ipv6Fragments),
+	}
+}
+
+// Defrag returns the packet unchanged if it isn't a fragment, nil if more fragments are needed,
+// or a new packet starting at the reassembled network layer
+func (d *Defragmenter) Defrag(packet gopacket.Packet) (gopacket.Packet, error) {
+	now := packet.Metadata().Timestamp
+	if now.IsZero() {
+		now = time.Now()
+	}
+	d.expire(now)
+
+	var ip4 *layers.IPv4
+	var ip6 *layers.IPv6
+	var frag6 *layers.IPv6Fragment
+
+	for _, layer := range packet.Layers() {
+		switch l := layer.(type) {
+		case *layers.IPv4:
+			ip4, ip6, frag6 = l, nil, nil
+		case *layers.IPv6:
+			ip4, ip6, frag6 = nil, l, nil
+		case *layers.IPv6Fragment:
+			frag6 = l
+		}
+	}
+
+	switch {
+	case ip4 != nil && (ip4.Flags&layers.IPv4MoreFragments != 0 || ip4.FragOffset != 0):
+		return d.defragIPv4(packet, ip4, now)
+	case ip6 != nil && frag6 != nil:
+		return d.defragIPv6(packet, ip6, frag6, now)
+	}
+	return packet, nil
+}
+
+func (d *Defragmenter) defragIPv4(packet gopacket.Packet, ip *layers.IPv4, now time.Time) (gopacket.Packet, error) {
+	out, err := d.v4.DefragIPv4WithTimestamp(ip, now)
+	if err != nil {
+		return nil, errors.Join(InvalidFragment, err)
+	}
+	if out == nil {
+		logger.Trace().Uint16("id", ip.Id).Uint16("offset", ip.FragOffset).Msg("waiting for more ipv4 fragments")
+		return nil, nil
+	}
+
+	logger.Debug().Uint16("id", ip.Id).Int("len", len(out.Payload)).Msg("reassembled ipv4 datagram")
+	return rebuildPacket(packet, layers.LayerTypeIPv4, out, gopacket.Payload(out.Payload))
+}
+
+func (d *Defragmenter) defragIPv6(packet gopacket.Packet, ip *layers.IPv6, frag *layers.IPv6Fragment, now time.Time) (gopacket.Packet, error) {
+	key := ipv6FragmentKey{flow: ip.NetworkFlow(), id: frag.Identification}
+	offset := int(frag.FragmentOffset) * 8
+	end := offset + len(frag.Payload)
+
+	if end > maxIPv6DatagramSize || (frag.MoreFragments && len(frag.Payload)%8 != 0) {
+		delete(d.v6, key)
+		return nil, InvalidFragment
+	}
+
+	fragments, ok := d.v6[key]
+	if !ok {
+		if len(d.v6) >= maxIPv6Datagrams {
+			logger.Warn().Int("pending", len(d.v6)).Msg("too many incomplete ipv6 datagrams, dropping fragment")
+			return nil, InvalidFragment
+		}
+		fragments = &ipv6Fragments{total: -1}
+		d.v6[key] = fragments
+	}
+	// Retransmitted and duplicated fragments carry nothing new
+	if fragments.has(offset) {
+		logger.Trace().Uint32("id", frag.Identification).Int("offset", offset).Msg("duplicate ipv6 fragment")
+		return nil, nil
+	}
+	if len(fragments.fragments) >= maxIPv6Fragments {
+		delete(d.v6, key)
+		return nil, InvalidFragment
+	}
+
+	fragments.seen = now
+	fragments.fragments = append(fragments.fragments, ipv6Fragment{
+		offset: offset,
+		data:   append([]byte(nil), frag.Payload...),
+	})
+	if offset == 0 {
+		first := *ip
+		first.NextHeader = frag.NextHeader
+		fragments.first = &first
+	}
+	if !frag.MoreFragments {
+		fragments.total = end
+	}
+
+	if fragments.first == nil || fragments.total < 0 || !fragments.complete() {
+		logger.Trace().Uint32("id", frag.Identification).Int("offset", offset).Msg("waiting for more ipv6 fragments")
+		return nil, nil
+	}
+
+	payload, err := fragments.assemble()
+	delete(d.v6, key)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Debug().Uint32("id", frag.Identification).Int("len", len(payload)).Msg("reassembled ipv6 datagram")
+	out := *fragments.first
+	out.HopByHop = nil
+	return rebuildPacket(packet, layers.LayerTypeIPv6, &out, gopacket.Payload(payload))
+}
+
+func (f *ipv6Fragments) has(offset int) bool {
+	for _, frag := range f.fragments {
+		if frag.offset == offset {
+			return true
+		}
+	}
+	return false
+}
+
+// complete returns whether the fragments cover the datagram without holes, once its total length is known
+func (f *ipv6Fragments) complete() bool {
+	sort.SliceStable(f.fragments, func(i, j int) bool {
+		return f.fragments[i].offset < f.fragments[j].offset
+	})
+
+	covered := 0
+	for _, frag := range f.fragments {
+		if frag.offset > covered {
+			return false
+		}
+		covered = max(covered, frag.offset+len(frag.data))
+	}
+	return covered >= f.total
+}
+
+// assemble concatenates the fragments in order, overlapping data is taken from the earliest fragment
+func (f *ipv6Fragments) assemble() ([]byte, error) {
+	sort.SliceStable(f.fragments, func(i, j int) bool {
+		return f.fragments[i].offset < f.fragments[j].offset
+	})
+
+	payload := make([]byte, 0, f.total)
+	for _, frag := range f.fragments {
+		if frag.offset > len(payload) {
+			return nil, errors.Join(InvalidFragment, errors.New("hole in ipv6 fragments"))
+		}
+		if end := frag.offset + len(frag.data); end > len(payload) {
+			payload = append(payload, frag.data[len(payload)-frag.offset:]...)
+		}
+	}
+
+	if len(payload) != f.total {
+		return nil, errors.Join(InvalidFragment, errors.New("ipv6 fragments do not match total length"))
+	}
+	return payload, nil
+}
+
+func (d *Defragmenter) expire(now time.Time) {
+	if now.Sub(d.lastSeen) < time.Second {
+		return
+	}
+	d.lastSeen = now
+
+	cutoff := now.Add(-FRAGMENT_TIMEOUT)
+	if n := d.v4.DiscardOlderThan(cutoff); n > 0 {
+		logger.Debug().Int("count", n).Msg("discarded stale ipv4 fragments")
+	}
+	for key, fragments := range d.v6 {
+		if fragments.seen.Before(cutoff) {
+			logger.Debug().Uint32("id", key.id).Msg("discarded stale ipv6 fragments")
+			delete(d.v6, key)
+		}
+	}
+}
+
+func rebuildPacket(original gopacket.Packet, first gopacket.LayerType, toSerialize ...gopacket.SerializableLayer) (gopacket.Packet, error) {
+	buf := gopacket.NewSerializeBuffer()
+	opts := gopacket.SerializeOptions{FixLengths: true, ComputeChecksums: true}
+	if err := gopacket.SerializeLayers(buf, opts, toSerialize...); err != nil {
+		return nil, err
+	}
+
+	packet := gopacket.NewPacket(buf.Bytes(), first, gopacket.Default)
+	md := packet.Metadata()
+	md.CaptureInfo = original.Metadata().CaptureInfo
+	md.CaptureLength = len(buf.Bytes())
+	md.Length = len(buf.Bytes())
+	return packet, nil
+}
@@ -0,0 +1,228 @@
+package reliquary_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/Fesaa/go-reliquary"
+	"github.com/Fesaa/go-reliquary/reliquarytest"
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	"net"
+	"net/netip"
+	"testing"
+	"time"
+)
+
+// fragmentedPackets builds a UDP datagram from the game server and splits it into 3 IP fragments,
+// it returns the fragments and the UDP payload they reassemble into
+func fragmentedPackets(t *testing.T, ipv6 bool) ([]gopacket.Packet, []byte) {
+	t.Helper()
+
+	payload := bytes.Repeat([]byte{0x42}, 1200)
+	eth := &layers.Ethernet{
+		SrcMAC: net.HardwareAddr{0x02, 0, 0, 0, 0, 1},
+		DstMAC: net.HardwareAddr{0x02, 0, 0, 0, 0, 2},
+	}
+	udp := &layers.UDP{SrcPort: 23301, DstPort: 51234}
+
+	var network gopacket.SerializableLayer
+	if ipv6 {
+		eth.EthernetType = layers.EthernetTypeIPv6
+		ip := &layers.IPv6{
+			Version:    6,
+			HopLimit:   64,
+			NextHeader: layers.IPProtocolUDP,
+			SrcIP:      net.ParseIP("2001:db8::1"),
+			DstIP:      net.ParseIP("2001:db8::10"),
+		}
+		_ = udp.SetNetworkLayerForChecksum(ip)
+		network = ip
+	} else {
+		eth.EthernetType = layers.EthernetTypeIPv4
+		ip := &layers.IPv4{
+			Version:  4,
+			IHL:      5,
+			TTL:      64,
+			Id:       0x1234,
+			Protocol: layers.IPProtocolUDP,
+			SrcIP:    net.IP{47, 100, 1, 1},
+			DstIP:    net.IP{192, 168, 1, 10},
+		}
+		_ = udp.SetNetworkLayerForChecksum(ip)
+		network = ip
+	}
+	transport := serialize(t, udp, gopacket.Payload(payload))
+
+	const chunk = 480
+	var fragments []gopacket.Packet
+	for offset := 0; offset < len(transport); offset += chunk {
+		end := min(offset+chunk, len(transport))
+		more := end < len(transport)
+
+		var data []byte
+		switch ip := network.(type) {
+		case *layers.IPv4:
+			fragment := *ip
+			fragment.FragOffset = uint16(offset / 8)
+			if more {
+				fragment.Flags = layers.IPv4MoreFragments
+			}
+			data = serialize(t, eth, &fragment, gopacket.Payload(transport[offset:end]))
+		case *layers.IPv6:
+			fragment := *ip
+			fragment.NextHeader = layers.IPProtocolIPv6Fragment
+			header := make([]byte, 8, 8+end-offset)
+			header[0] = byte(layers.IPProtocolUDP)
+			flags := uint16(offset)
+			if more {
+				flags |= 1
+			}
+			binary.BigEndian.PutUint16(header[2:4], flags)
+			binary.BigEndian.PutUint32(header[4:8], 0x1234)
+			data = serialize(t, eth, &fragment, gopacket.Payload(append(header, transport[offset:end]...)))
+		}
+		fragments = append(fragments, packet(data))
+	}
+	if len(fragments) != 3 {
+		t.Fatalf("expected 3 fragments, got %d", len(fragments))
+	}
+	return fragments, payload
+}
+
+func serialize(t *testing.T, toSerialize ...gopacket.SerializableLayer) []byte {
+	t.Helper()
+
+	buf := gopacket.NewSerializeBuffer()
+	opts := gopacket.SerializeOptions{FixLengths: true, ComputeChecksums: true}
+	if err := gopacket.SerializeLayers(buf, opts, toSerialize...); err != nil {
+		t.Fatal(err)
+	}
+	return append([]byte(nil), buf.Bytes()...)
+}
+
+func packet(data []byte) gopacket.Packet {
+	p := gopacket.NewPacket(data, layers.LinkTypeEthernet, gopacket.Default)
+	p.Metadata().CaptureInfo = gopacket.CaptureInfo{Timestamp: time.Unix(1700000000, 0), CaptureLength: len(data), Length: len(data)}
+	return p
+}
+
+func TestDefragmenter(t *testing.T) {
+	tests := []struct {
+		name  string
+		order []int
+		// complete is the index in order after which the datagram is reassembled, -1 if never
+		complete int
+	}{
+		{"in order", []int{0, 1, 2}, 2},
+		{"reversed", []int{2, 1, 0}, 2},
+		{"last first", []int{2, 0, 1}, 2},
+		{"duplicated", []int{0, 0, 1, 1, 2}, 4},
+		{"retransmitted before the hole is filled", []int{0, 0, 2, 1}, 3},
+		{"missing fragment", []int{0, 2, 2}, -1},
+	}
+
+	for _, ipv6 := range []bool{false, true} {
+		fragments, want := fragmentedPackets(t, ipv6)
+		for _, tt := range tests {
+			name := tt.name
+			if ipv6 {
+				name = "ipv6 " + name
+			} else {
+				name = "ipv4 " + name
+			}
+
+			t.Run(name, func(t *testing.T) {
+				defrag := reliquary.NewDefragmenter()
+				complete := -1
+				for i, idx := range tt.order {
+					out, err := defrag.Defrag(fragments[idx])
+					if err != nil {
+						t.Fatalf("fragment %d: %v", idx, err)
+					}
+					if out == nil {
+						continue
+					}
+					if complete >= 0 {
+						t.Fatalf("fragment %d reassembled the datagram again", idx)
+					}
+					complete = i
+
+					udp, ok := out.Layer(layers.LayerTypeUDP).(*layers.UDP)
+					if !ok {
+						t.Fatal("reassembled packet has no udp layer")
+					}
+					if !bytes.Equal(udp.Payload, want) {
+						t.Fatalf("reassembled %d bytes, want %d", len(udp.Payload), len(want))
+					}
+				}
+				if complete != tt.complete {
+					t.Fatalf("reassembled after fragment %d, want %d", complete, tt.complete)
+				}
+			})
+		}
+	}
+}
+
+func TestDefragmenterPassesWholePackets(t *testing.T) {
+	eth := &layers.Ethernet{
+		SrcMAC:       net.HardwareAddr{0x02, 0, 0, 0, 0, 1},
+		DstMAC:       net.HardwareAddr{0x02, 0, 0, 0, 0, 2},
+		EthernetType: layers.EthernetTypeIPv4,
+	}
+	ip := &layers.IPv4{Version: 4, IHL: 5, TTL: 64, Protocol: layers.IPProtocolUDP, SrcIP: net.IP{192, 168, 1, 10}, DstIP: net.IP{47, 100, 1, 1}}
+	udp := &layers.UDP{SrcPort: 51234, DstPort: 23301}
+	_ = udp.SetNetworkLayerForChecksum(ip)
+
+	in := packet(serialize(t, eth, ip, udp, gopacket.Payload("not fragmented")))
+	out, err := reliquary.NewDefragmenter().Defrag(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out != in {
+		t.Fatal("unfragmented packet was changed")
+	}
+}
+
+func TestSnifferReadsFragmentedCommands(t *testing.T) {
+	for _, ipv6 := range []bool{false, true} {
+		session := reliquarytest.NewSession()
+		if ipv6 {
+			session.Client = netip.MustParseAddrPort("[2001:db8::10]:51234")
+			session.Server = netip.MustParseAddrPort("[2001:db8::1]:23301")
+		}
+		session.Handshake().
+			FromClientData(reliquary.GetBagCsReq, nil, reliquarytest.Fragmented(500)).
+			FromServerData(reliquary.GetBagScRsp, bytes.Repeat([]byte{0x42}, 4000), reliquarytest.Fragmented(500), reliquarytest.Reordered())
+
+		got := readCommands(t, session)
+		if len(got) != 2 {
+			t.Fatalf("ipv6=%t: read %d commands, want 2", ipv6, len(got))
+		}
+		if got[1].Id != reliquary.GetBagScRsp || len(got[1].ProtoData) != 4000 {
+			t.Fatalf("ipv6=%t: read command %d with %d bytes", ipv6, got[1].Id, len(got[1].ProtoData))
+		}
+	}
+}
+
+// readCommands feeds every packet of the session into a new Sniffer, and returns the commands it read
+func readCommands(t *testing.T, session *reliquarytest.Session) []reliquary.GameCommand {
+	t.Helper()
+
+	packets, err := session.Packets()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sniffer := &reliquary.Sniffer{}
+	var commands []reliquary.GameCommand
+	for i, p := range packets {
+		gp, err := sniffer.ReadPacket(p)
+		if err != nil {
+			t.Fatalf("packet %d: %v", i, err)
+		}
+		if cp, ok := gp.(*reliquary.CommandsPacket); ok {
+			commands = append(commands, cp.Commands...)
+		}
+	}
+	return commands
+}
@@ -1,10 +1,5 @@
 package reliquary
 
-import (
-	"github.com/google/gopacket/layers"
-	"slices"
-)
-
 type Direction byte
 
 const (
@@ -25,19 +20,3 @@ func (d Direction) String() string {
 		return "Unknown (unset)"
 	}
 }
-
-func directionFromUdp(udp *layers.UDP) Direction {
-	if slices.Contains(PORTS, udp.DstPort.String()) {
-		return Received
-	}
-
-	if slices.Contains(PORTS, udp.SrcPort.String()) {
-		return Send
-	}
-
-	logger.Warn().
-		Str("dst", udp.DstPort.String()).
-		Str("src", udp.SrcPort.String()).
-		Msg("packet found with unknown direction")
-	return Unknown
-}
@@ -0,0 +1,155 @@
+package reliquary
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"github.com/fatedier/kcp-go"
+	"google.golang.org/protobuf/proto"
+	"math"
+)
+
+const (
+	// KCP_HEADER_LEN is the KCP header as used by the game, the regular 24 byte header with a token after the conv
+	KCP_HEADER_LEN = 28
+	// KCP_MSS is the largest payload of a single segment
+	KCP_MSS = 1400 - KCP_HEADER_LEN
+
+	kcpMaxFragments = 255
+	kcpDefaultWnd   = 1024
+)
+
+var (
+	CommandTooLarge  = errors.New("command too large to encode")
+	MalformedCommand = errors.New("malformed command")
+)
+
+// EncodeCommand marshals msg and frames it as a command, see gameCommandFromData for the layout.
+// The result is not encrypted, use Key.Encrypt for that
+func EncodeCommand(id uint16, head []byte, msg proto.Message) ([]byte, error) {
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	return EncodeCommandData(id, head, data)
+}
+
+// EncodeCommandData frames already marshalled proto data as a command
+func EncodeCommandData(id uint16, head []byte, data []byte) ([]byte, error) {
+	if len(head) > math.MaxUint16 || uint64(len(data)) > math.MaxUint32-HEADER_OVERHEAD-uint64(len(head)) {
+		return nil, CommandTooLarge
+	}
+
+	out := make([]byte, HEADER_LEN, HEADER_OVERHEAD+len(head)+len(data))
+	binary.BigEndian.PutUint32(out[0:4], HEAD_MAGIC)
+	binary.BigEndian.PutUint16(out[4:6], id)
+	binary.BigEndian.PutUint16(out[6:8], uint16(len(head)))
+	binary.BigEndian.PutUint32(out[8:12], uint32(len(data)))
+	out = append(out, head...)
+	out = append(out, data...)
+	out = binary.BigEndian.AppendUint32(out, TAIL_MAGIC)
+	return out, nil
+}
+
+// DecodeCommand parses a decrypted command, the inverse of EncodeCommandData
+func DecodeCommand(command []byte) (*GameCommand, error) {
+	if !validFraming(command) {
+		return nil, fmt.Errorf("%w: invalid framing", MalformedCommand)
+	}
+	return gameCommandFromData(command)
+}
+
+// KcpSegmenter wraps messages into the game's KCP segments, one direction of a conversation
+type KcpSegmenter struct {
+	Conv  uint32
+	Token uint32
+	// Mss defaults to KCP_MSS
+	Mss int
+	// Una is written into every segment, the next sequence number expected from the other side
+	Una uint32
+
+	sn uint32
+}
+
+// Segments splits a message into PUSH segments with consecutive sequence numbers.
+// Each segment is a full UDP payload, but several can be concatenated into a single datagram
+func (ks *KcpSegmenter) Segments(message []byte, ts uint32) ([][]byte, error) {
+	mss := ks.Mss
+	if mss <= 0 {
+		mss = KCP_MSS
+	}
+
+	count := max(1, (len(message)+mss-1)/mss)
+	if count > kcpMaxFragments {
+		return nil, fmt.Errorf("%w: %d fragments", CommandTooLarge, count)
+	}
+
+	segments := make([][]byte, 0, count)
+	for i := 0; i < count; i++ {
+		content := message[i*mss : min((i+1)*mss, len(message))]
+		segments = append(segments, ks.segment(kcp.IKCP_CMD_PUSH, byte(count-i-1), ts, ks.sn, content))
+		ks.sn++
+	}
+	return segments, nil
+}
+
+// Ack returns an ACK segment for sn, the sniffer ignores these but they make captures look realistic
+func (ks *KcpSegmenter) Ack(sn uint32, ts uint32) []byte {
+	return ks.segment(kcp.IKCP_CMD_ACK, 0, ts, sn, nil)
+}
+
+// NextSn returns the sequence number of the next segment
+func (ks *KcpSegmenter) NextSn() uint32 {
+	return ks.sn
+}
+
+// segment encodes a single segment with the game's header
+//
+//	## Bit Layout (little endian)
+//	| Bit indices     |  Type |  Name |
+//	| - | - | - |
+//	|   0:4      |  `uint32`  |  Conv |
+//	|   4:8      |  `uint32`  |  Token |
+//	|   8:9      |  `uint8`   |  Cmd |
+//	|   9:10     |  `uint8`   |  Frg |
+//	|   10:12    |  `uint16`  |  Wnd |
+//	|   12:16    |  `uint32`  |  Ts |
+//	|   16:20    |  `uint32`  |  Sn |
+//	|   20:24    |  `uint32`  |  Una |
+//	|   24:28    |  `uint32`  |  Len |
+func (ks *KcpSegmenter) segment(cmd byte, frg byte, ts uint32, sn uint32, content []byte) []byte {
+	out := make([]byte, KCP_HEADER_LEN, KCP_HEADER_LEN+len(content))
+	binary.LittleEndian.PutUint32(out[0:4], ks.Conv)
+	binary.LittleEndian.PutUint32(out[4:8], ks.Token)
+	out[8] = cmd
+	out[9] = frg
+	binary.LittleEndian.PutUint16(out[10:12], kcpDefaultWnd)
+	binary.LittleEndian.PutUint32(out[12:16], ts)
+	binary.LittleEndian.PutUint32(out[16:20], sn)
+	binary.LittleEndian.PutUint32(out[20:24], ks.Una)
+	binary.LittleEndian.PutUint32(out[24:28], uint32(len(content)))
+	return append(out, content...)
+}
+
+// EncodeHandshake is the inverse of parseHandshake
+func EncodeHandshake(h Handshake) []byte {
+	out := make([]byte, 0, HANDSHAKE_LEN)
+	out = binary.BigEndian.AppendUint32(out, h.Magic)
+	out = binary.BigEndian.AppendUint32(out, h.Conv)
+	out = binary.BigEndian.AppendUint32(out, h.Token)
+	out = binary.BigEndian.AppendUint32(out, h.Data)
+	out = binary.BigEndian.AppendUint32(out, h.MagicEnd)
+	return out
+}
+
+// DecodeHandshake parses a handshake datagram, the inverse of EncodeHandshake
+func DecodeHandshake(payload []byte) (Handshake, error) {
+	h, err := parseHandshake(payload)
+	if err != nil {
+		return Handshake{}, err
+	}
+	if _, err = h.Type(); err != nil {
+		return Handshake{}, err
+	}
+	return *h, nil
+}
@@ -0,0 +1,63 @@
+package fribbels
+
+import (
+	"github.com/Fesaa/go-reliquary"
+	"github.com/Fesaa/go-reliquary/gamedata"
+	"github.com/Fesaa/go-reliquary/state"
+	"sync"
+)
+
+// AutoExporter applies commands to an Account, and emits an export every time the login responses
+// for both the inventory and the roster have been seen since the last export, so once per login.
+// Safe for concurrent use
+type AutoExporter struct {
+	Account *state.Account
+	Data    *gamedata.Resolver
+	// Emit receives every export, err reports items that were left out as with New. It may be nil
+	Emit func(export *Export, err error)
+
+	mu         sync.Mutex
+	sawBag     bool
+	sawAvatars bool
+}
+
+func NewAutoExporter(data *gamedata.Resolver, emit func(export *Export, err error)) *AutoExporter {
+	return &AutoExporter{
+		Account: state.NewAccount(),
+		Data:    data,
+		Emit:    emit,
+	}
+}
+
+// Apply passes the commands on to the Account, and emits an export when the account became complete
+func (ae *AutoExporter) Apply(commands ...reliquary.GameCommand) error {
+	ae.mu.Lock()
+	snapshot, complete, err := ae.apply(commands)
+	ae.mu.Unlock()
+
+	if complete && ae.Emit != nil {
+		ae.Emit(New(snapshot, ae.Data))
+	}
+	return err
+}
+
+// apply returns the snapshot to export when the account became complete
+func (ae *AutoExporter) apply(commands []reliquary.GameCommand) (state.Snapshot, bool, error) {
+	for _, command := range commands {
+		ae.sawBag = ae.sawBag || command.Id == reliquary.GetBagScRsp
+		ae.sawAvatars = ae.sawAvatars || command.Id == reliquary.GetAvatarDataScRsp
+	}
+
+	err := ae.Account.Apply(commands...)
+	if !ae.sawBag || !ae.sawAvatars {
+		return state.Snapshot{}, false, err
+	}
+
+	// A failed response doesn't seed the account, wait for the next one
+	snapshot := ae.Account.Snapshot()
+	if !snapshot.Seeded || !snapshot.AvatarsSeeded {
+		return state.Snapshot{}, false, err
+	}
+	ae.sawBag, ae.sawAvatars = false, false
+	return snapshot, true, err
+}
@@ -0,0 +1,327 @@
+// Package fribbels exports an account in the scanner JSON format of the Fribbels HSR Optimizer,
+// the same output reliquary-archiver produces
+package fribbels
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/Fesaa/go-reliquary/gamedata"
+	"github.com/Fesaa/go-reliquary/state"
+	"io"
+	"strconv"
+)
+
+const (
+	// SOURCE is what the optimizer checks to accept an import, it only knows the scanners it supports
+	SOURCE         = "reliquary_archiver"
+	BUILD          = "go-reliquary"
+	FORMAT_VERSION = 4
+
+	trailblazerBaseId = 8001
+)
+
+var (
+	UnknownRelic     = errors.New("relic not in game data")
+	UnknownLightCone = errors.New("light cone not in game data")
+	UnknownAvatar    = errors.New("avatar not in game data")
+)
+
+type Export struct {
+	Source     string      `json:"source"`
+	Build      string      `json:"build"`
+	Version    int         `json:"version"`
+	Metadata   Metadata    `json:"metadata"`
+	LightCones []LightCone `json:"light_cones"`
+	Relics     []Relic     `json:"relics"`
+	Characters []Character `json:"characters"`
+}
+
+type Metadata struct {
+	Uid                    *uint32 `json:"uid"`
+	Trailblazer            string  `json:"trailblazer,omitempty"`
+	CurrentTrailblazerPath string  `json:"current_trailblazer_path,omitempty"`
+}
+
+type Relic struct {
+	SetId    string    `json:"set_id"`
+	Name     string    `json:"name"`
+	Slot     string    `json:"slot"`
+	Rarity   uint32    `json:"rarity"`
+	Level    uint32    `json:"level"`
+	MainStat string    `json:"mainstat"`
+	SubStats []SubStat `json:"substats"`
+	Location string    `json:"location"`
+	Lock     bool      `json:"lock"`
+	Discard  bool      `json:"discard"`
+	Uid      string    `json:"_uid"`
+}
+
+type SubStat struct {
+	Key   string  `json:"key"`
+	Value float64 `json:"value"`
+	Count uint32  `json:"count"`
+	Step  uint32  `json:"step"`
+}
+
+type LightCone struct {
+	Id              string `json:"id"`
+	Name            string `json:"name"`
+	Level           uint32 `json:"level"`
+	Ascension       uint32 `json:"ascension"`
+	Superimposition uint32 `json:"superimposition"`
+	Location        string `json:"location"`
+	Lock            bool   `json:"lock"`
+	Uid             string `json:"_uid"`
+}
+
+type Character struct {
+	Id        string `json:"id"`
+	Name      string `json:"name"`
+	Path      string `json:"path"`
+	Level     uint32 `json:"level"`
+	Ascension uint32 `json:"ascension"`
+	Eidolon   uint32 `json:"eidolon"`
+	Skills    Skills `json:"skills"`
+	Traces    Traces `json:"traces"`
+}
+
+type Skills struct {
+	Basic  uint32 `json:"basic"`
+	Skill  uint32 `json:"skill"`
+	Ult    uint32 `json:"ult"`
+	Talent uint32 `json:"talent"`
+}
+
+// Traces are the unlocked ability and stat bonus nodes of the skill tree
+type Traces struct {
+	Ability1 bool `json:"ability_1"`
+	Ability2 bool `json:"ability_2"`
+	Ability3 bool `json:"ability_3"`
+	Stat1    bool `json:"stat_1"`
+	Stat2    bool `json:"stat_2"`
+	Stat3    bool `json:"stat_3"`
+	Stat4    bool `json:"stat_4"`
+	Stat5    bool `json:"stat_5"`
+	Stat6    bool `json:"stat_6"`
+	Stat7    bool `json:"stat_7"`
+	Stat8    bool `json:"stat_8"`
+	Stat9    bool `json:"stat_9"`
+	Stat10   bool `json:"stat_10"`
+}
+
+var slots = map[gamedata.Slot]string{
+	gamedata.HEAD:   "Head",
+	gamedata.HAND:   "Hands",
+	gamedata.BODY:   "Body",
+	gamedata.FOOT:   "Feet",
+	gamedata.NECK:   "Planar Sphere",
+	gamedata.OBJECT: "Link Rope",
+}
+
+// statKeys are the optimizer's names for properties, percentages end in an underscore
+var statKeys = map[gamedata.Property]string{
+	"HPDelta":                   "HP",
+	"AttackDelta":               "ATK",
+	"DefenceDelta":              "DEF",
+	"HPAddedRatio":              "HP_",
+	"AttackAddedRatio":          "ATK_",
+	"DefenceAddedRatio":         "DEF_",
+	"SpeedDelta":                "SPD",
+	"CriticalChanceBase":        "CRIT Rate_",
+	"CriticalDamageBase":        "CRIT DMG_",
+	"StatusProbabilityBase":     "Effect Hit Rate_",
+	"StatusResistanceBase":      "Effect RES_",
+	"BreakDamageAddedRatioBase": "Break Effect_",
+	"SPRatioBase":               "Energy Regeneration Rate_",
+	"HealRatioBase":             "Outgoing Healing Boost_",
+	"PhysicalAddedRatio":        "Physical DMG Boost_",
+	"FireAddedRatio":            "Fire DMG Boost_",
+	"IceAddedRatio":             "Ice DMG Boost_",
+	"ThunderAddedRatio":         "Lightning DMG Boost_",
+	"WindAddedRatio":            "Wind DMG Boost_",
+	"QuantumAddedRatio":         "Quantum DMG Boost_",
+	"ImaginaryAddedRatio":       "Imaginary DMG Boost_",
+}
+
+// StatKey returns the optimizer's name for a property, the property itself when unknown
+func StatKey(property gamedata.Property) string {
+	if key, ok := statKeys[property]; ok {
+		return key
+	}
+	return string(property)
+}
+
+// New builds an export from a snapshot. Items missing from the game data are left out,
+// and reported in the returned error next to the export
+func New(snapshot state.Snapshot, data *gamedata.Resolver) (*Export, error) {
+	export := &Export{
+		Source:     SOURCE,
+		Build:      BUILD,
+		Version:    FORMAT_VERSION,
+		LightCones: []LightCone{},
+		Relics:     []Relic{},
+		Characters: []Character{},
+	}
+
+	if snapshot.Uid != 0 {
+		uid := snapshot.Uid
+		export.Metadata.Uid = &uid
+	}
+	if current, ok := snapshot.CurrentPath(trailblazerBaseId); ok {
+		// The paths alternate between Caelus and Stelle
+		export.Metadata.Trailblazer = "Stelle"
+		if current.Id%2 == 1 {
+			export.Metadata.Trailblazer = "Caelus"
+		}
+		if avatar, ok := data.Avatar(current.Id); ok {
+			export.Metadata.CurrentTrailblazerPath = gamedata.PathName(avatar.Path)
+		}
+	}
+
+	var errs []error
+	for _, relic := range snapshot.Relics() {
+		r, err := newRelic(relic, data)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		export.Relics = append(export.Relics, r)
+	}
+
+	for _, lightCone := range snapshot.LightCones() {
+		if _, ok := data.LightCone(lightCone.Id); !ok {
+			errs = append(errs, fmt.Errorf("%w: %d", UnknownLightCone, lightCone.Id))
+			continue
+		}
+		export.LightCones = append(export.LightCones, LightCone{
+			Id:              strconv.FormatUint(uint64(lightCone.Id), 10),
+			Name:            data.LightConeName(lightCone.Id),
+			Level:           lightCone.Level,
+			Ascension:       lightCone.Promotion,
+			Superimposition: lightCone.Rank,
+			Location:        location(lightCone.EquippedAvatarId),
+			Lock:            lightCone.Locked,
+			Uid:             "light_cone_" + strconv.FormatUint(uint64(lightCone.UniqueId), 10),
+		})
+	}
+
+	for _, avatar := range snapshot.Avatars() {
+		info, ok := data.Avatar(avatar.Id)
+		if !ok {
+			errs = append(errs, fmt.Errorf("%w: %d", UnknownAvatar, avatar.Id))
+			continue
+		}
+		character := Character{
+			Id:        strconv.FormatUint(uint64(avatar.Id), 10),
+			Name:      data.AvatarName(avatar.Id),
+			Path:      gamedata.PathName(info.Path),
+			Level:     avatar.Level,
+			Ascension: avatar.Promotion,
+			Eidolon:   avatar.Rank,
+		}
+		for pointId, level := range avatar.Traces {
+			character.addTrace(pointId, level, data)
+		}
+		export.Characters = append(export.Characters, character)
+	}
+	return export, errors.Join(errs...)
+}
+
+// addTrace sets the skill or trace at a skill tree point by its anchor, the same way reliquary-archiver does.
+// Points missing from the game data, and the technique, are left out
+func (c *Character) addTrace(pointId uint32, level uint32, data *gamedata.Resolver) {
+	point, ok := data.SkillTreePoint(pointId)
+	if !ok {
+		return
+	}
+
+	skills := map[string]*uint32{
+		"Point01": &c.Skills.Basic,
+		"Point02": &c.Skills.Skill,
+		"Point03": &c.Skills.Ult,
+		"Point04": &c.Skills.Talent,
+	}
+	if skill, ok := skills[point.Anchor]; ok {
+		*skill = level
+		return
+	}
+
+	traces := map[string]*bool{
+		"Point06": &c.Traces.Ability1,
+		"Point07": &c.Traces.Ability2,
+		"Point08": &c.Traces.Ability3,
+		"Point09": &c.Traces.Stat1,
+		"Point10": &c.Traces.Stat2,
+		"Point11": &c.Traces.Stat3,
+		"Point12": &c.Traces.Stat4,
+		"Point13": &c.Traces.Stat5,
+		"Point14": &c.Traces.Stat6,
+		"Point15": &c.Traces.Stat7,
+		"Point16": &c.Traces.Stat8,
+		"Point17": &c.Traces.Stat9,
+		"Point18": &c.Traces.Stat10,
+	}
+	if trace, ok := traces[point.Anchor]; ok {
+		*trace = level > 0
+	}
+}
+
+func newRelic(relic state.Relic, data *gamedata.Resolver) (Relic, error) {
+	info, ok := data.Relic(relic.Id)
+	if !ok {
+		return Relic{}, fmt.Errorf("%w: %d", UnknownRelic, relic.Id)
+	}
+	mainAffix, ok := data.MainAffix(relic.Id, relic.MainAffixId)
+	if !ok {
+		return Relic{}, fmt.Errorf("%w: %d has no main affix %d", UnknownRelic, relic.Id, relic.MainAffixId)
+	}
+
+	subStats := make([]SubStat, 0, len(relic.SubAffixes))
+	for _, sub := range relic.SubAffixes {
+		affix, ok := data.SubAffix(relic.Id, sub.Id)
+		if !ok {
+			return Relic{}, fmt.Errorf("%w: %d has no sub affix %d", UnknownRelic, relic.Id, sub.Id)
+		}
+
+		value := affix.Value(sub.Count, sub.Step)
+		if affix.Property.Percent() {
+			value *= 100
+		}
+		subStats = append(subStats, SubStat{
+			Key:   StatKey(affix.Property),
+			Value: value,
+			Count: sub.Count,
+			Step:  sub.Step,
+		})
+	}
+
+	return Relic{
+		SetId:    strconv.FormatUint(uint64(info.SetId), 10),
+		Name:     data.RelicSetName(info.SetId),
+		Slot:     slots[info.Slot],
+		Rarity:   info.Rarity,
+		Level:    relic.Level,
+		MainStat: StatKey(mainAffix.Property),
+		SubStats: subStats,
+		Location: location(relic.EquippedAvatarId),
+		Lock:     relic.Locked,
+		Discard:  relic.Discarded,
+		Uid:      "relic_" + strconv.FormatUint(uint64(relic.UniqueId), 10),
+	}, nil
+}
+
+// location is the id of the avatar an item is equipped on, empty if it isn't
+func location(avatarId uint32) string {
+	if avatarId == 0 {
+		return ""
+	}
+	return strconv.FormatUint(uint64(avatarId), 10)
+}
+
+// Write writes the export as indented JSON
+func (e *Export) Write(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(e)
+}
@@ -0,0 +1,177 @@
+package fribbels_test
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"github.com/Fesaa/go-reliquary"
+	"github.com/Fesaa/go-reliquary/fribbels"
+	"github.com/Fesaa/go-reliquary/gamedata"
+	"github.com/Fesaa/go-reliquary/pb"
+	"github.com/Fesaa/go-reliquary/reliquarytest"
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files in testdata")
+
+func loadData(t *testing.T) *gamedata.Resolver {
+	t.Helper()
+
+	fsys := fstest.MapFS{
+		"ExcelOutput/RelicConfig.json": {Data: []byte(`[
+			{"ID": 61011, "SetID": 101, "Type": "HEAD", "Rarity": "CombatPowerRelicRarity5", "MaxLevel": 15, "MainAffixGroup": 1, "SubAffixGroup": 5}
+		]`)},
+		"ExcelOutput/RelicMainAffixConfig.json": {Data: []byte(`{"1": {"1": {"GroupID": 1, "AffixID": 1, "Property": "HPDelta", "BaseValue": {"Value": 112.896}, "LevelAdd": {"Value": 39.5136}}}}`)},
+		"ExcelOutput/RelicSubAffixConfig.json": {Data: []byte(`[
+			{"GroupID": 5, "AffixID": 1, "Property": "HPDelta", "BaseValue": {"Value": 33.87}, "StepValue": {"Value": 4.234}, "StepNum": 2},
+			{"GroupID": 5, "AffixID": 8, "Property": "CriticalChanceBase", "BaseValue": {"Value": 0.0259}, "StepValue": {"Value": 0.0032}, "StepNum": 2}
+		]`)},
+		"ExcelOutput/RelicSetConfig.json":  {Data: []byte(`[{"SetID": 101, "SetName": {"Hash": 1}}]`)},
+		"ExcelOutput/EquipmentConfig.json": {Data: []byte(`[{"EquipmentID": 23001, "EquipmentName": {"Hash": 2}, "Rarity": "CombatPowerLightconeRarity5", "AvatarBaseType": "Rogue"}]`)},
+		"ExcelOutput/AvatarConfig.json": {Data: []byte(`[
+			{"AvatarID": 1102, "AvatarName": {"Hash": 3}, "Rarity": "CombatPowerAvatarRarityType5", "AvatarBaseType": "Rogue"},
+			{"AvatarID": 8002, "AvatarName": {"Hash": 4}, "Rarity": "CombatPowerAvatarRarityType5", "AvatarBaseType": "Warrior"}
+		]`)},
+		"ExcelOutput/AvatarSkillTreeConfig.json": {Data: []byte(`[
+			{"PointID": 1102001, "AvatarID": 1102, "Anchor": "Point01", "MaxLevel": 6},
+			{"PointID": 1102002, "AvatarID": 1102, "Anchor": "Point02", "MaxLevel": 10},
+			{"PointID": 1102101, "AvatarID": 1102, "Anchor": "Point06", "MaxLevel": 1},
+			{"PointID": 1102201, "AvatarID": 1102, "Anchor": "Point09", "MaxLevel": 1},
+			{"PointID": 1102301, "AvatarID": 1102, "Anchor": "Point05", "MaxLevel": 1}
+		]`)},
+		"ExcelOutput/ItemConfig.json": {Data: []byte(`[]`)},
+		"TextMap/TextMapEN.json":      {Data: []byte(`{"1": "Passerby of Wandering Cloud", "2": "In the Night", "3": "Seele", "4": "{NICKNAME}"}`)},
+	}
+	data, err := gamedata.LoadFS(fsys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+// login sends what the game sends on login, with a relic and a light cone the game data doesn't know
+func login(s *reliquarytest.Session) {
+	s.Login(1)
+	s.FromServer(reliquary.GetBagScRsp, &pb.GetBagScRsp{
+		RelicList: []*pb.Relic{
+			{
+				UniqueID:         1,
+				ID:               61011,
+				Level:            15,
+				MainAffixID:      1,
+				SubAffixList:     []*pb.SubAffix{{AffixID: 1, Cnt: 2, Step: 3}, {AffixID: 8, Cnt: 1}},
+				Locked:           true,
+				EquippedAvatarID: 1102,
+			},
+			{UniqueID: 2, ID: 61011, MainAffixID: 1, Discard: true},
+			{UniqueID: 3, ID: 99999},
+		},
+		LightConeList: []*pb.LightCone{
+			{UniqueID: 10, ID: 23001, Level: 80, Rank: 1, Promotion: 6, EquippedAvatarID: 1102},
+			{UniqueID: 11, ID: 99999},
+		},
+	})
+	s.FromServer(reliquary.GetAvatarDataScRsp, &pb.GetAvatarDataScRsp{
+		IsGetAll: true,
+		AvatarList: []*pb.Avatar{
+			{
+				CharacterId:       1102,
+				Level:             80,
+				Promotion:         6,
+				Rank:              2,
+				EquippedRelicList: []*pb.EquippedRelic{{Slot: 1, RelicUniqueId: 1}},
+				SkillTreeList: []*pb.SkillTreeNode{
+					{PointId: 1102001, Level: 6},
+					{PointId: 1102002, Level: 10},
+					{PointId: 1102101, Level: 1},
+					{PointId: 1102201, Level: 1},
+					{PointId: 1102301, Level: 1},
+				},
+				EquipmentUniqueId: 10,
+			},
+			{CharacterId: 8001, Level: 70},
+		},
+		MultiPathAvatarList: []*pb.MultiPathAvatar{{AvatarId: 8002}},
+	})
+	s.FromServer(reliquary.AvatarPathChangedNotify, &pb.AvatarPathChangedNotify{CharacterId: 8001, CurMultiPathAvatarType: 8002})
+}
+
+func TestAutoExporter(t *testing.T) {
+	var exports []*fribbels.Export
+	var errs []error
+	exporter := fribbels.NewAutoExporter(loadData(t), func(export *fribbels.Export, err error) {
+		exports = append(exports, export)
+		errs = append(errs, err)
+	})
+	session := reliquarytest.NewSession()
+	login(session)
+	if err := session.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if err := exporter.Apply(session.GameCommands()...); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(exports) != 1 {
+		t.Fatalf("%d exports, want 1", len(exports))
+	}
+	for _, want := range []error{fribbels.UnknownRelic, fribbels.UnknownLightCone} {
+		if !errors.Is(errs[0], want) {
+			t.Errorf("error %v, want %v", errs[0], want)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := exports[0].Write(&buf); err != nil {
+		t.Fatal(err)
+	}
+	compareGolden(t, "export", buf.Bytes())
+
+	// The next export waits for the next login
+	again := reliquarytest.NewSession()
+	again.FromServer(reliquary.GetBagScRsp, &pb.GetBagScRsp{})
+	if err := exporter.Apply(again.GameCommands()...); err != nil {
+		t.Fatal(err)
+	}
+	if len(exports) != 1 {
+		t.Errorf("%d exports after another bag, want 1", len(exports))
+	}
+}
+
+func TestAutoExporterWithoutEmit(t *testing.T) {
+	exporter := fribbels.NewAutoExporter(loadData(t), nil)
+	session := reliquarytest.NewSession()
+	login(session)
+	if err := exporter.Apply(session.GameCommands()...); err != nil {
+		t.Fatal(err)
+	}
+	if relics := exporter.Account.Snapshot().Relics(); len(relics) != 3 {
+		t.Errorf("%d relics, want 3", len(relics))
+	}
+}
+
+// compareGolden compares an export with testdata/<name>.golden
+func compareGolden(t *testing.T, name string, got []byte) {
+	t.Helper()
+
+	path := filepath.Join("testdata", name+".golden")
+	if *update {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, got, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("%v, run the tests with -update to create it", err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("%s differs, run the tests with -update to see the changes\ngot:\n%s\nwant:\n%s", path, got, want)
+	}
+}
@@ -0,0 +1,188 @@
+package reliquary
+
+// Fuzz targets for every parsing stage, none of them may panic. The corpus in testdata/fuzz holds captures
+// from reliquarytest, rewrite it with
+//
+//	go test -run TestFuzzCorpus -update
+//
+// and fuzz a single stage with
+//
+//	go test -run '^$' -fuzz FuzzReadPacket
+
+import (
+	"encoding/binary"
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	"github.com/rs/zerolog"
+	"net"
+	"testing"
+)
+
+// seedSegments returns the KCP segments of a command split in two, and of a command without data
+func seedSegments(f *testing.F) [][]byte {
+	f.Helper()
+
+	segmenter := &KcpSegmenter{Conv: 0x12345678, Token: 0x0BADF00D, Mss: 64}
+	command, err := EncodeCommandData(PlayerHeartBeatCsReq, nil, make([]byte, 100))
+	if err != nil {
+		f.Fatal(err)
+	}
+	segments, err := segmenter.Segments(command, 1)
+	if err != nil {
+		f.Fatal(err)
+	}
+	empty, err := EncodeCommandData(PlayerHeartBeatScRsp, nil, nil)
+	if err != nil {
+		f.Fatal(err)
+	}
+	last, err := segmenter.Segments(empty, 2)
+	if err != nil {
+		f.Fatal(err)
+	}
+	return append(segments, last...)
+}
+
+func seedHandshakes(f *testing.F) [][]byte {
+	f.Helper()
+
+	var out [][]byte
+	for _, t := range []ConnectionType{HandshakeRequested, HandshakeEstablished, Disconnected} {
+		h, err := NewHandshake(t, 0x12345678, 0x0BADF00D, 1)
+		if err != nil {
+			f.Fatal(err)
+		}
+		out = append(out, EncodeHandshake(h))
+	}
+	return out
+}
+
+// seedFrame wraps a UDP payload to the game server in an Ethernet frame
+func seedFrame(f *testing.F, payload []byte) []byte {
+	f.Helper()
+
+	eth := &layers.Ethernet{
+		SrcMAC:       net.HardwareAddr{0x02, 0, 0, 0, 0, 1},
+		DstMAC:       net.HardwareAddr{0x02, 0, 0, 0, 0, 2},
+		EthernetType: layers.EthernetTypeIPv4,
+	}
+	ip := &layers.IPv4{
+		Version:  4,
+		IHL:      5,
+		TTL:      64,
+		Protocol: layers.IPProtocolUDP,
+		SrcIP:    net.IP{192, 168, 1, 10},
+		DstIP:    net.IP{47, 100, 1, 1},
+	}
+	udp := &layers.UDP{SrcPort: 51234, DstPort: 23301}
+	_ = udp.SetNetworkLayerForChecksum(ip)
+
+	buf := gopacket.NewSerializeBuffer()
+	opts := gopacket.SerializeOptions{FixLengths: true, ComputeChecksums: true}
+	if err := gopacket.SerializeLayers(buf, opts, eth, ip, udp, gopacket.Payload(payload)); err != nil {
+		f.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func FuzzConnectionPacket(f *testing.F) {
+	SetLogLevel(zerolog.Disabled)
+	for _, payload := range append(seedHandshakes(f), seedSegments(f)...) {
+		f.Add(seedFrame(f, payload))
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		packet := gopacket.NewPacket(data, layers.LinkTypeEthernet, gopacket.Default)
+		_, _ = parseConnectionPacket(packet, &serverMatcher{})
+	})
+}
+
+func FuzzKcpSegments(f *testing.F) {
+	SetLogLevel(zerolog.Disabled)
+	segments := seedSegments(f)
+	for _, segment := range segments {
+		f.Add(segment)
+	}
+	f.Add(append(append([]byte(nil), segments[0]...), segments[1]...))
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		ks := &kcpSniffer{logger: logger}
+		_, _ = ks.reformatKcpSegments(data)
+	})
+}
+
+func FuzzValidateKcpSegment(f *testing.F) {
+	SetLogLevel(zerolog.Disabled)
+	for _, segment := range seedSegments(f) {
+		f.Add(segment)
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		_, _ = validateKcpSegment(data)
+	})
+}
+
+func FuzzGameCommand(f *testing.F) {
+	SetLogLevel(zerolog.Disabled)
+	for _, data := range [][]byte{nil, make([]byte, 10), make([]byte, 1000)} {
+		command, err := EncodeCommandData(GetBagScRsp, []byte{1, 2}, data)
+		if err != nil {
+			f.Fatal(err)
+		}
+		f.Add(command)
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		command, err := gameCommandFromData(data)
+		if err != nil {
+			return
+		}
+		if int(command.HeaderLen) != len(command.HeaderData) || int(command.DataLen) != len(command.ProtoData) {
+			t.Fatalf("lengths %d/%d do not match data %d/%d",
+				command.HeaderLen, command.DataLen, len(command.HeaderData), len(command.ProtoData))
+		}
+	})
+}
+
+func FuzzHandshake(f *testing.F) {
+	SetLogLevel(zerolog.Disabled)
+	for _, handshake := range seedHandshakes(f) {
+		f.Add(handshake)
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		handshake, err := parseHandshake(data)
+		if err != nil {
+			return
+		}
+		if _, err = handshake.Type(); err != nil {
+			return
+		}
+		if got, err := DecodeHandshake(EncodeHandshake(*handshake)); err != nil || got != *handshake {
+			t.Fatalf("handshake does not survive encoding: %v", err)
+		}
+	})
+}
+
+// FuzzReadPacket feeds a sequence of frames into a single Sniffer, each frame prefixed by its 2 byte length
+func FuzzReadPacket(f *testing.F) {
+	SetLogLevel(zerolog.Disabled)
+	var seed []byte
+	for _, payload := range append(seedHandshakes(f)[:2], seedSegments(f)...) {
+		frame := seedFrame(f, payload)
+		seed = binary.BigEndian.AppendUint16(seed, uint16(len(frame)))
+		seed = append(seed, frame...)
+	}
+	f.Add(seed)
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		sniffer := &Sniffer{}
+		sniffer.EnableKeyRecovery()
+
+		for len(data) >= 2 {
+			n := min(int(binary.BigEndian.Uint16(data)), len(data)-2)
+			packet := gopacket.NewPacket(data[2:2+n], layers.LinkTypeEthernet, gopacket.Default)
+			data = data[2+n:]
+			_, _ = sniffer.ReadPacket(packet)
+		}
+	})
+}
@@ -0,0 +1,374 @@
+// Package gacha records warps from the commands a reliquary.Sniffer reads, keeps the pity counters
+// and exports the history in the UIGF and SRGF formats community warp trackers import.
+//
+//	tracker, err := gacha.Open("warps.json", data)
+//	for packet := range capture.Packets {
+//		_ = tracker.Apply(packet.Commands...)
+//	}
+//	err = tracker.WriteUIGF(os.Stdout)
+//
+// Warps only show up when they're made with the capture running, the game never sends older ones
+package gacha
+
+import (
+	"cmp"
+	"errors"
+	"github.com/Fesaa/go-reliquary"
+	"github.com/Fesaa/go-reliquary/gamedata"
+	"github.com/Fesaa/go-reliquary/internal/accountfile"
+	"github.com/Fesaa/go-reliquary/internal/dispatch"
+	"github.com/Fesaa/go-reliquary/pb"
+	"slices"
+	"sync"
+	"time"
+)
+
+// FILE_VERSION is written to the history file, files from a newer version are refused
+const FILE_VERSION = 1
+
+var UnsupportedFile = errors.New("unsupported gacha history file")
+
+// Type is the gacha_type of UIGF and SRGF. Banners of the same type share their pity
+type Type uint32
+
+const (
+	STELLAR          Type = 1
+	DEPARTURE        Type = 2
+	CHARACTER_EVENT  Type = 11
+	LIGHT_CONE_EVENT Type = 12
+)
+
+// TypeOf returns the type of a banner from its gacha id, 0 when unknown
+func TypeOf(gachaId uint32) Type {
+	switch gachaId / 1000 {
+	case 1:
+		return STELLAR
+	case 2:
+		return CHARACTER_EVENT
+	case 3:
+		return LIGHT_CONE_EVENT
+	case 4:
+		return DEPARTURE
+	}
+	return 0
+}
+
+type Pull struct {
+	// Id is unique and increasing, the game doesn't send the ids its own history uses
+	Id      uint64    `json:"id"`
+	GachaId uint32    `json:"gacha_id"`
+	Type    Type      `json:"gacha_type"`
+	ItemId  uint32    `json:"item_id"`
+	Rarity  uint32    `json:"rarity"`
+	Time    time.Time `json:"time"`
+	// Pity is the number of pulls of the type it took to get a 4 or 5 star, counting this one. 0 for anything else
+	Pity uint32 `json:"pity,omitempty"`
+}
+
+// Pity is the progress towards the next guaranteed pulls of a type
+type Pity struct {
+	// Five and Four are the pulls since the last 5 star and since the last 4 or 5 star
+	Five uint32
+	Four uint32
+	// Ceiling is the pulls towards the 300 pull selector, only the stellar warp has one
+	Ceiling        uint32
+	CeilingClaimed bool
+}
+
+type Banner struct {
+	GachaId uint32
+	Type    Type
+	Begin   time.Time
+	End     time.Time
+}
+
+type history struct {
+	Pulls          []Pull `json:"pulls"`
+	Ceiling        uint32 `json:"ceiling"`
+	CeilingClaimed bool   `json:"ceiling_claimed"`
+	// Seeds are the pity counters the game last sent per type
+	Seeds map[Type]seed `json:"seeds,omitempty"`
+}
+
+// seed is a pity counter sent by the game, pulls recorded after it count on top
+type seed struct {
+	Five uint32 `json:"five"`
+	Four uint32 `json:"four"`
+	// After is the id of the last recorded pull when the counters were sent
+	After uint64 `json:"after"`
+}
+
+var historyFile = accountfile.File[*history]{Version: FILE_VERSION, Unsupported: UnsupportedFile}
+
+var handlers = dispatch.Handlers[*Tracker]{
+	reliquary.PlayerGetTokenScRsp:  dispatch.On((*Tracker).applyLogin),
+	reliquary.GetGachaInfoScRsp:    dispatch.On((*Tracker).applyGachaInfo),
+	reliquary.GetGachaCeilingScRsp: dispatch.On((*Tracker).applyGachaCeiling),
+	reliquary.DoGachaScRsp:         dispatch.At((*Tracker).applyDoGacha),
+}
+
+// Tracker keeps the warp history of every account seen in a file, safe for concurrent use
+type Tracker struct {
+	// Now gives the export time
+	Now func() time.Time
+
+	mu        sync.RWMutex
+	path      string
+	data      *gamedata.Resolver
+	uid       uint32
+	histories map[uint32]*history
+	banners   map[uint32]Banner
+	lastId    uint64
+}
+
+// Open reads the history at path, a missing file starts an empty one. The game data
+// gives pulls their rarity, pity can't be counted without it
+func Open(path string, data *gamedata.Resolver) (*Tracker, error) {
+	t := &Tracker{
+		Now:       time.Now,
+		path:      path,
+		data:      data,
+		histories: make(map[uint32]*history),
+		banners:   make(map[uint32]Banner),
+	}
+
+	histories, err := historyFile.Read(path)
+	if err != nil {
+		return nil, err
+	}
+	for uid, h := range histories {
+		if h == nil {
+			continue
+		}
+		t.histories[uid] = h
+		for _, pull := range h.Pulls {
+			t.lastId = max(t.lastId, pull.Id)
+		}
+	}
+	return t, nil
+}
+
+// Apply records the pulls, saving the history when any were made
+func (t *Tracker) Apply(commands ...reliquary.GameCommand) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return handlers.Apply(t, commands)
+}
+
+func (t *Tracker) applyLogin(rsp *pb.PlayerGetTokenScRsp) error {
+	if rsp.GetRetcode() == 0 {
+		t.uid = rsp.GetUid()
+	}
+	return nil
+}
+
+func (t *Tracker) applyGachaInfo(rsp *pb.GetGachaInfoScRsp) error {
+	if rsp.GetRetcode() != 0 {
+		return nil
+	}
+
+	clear(t.banners)
+	for _, info := range rsp.GetGachaInfoList() {
+		banner := Banner{
+			GachaId: info.GetGachaId(),
+			Type:    TypeOf(info.GetGachaId()),
+		}
+		if info.GetBeginTime() != 0 {
+			banner.Begin = time.Unix(info.GetBeginTime(), 0)
+		}
+		if info.GetEndTime() != 0 {
+			banner.End = time.Unix(info.GetEndTime(), 0)
+		}
+		t.banners[banner.GachaId] = banner
+
+		// Banners of a type share their pity, so they all send the same counters
+		if banner.Type != 0 {
+			h := t.history()
+			if h.Seeds == nil {
+				h.Seeds = make(map[Type]seed)
+			}
+			h.Seeds[banner.Type] = seed{Five: info.GetFiveStarPity(), Four: info.GetFourStarPity(), After: t.lastId}
+		}
+		if ceiling := info.GetGachaCeiling(); ceiling != nil {
+			h := t.history()
+			h.Ceiling, h.CeilingClaimed = ceiling.GetCeilingNum(), ceiling.GetIsClaimed()
+		}
+	}
+	return nil
+}
+
+func (t *Tracker) applyGachaCeiling(rsp *pb.GetGachaCeilingScRsp) error {
+	if rsp.GetRetcode() != 0 || rsp.GetGachaCeiling() == nil {
+		return nil
+	}
+
+	h := t.history()
+	h.Ceiling, h.CeilingClaimed = rsp.GetGachaCeiling().GetCeilingNum(), rsp.GetGachaCeiling().GetIsClaimed()
+	return nil
+}
+
+func (t *Tracker) applyDoGacha(rsp *pb.DoGachaScRsp, at time.Time) error {
+	if rsp.GetRetcode() != 0 || len(rsp.GetGachaItemList()) == 0 {
+		return nil
+	}
+
+	h := t.history()
+	gachaType := TypeOf(rsp.GetGachaId())
+	if gachaType == STELLAR {
+		h.Ceiling = rsp.GetCeilingNum()
+	}
+
+	pity := h.pity(gachaType)
+	for _, item := range rsp.GetGachaItemList() {
+		pull := Pull{
+			Id:      t.nextId(at),
+			GachaId: rsp.GetGachaId(),
+			Type:    gachaType,
+			ItemId:  item.GetGachaItem().GetItemId(),
+			Rarity:  t.rarity(item.GetGachaItem().GetItemId()),
+			Time:    at,
+		}
+
+		pity.Five++
+		pity.Four++
+		switch pull.Rarity {
+		case 5:
+			pull.Pity = pity.Five
+			pity.Five, pity.Four = 0, 0
+		case 4:
+			pull.Pity = pity.Four
+			pity.Four = 0
+		}
+		h.Pulls = append(h.Pulls, pull)
+	}
+	return t.save()
+}
+
+// history returns the history of the logged in account, creating it if needed
+func (t *Tracker) history() *history {
+	h, ok := t.histories[t.uid]
+	if !ok {
+		h = &history{}
+		t.histories[t.uid] = h
+	}
+	return h
+}
+
+// nextId derives an id from the time, pulls made in the same millisecond count up from it
+func (t *Tracker) nextId(at time.Time) uint64 {
+	t.lastId = max(t.lastId+1, uint64(max(at.UnixMilli(), 0))*1000)
+	return t.lastId
+}
+
+// rarity looks an item up as an avatar and as a light cone, 0 when the game data doesn't know it
+func (t *Tracker) rarity(itemId uint32) uint32 {
+	if t.data == nil {
+		return 0
+	}
+	if avatar, ok := t.data.Avatar(itemId); ok {
+		return avatar.Rarity
+	}
+	if lightCone, ok := t.data.LightCone(itemId); ok {
+		return lightCone.Rarity
+	}
+	return 0
+}
+
+func (t *Tracker) save() error {
+	return historyFile.Write(t.path, t.histories)
+}
+
+// pity counts the pulls of a type since the last 4 and 5 star, on top of the counters the game sent.
+// Without counters only the recorded pulls are counted
+func (h *history) pity(gachaType Type) Pity {
+	var pity Pity
+	s, seeded := h.Seeds[gachaType]
+	fourFound := false
+	for _, pull := range slices.Backward(h.Pulls) {
+		if seeded && pull.Id <= s.After {
+			break
+		}
+		if pull.Type != gachaType {
+			continue
+		}
+		if pull.Rarity == 5 {
+			return pity
+		}
+		if pull.Rarity == 4 {
+			fourFound = true
+		}
+		if !fourFound {
+			pity.Four++
+		}
+		pity.Five++
+	}
+	if seeded {
+		pity.Five += s.Five
+		if !fourFound {
+			pity.Four += s.Four
+		}
+	}
+	return pity
+}
+
+// Uid returns the logged in account, 0 before the login was seen
+func (t *Tracker) Uid() uint32 {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.uid
+}
+
+// Pulls returns the pulls of the logged in account oldest first, of every type when none are given
+func (t *Tracker) Pulls(types ...Type) []Pull {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	h, ok := t.histories[t.uid]
+	if !ok {
+		return nil
+	}
+	if len(types) == 0 {
+		return slices.Clone(h.Pulls)
+	}
+
+	var pulls []Pull
+	for _, pull := range h.Pulls {
+		if slices.Contains(types, pull.Type) {
+			pulls = append(pulls, pull)
+		}
+	}
+	return pulls
+}
+
+// Pity returns the pity of the logged in account on a type, the counters of the last GetGachaInfoScRsp
+// plus the pulls recorded since
+func (t *Tracker) Pity(gachaType Type) Pity {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	h, ok := t.histories[t.uid]
+	if !ok {
+		return Pity{}
+	}
+	pity := h.pity(gachaType)
+	if gachaType == STELLAR {
+		pity.Ceiling, pity.CeilingClaimed = h.Ceiling, h.CeilingClaimed
+	}
+	return pity
+}
+
+// Banners returns the banners of the last GetGachaInfoScRsp, ordered by gacha id
+func (t *Tracker) Banners() []Banner {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	banners := make([]Banner, 0, len(t.banners))
+	for _, banner := range t.banners {
+		banners = append(banners, banner)
+	}
+	slices.SortFunc(banners, func(a, b Banner) int {
+		return cmp.Compare(a.GachaId, b.GachaId)
+	})
+	return banners
+}
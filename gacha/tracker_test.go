@@ -0,0 +1,294 @@
+package gacha_test
+
+import (
+	"errors"
+	"github.com/Fesaa/go-reliquary"
+	"github.com/Fesaa/go-reliquary/gacha"
+	"github.com/Fesaa/go-reliquary/gamedata"
+	"github.com/Fesaa/go-reliquary/pb"
+	"github.com/Fesaa/go-reliquary/reliquarytest"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"testing/fstest"
+	"time"
+)
+
+// Items of every rarity, light cones have five digit ids
+const (
+	MARCH_7TH   uint32 = 1001
+	SEELE       uint32 = 1102
+	ARROWS      uint32 = 20000
+	SWORDPLAY   uint32 = 21010
+	IN_THE_NAME uint32 = 23000
+)
+
+func loadData(t *testing.T) *gamedata.Resolver {
+	t.Helper()
+
+	fsys := fstest.MapFS{
+		"ExcelOutput/RelicConfig.json":          {Data: []byte(`[]`)},
+		"ExcelOutput/RelicMainAffixConfig.json": {Data: []byte(`[]`)},
+		"ExcelOutput/RelicSubAffixConfig.json":  {Data: []byte(`[]`)},
+		"ExcelOutput/RelicSetConfig.json":       {Data: []byte(`[]`)},
+		"ExcelOutput/EquipmentConfig.json": {Data: []byte(`[
+			{"EquipmentID": 20000, "EquipmentName": {"Hash": 1}, "Rarity": "CombatPowerLightconeRarity3"},
+			{"EquipmentID": 21010, "EquipmentName": {"Hash": 2}, "Rarity": "CombatPowerLightconeRarity4"},
+			{"EquipmentID": 23000, "EquipmentName": {"Hash": 3}, "Rarity": "CombatPowerLightconeRarity5"}
+		]`)},
+		"ExcelOutput/AvatarConfig.json": {Data: []byte(`[
+			{"AvatarID": 1001, "AvatarName": {"Hash": 4}, "Rarity": "CombatPowerAvatarRarityType4"},
+			{"AvatarID": 1102, "AvatarName": {"Hash": 5}, "Rarity": "CombatPowerAvatarRarityType5"}
+		]`)},
+		"ExcelOutput/ItemConfig.json": {Data: []byte(`[]`)},
+		"TextMap/TextMapEN.json":      {Data: []byte(`{"1": "Arrows", "2": "Swordplay", "3": "In the Name of the World", "4": "March 7th", "5": "Seele"}`)},
+	}
+	data, err := gamedata.LoadFS(fsys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func pull(s *reliquarytest.Session, gachaId uint32, items ...uint32) {
+	rsp := &pb.DoGachaScRsp{GachaId: gachaId, GachaNum: uint32(len(items))}
+	for _, item := range items {
+		rsp.GachaItemList = append(rsp.GachaItemList, &pb.GachaItem{GachaItem: &pb.Item{ItemId: item}})
+	}
+	s.FromServer(reliquary.DoGachaScRsp, rsp)
+}
+
+// seed sends the pity counters of banners like opening the warp menu does
+func seed(s *reliquarytest.Session, banners ...*pb.GachaInfo) {
+	s.FromServer(reliquary.GetGachaInfoScRsp, &pb.GetGachaInfoScRsp{GachaInfoList: banners})
+}
+
+func apply(t *testing.T, tracker *gacha.Tracker, s *reliquarytest.Session) {
+	t.Helper()
+
+	if err := s.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if err := tracker.Apply(s.GameCommands()...); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestTrackerPity(t *testing.T) {
+	mixed := func(s *reliquarytest.Session) {
+		seed(s,
+			&pb.GachaInfo{GachaId: 2001, FiveStarPity: 10, FourStarPity: 2},
+			&pb.GachaInfo{GachaId: 3001, FiveStarPity: 40, FourStarPity: 8})
+		pull(s, 3001, ARROWS, IN_THE_NAME)
+		pull(s, 2001, ARROWS)
+		pull(s, 3001, SWORDPLAY)
+	}
+
+	tests := []struct {
+		name      string
+		script    func(s *reliquarytest.Session)
+		gachaType gacha.Type
+		want      gacha.Pity
+		// pity are the Pity of every pull of gachaType
+		pity []uint32
+	}{
+		{"unseeded", func(s *reliquarytest.Session) {
+			pull(s, 2001, ARROWS, ARROWS, ARROWS)
+		}, gacha.CHARACTER_EVENT, gacha.Pity{Five: 3, Four: 3}, []uint32{0, 0, 0}},
+		{"unseeded 4 star", func(s *reliquarytest.Session) {
+			pull(s, 2001, ARROWS, MARCH_7TH, ARROWS)
+		}, gacha.CHARACTER_EVENT, gacha.Pity{Five: 3, Four: 1}, []uint32{0, 2, 0}},
+		{"unseeded 5 star", func(s *reliquarytest.Session) {
+			pull(s, 2001, ARROWS, SEELE, ARROWS)
+		}, gacha.CHARACTER_EVENT, gacha.Pity{Five: 1, Four: 1}, []uint32{0, 2, 0}},
+		{"seeded", func(s *reliquarytest.Session) {
+			seed(s, &pb.GachaInfo{GachaId: 2001, FiveStarPity: 10, FourStarPity: 5})
+			pull(s, 2001, ARROWS, ARROWS)
+		}, gacha.CHARACTER_EVENT, gacha.Pity{Five: 12, Four: 7}, []uint32{0, 0}},
+		{"seeded 4 star", func(s *reliquarytest.Session) {
+			seed(s, &pb.GachaInfo{GachaId: 2001, FiveStarPity: 10, FourStarPity: 5})
+			pull(s, 2001, MARCH_7TH, ARROWS)
+		}, gacha.CHARACTER_EVENT, gacha.Pity{Five: 12, Four: 1}, []uint32{6, 0}},
+		{"5 star after seed", func(s *reliquarytest.Session) {
+			seed(s, &pb.GachaInfo{GachaId: 2001, FiveStarPity: 70, FourStarPity: 3})
+			pull(s, 2001, ARROWS, SEELE, ARROWS)
+		}, gacha.CHARACTER_EVENT, gacha.Pity{Five: 1, Four: 1}, []uint32{0, 72, 0}},
+		{"pulls before seed", func(s *reliquarytest.Session) {
+			pull(s, 2001, ARROWS, MARCH_7TH)
+			seed(s, &pb.GachaInfo{GachaId: 2001, FiveStarPity: 5, FourStarPity: 5})
+			pull(s, 2001, ARROWS)
+		}, gacha.CHARACTER_EVENT, gacha.Pity{Five: 6, Four: 6}, []uint32{0, 2, 0}},
+		{"banners of a type share pity", func(s *reliquarytest.Session) {
+			pull(s, 2001, ARROWS)
+			pull(s, 2002, ARROWS, MARCH_7TH)
+		}, gacha.CHARACTER_EVENT, gacha.Pity{Five: 3, Four: 0}, []uint32{0, 0, 3}},
+		{"mixed banner types character", mixed, gacha.CHARACTER_EVENT, gacha.Pity{Five: 11, Four: 3}, []uint32{0}},
+		{"mixed banner types light cone", mixed, gacha.LIGHT_CONE_EVENT, gacha.Pity{Five: 1, Four: 0}, []uint32{0, 42, 1}},
+		{"stellar ceiling", func(s *reliquarytest.Session) {
+			s.FromServer(reliquary.GetGachaCeilingScRsp, &pb.GetGachaCeilingScRsp{
+				GachaCeiling: &pb.GachaCeiling{CeilingNum: 40, IsClaimed: true},
+			})
+			s.FromServer(reliquary.DoGachaScRsp, &pb.DoGachaScRsp{
+				GachaId:       1001,
+				CeilingNum:    50,
+				GachaItemList: []*pb.GachaItem{{GachaItem: &pb.Item{ItemId: ARROWS}}},
+			})
+		}, gacha.STELLAR, gacha.Pity{Five: 1, Four: 1, Ceiling: 50, CeilingClaimed: true}, []uint32{0}},
+		{"failed pull", func(s *reliquarytest.Session) {
+			s.FromServer(reliquary.DoGachaScRsp, &pb.DoGachaScRsp{
+				Retcode:       1,
+				GachaId:       2001,
+				GachaItemList: []*pb.GachaItem{{GachaItem: &pb.Item{ItemId: SEELE}}},
+			})
+		}, gacha.CHARACTER_EVENT, gacha.Pity{}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tracker, err := gacha.Open(filepath.Join(t.TempDir(), "warps.json"), loadData(t))
+			if err != nil {
+				t.Fatal(err)
+			}
+			session := reliquarytest.NewSession()
+			session.Login(1)
+			tt.script(session)
+			apply(t, tracker, session)
+
+			if got := tracker.Pity(tt.gachaType); got != tt.want {
+				t.Errorf("pity %+v, want %+v", got, tt.want)
+			}
+			var pity []uint32
+			for _, p := range tracker.Pulls(tt.gachaType) {
+				pity = append(pity, p.Pity)
+			}
+			if !slices.Equal(pity, tt.pity) {
+				t.Errorf("pulls with pity %v, want %v", pity, tt.pity)
+			}
+		})
+	}
+}
+
+func TestTrackerPulls(t *testing.T) {
+	tracker, err := gacha.Open(filepath.Join(t.TempDir(), "warps.json"), loadData(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	session := reliquarytest.NewSession()
+	session.Login(1)
+	pull(session, 2001, ARROWS, SEELE)
+	session.Wait(time.Hour)
+	pull(session, 3001, IN_THE_NAME)
+	apply(t, tracker, session)
+
+	pulls := tracker.Pulls()
+	if len(pulls) != 3 {
+		t.Fatalf("recorded %d pulls, want 3", len(pulls))
+	}
+	commands := session.Commands()
+	for i, want := range []struct {
+		gachaType gacha.Type
+		item      uint32
+		rarity    uint32
+		at        time.Time
+	}{
+		{gacha.CHARACTER_EVENT, ARROWS, 3, commands[2].Time},
+		{gacha.CHARACTER_EVENT, SEELE, 5, commands[2].Time},
+		{gacha.LIGHT_CONE_EVENT, IN_THE_NAME, 5, commands[3].Time},
+	} {
+		got := pulls[i]
+		if got.Type != want.gachaType || got.ItemId != want.item || got.Rarity != want.rarity || !got.Time.Equal(want.at) {
+			t.Errorf("pull %d: %+v, want type %d item %d rarity %d at %v", i, got, want.gachaType, want.item, want.rarity, want.at)
+		}
+		if i > 0 && got.Id <= pulls[i-1].Id {
+			t.Errorf("pull %d: id %d after %d", i, got.Id, pulls[i-1].Id)
+		}
+	}
+	if got := tracker.Pulls(gacha.LIGHT_CONE_EVENT); len(got) != 1 || got[0] != pulls[2] {
+		t.Errorf("light cone pulls %+v", got)
+	}
+}
+
+func TestOpen(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		fails   bool
+		want    error
+	}{
+		{"missing", "", false, nil},
+		{"current version", `{"version": 1, "accounts": {"100000001": {"pulls": [{"id": 7, "gacha_type": 11, "item_id": 1102}]}}}`, false, nil},
+		{"newer version", `{"version": 2, "accounts": {}}`, true, gacha.UnsupportedFile},
+		{"malformed", `{"version": 1, "accounts": [`, true, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "warps.json")
+			if tt.content != "" {
+				if err := os.WriteFile(path, []byte(tt.content), 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			_, err := gacha.Open(path, nil)
+			if (err != nil) != tt.fails || tt.want != nil && !errors.Is(err, tt.want) {
+				t.Fatalf("error %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestOpenRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "warps.json")
+	data := loadData(t)
+	tracker, err := gacha.Open(path, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	session := reliquarytest.NewSession()
+	session.Login(1)
+	seed(session, &pb.GachaInfo{GachaId: 2001, FiveStarPity: 10, FourStarPity: 5})
+	pull(session, 2001, ARROWS, MARCH_7TH)
+	session.FromServer(reliquary.DoGachaScRsp, &pb.DoGachaScRsp{
+		GachaId:       1001,
+		CeilingNum:    20,
+		GachaItemList: []*pb.GachaItem{{GachaItem: &pb.Item{ItemId: ARROWS}}},
+	})
+	apply(t, tracker, session)
+
+	reopened, err := gacha.Open(path, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pulls := reopened.Pulls(); len(pulls) != 0 {
+		t.Fatalf("%d pulls before the login", len(pulls))
+	}
+	login := reliquarytest.NewSession()
+	login.Login(1)
+	apply(t, reopened, login)
+
+	want, got := tracker.Pulls(), reopened.Pulls()
+	if len(got) != len(want) {
+		t.Fatalf("read %d pulls, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if got[i].Id != want[i].Id || got[i].ItemId != want[i].ItemId || got[i].Pity != want[i].Pity || !got[i].Time.Equal(want[i].Time) {
+			t.Errorf("pull %d: %+v, want %+v", i, got[i], want[i])
+		}
+	}
+	for _, gachaType := range []gacha.Type{gacha.CHARACTER_EVENT, gacha.STELLAR} {
+		if got, want := reopened.Pity(gachaType), tracker.Pity(gachaType); got != want {
+			t.Errorf("type %d: pity %+v, want %+v", gachaType, got, want)
+		}
+	}
+
+	// Ids keep increasing past the saved ones, even for pulls with an earlier time
+	later := reliquarytest.NewSession()
+	pull(later, 2001, ARROWS)
+	apply(t, reopened, later)
+	pulls := reopened.Pulls()
+	if last := pulls[len(pulls)-1]; last.Id <= want[len(want)-1].Id {
+		t.Errorf("new pull id %d, last saved %d", last.Id, want[len(want)-1].Id)
+	}
+}
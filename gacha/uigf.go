@@ -0,0 +1,208 @@
+package gacha
+
+import (
+	"encoding/json"
+	"github.com/Fesaa/go-reliquary/gamedata"
+	"io"
+	"maps"
+	"runtime/debug"
+	"slices"
+	"strconv"
+	"time"
+)
+
+const (
+	UIGF_VERSION = "v4.0"
+	SRGF_VERSION = "v1.0"
+	EXPORT_APP   = "go-reliquary"
+
+	timeLayout = "2006-01-02 15:04:05"
+)
+
+// locales are the lang of UIGF and SRGF for every TextMap language
+var locales = map[gamedata.Language]string{
+	gamedata.CHS: "zh-cn",
+	gamedata.CHT: "zh-tw",
+	gamedata.DE:  "de-de",
+	gamedata.EN:  "en-us",
+	gamedata.ES:  "es-es",
+	gamedata.FR:  "fr-fr",
+	gamedata.ID:  "id-id",
+	gamedata.JP:  "ja-jp",
+	gamedata.KR:  "ko-kr",
+	gamedata.PT:  "pt-pt",
+	gamedata.RU:  "ru-ru",
+	gamedata.TH:  "th-th",
+	gamedata.VI:  "vi-vn",
+}
+
+// Record is a pull in both formats, every value is a string
+type Record struct {
+	GachaId   string `json:"gacha_id"`
+	GachaType string `json:"gacha_type"`
+	ItemId    string `json:"item_id"`
+	Count     string `json:"count"`
+	Time      string `json:"time"`
+	Name      string `json:"name"`
+	ItemType  string `json:"item_type"`
+	RankType  string `json:"rank_type"`
+	Id        string `json:"id"`
+}
+
+type UIGF struct {
+	Info struct {
+		ExportTimestamp  int64  `json:"export_timestamp"`
+		ExportApp        string `json:"export_app"`
+		ExportAppVersion string `json:"export_app_version"`
+		Version          string `json:"version"`
+	} `json:"info"`
+	Hkrpg []UIGFAccount `json:"hkrpg"`
+}
+
+type UIGFAccount struct {
+	Uid      string   `json:"uid"`
+	Timezone int      `json:"timezone"`
+	Lang     string   `json:"lang"`
+	List     []Record `json:"list"`
+}
+
+type SRGF struct {
+	Info struct {
+		Uid              string `json:"uid"`
+		Lang             string `json:"lang"`
+		RegionTimeZone   int    `json:"region_time_zone"`
+		ExportTimestamp  int64  `json:"export_timestamp"`
+		ExportApp        string `json:"export_app"`
+		ExportAppVersion string `json:"export_app_version"`
+		SrgfVersion      string `json:"srgf_version"`
+	} `json:"info"`
+	List []Record `json:"list"`
+}
+
+// Timezone returns the UTC offset the server of an account shows times in, by the first digit of the uid
+func Timezone(uid uint32) int {
+	switch uid / 100_000_000 {
+	case 6:
+		return -5
+	case 7:
+		return 1
+	}
+	return 8
+}
+
+// UIGF returns the history of every account in the UIGF format
+func (t *Tracker) UIGF() UIGF {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	var export UIGF
+	export.Info.ExportTimestamp = t.Now().Unix()
+	export.Info.ExportApp = EXPORT_APP
+	export.Info.ExportAppVersion = appVersion()
+	export.Info.Version = UIGF_VERSION
+	export.Hkrpg = []UIGFAccount{}
+	for _, uid := range slices.Sorted(maps.Keys(t.histories)) {
+		h := t.histories[uid]
+		export.Hkrpg = append(export.Hkrpg, UIGFAccount{
+			Uid:      strconv.FormatUint(uint64(uid), 10),
+			Timezone: Timezone(uid),
+			Lang:     t.locale(),
+			List:     t.records(uid, h),
+		})
+	}
+	return export
+}
+
+// SRGF returns the history of the logged in account in the SRGF format
+func (t *Tracker) SRGF() SRGF {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	var export SRGF
+	export.Info.Uid = strconv.FormatUint(uint64(t.uid), 10)
+	export.Info.Lang = t.locale()
+	export.Info.RegionTimeZone = Timezone(t.uid)
+	export.Info.ExportTimestamp = t.Now().Unix()
+	export.Info.ExportApp = EXPORT_APP
+	export.Info.ExportAppVersion = appVersion()
+	export.Info.SrgfVersion = SRGF_VERSION
+	export.List = []Record{}
+	if h, ok := t.histories[t.uid]; ok {
+		export.List = t.records(t.uid, h)
+	}
+	return export
+}
+
+// appVersion is the version of go-reliquary the program was built with
+func appVersion() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return "devel"
+	}
+	if info.Main.Path == "github.com/Fesaa/go-reliquary" {
+		return info.Main.Version
+	}
+	for _, dep := range info.Deps {
+		if dep.Path == "github.com/Fesaa/go-reliquary" {
+			return dep.Version
+		}
+	}
+	return "devel"
+}
+
+func (t *Tracker) WriteUIGF(w io.Writer) error {
+	return writeJSON(w, t.UIGF())
+}
+
+func (t *Tracker) WriteSRGF(w io.Writer) error {
+	return writeJSON(w, t.SRGF())
+}
+
+func writeJSON(w io.Writer, v any) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(v)
+}
+
+func (t *Tracker) locale() string {
+	if t.data == nil {
+		return locales[gamedata.EN]
+	}
+	return locales[t.data.Language()]
+}
+
+// records converts pulls, with their times in the timezone of the account's server
+func (t *Tracker) records(uid uint32, h *history) []Record {
+	zone := time.FixedZone("", Timezone(uid)*60*60)
+	records := make([]Record, 0, len(h.Pulls))
+	for _, pull := range h.Pulls {
+		name, itemType := t.item(pull.ItemId)
+		records = append(records, Record{
+			GachaId:   strconv.FormatUint(uint64(pull.GachaId), 10),
+			GachaType: strconv.FormatUint(uint64(pull.Type), 10),
+			ItemId:    strconv.FormatUint(uint64(pull.ItemId), 10),
+			Count:     "1",
+			Time:      pull.Time.In(zone).Format(timeLayout),
+			Name:      name,
+			ItemType:  itemType,
+			RankType:  strconv.FormatUint(uint64(pull.Rarity), 10),
+			Id:        strconv.FormatUint(pull.Id, 10),
+		})
+	}
+	return records
+}
+
+// item returns the name and item_type of a pull, light cones have five digit ids
+func (t *Tracker) item(itemId uint32) (string, string) {
+	itemType := "Character"
+	if itemId >= 10_000 {
+		itemType = "Light Cone"
+	}
+	if t.data == nil {
+		return "", itemType
+	}
+	if itemType == "Character" {
+		return t.data.AvatarName(itemId), itemType
+	}
+	return t.data.LightConeName(itemId), itemType
+}
@@ -0,0 +1,75 @@
+package gacha_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"github.com/Fesaa/go-reliquary/gacha"
+	"github.com/Fesaa/go-reliquary/reliquarytest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files in testdata")
+
+func TestExport(t *testing.T) {
+	tracker, err := gacha.Open(filepath.Join(t.TempDir(), "warps.json"), loadData(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tracker.Now = func() time.Time {
+		return time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	}
+
+	session := reliquarytest.NewSession()
+	session.Uid = 700000001
+	session.Login(1)
+	pull(session, 1001, ARROWS)
+	session.Uid = 100000001
+	session.Login(2)
+	pull(session, 2001, ARROWS, SEELE)
+	session.Wait(time.Minute)
+	pull(session, 3001, SWORDPLAY)
+	apply(t, tracker, session)
+
+	uigf := tracker.UIGF()
+	uigf.Info.ExportAppVersion = "test"
+	compareGolden(t, "uigf", uigf)
+
+	srgf := tracker.SRGF()
+	srgf.Info.ExportAppVersion = "test"
+	compareGolden(t, "srgf", srgf)
+}
+
+// compareGolden compares the export with testdata/<name>.golden, indented like WriteUIGF and WriteSRGF do
+func compareGolden(t *testing.T, name string, export any) {
+	t.Helper()
+
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(export); err != nil {
+		t.Fatal(err)
+	}
+
+	path := filepath.Join("testdata", name+".golden")
+	if *update {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, buf.Bytes(), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("%v, run the tests with -update to create it", err)
+	}
+	if !bytes.Equal(buf.Bytes(), want) {
+		t.Errorf("%s differs, run the tests with -update to see the changes\ngot:\n%s\nwant:\n%s", path, buf.Bytes(), want)
+	}
+}
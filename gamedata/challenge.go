@@ -0,0 +1,44 @@
+package gamedata
+
+// ChallengeMode is the endgame mode a challenge belongs to
+type ChallengeMode string
+
+const (
+	MEMORY_OF_CHAOS     ChallengeMode = "MemoryOfChaos"
+	PURE_FICTION        ChallengeMode = "PureFiction"
+	APOCALYPTIC_SHADOW  ChallengeMode = "ApocalypticShadow"
+	ANOMALY_ARBITRATION ChallengeMode = "AnomalyArbitration"
+)
+
+// challengeConfigs are the configs of every mode, a mode keeps its floors and groups in a config of its own
+var challengeConfigs = []struct {
+	mode   ChallengeMode
+	floors string
+	groups string
+}{
+	{MEMORY_OF_CHAOS, "ChallengeMazeConfig", "ChallengeGroupConfig"},
+	{PURE_FICTION, "ChallengeStoryMazeConfig", "ChallengeStoryGroupConfig"},
+	{APOCALYPTIC_SHADOW, "ChallengeBossMazeConfig", "ChallengeBossGroupConfig"},
+}
+
+// Challenge is a single floor, the group is the season it belongs to
+type Challenge struct {
+	Id      uint32
+	GroupId uint32
+	Floor   uint32
+	Mode    ChallengeMode
+
+	name textHash
+}
+
+type challengeRow struct {
+	ID      uint32
+	GroupID uint32
+	Floor   uint32
+	Name    textHash
+}
+
+type challengeGroupRow struct {
+	GroupID   uint32
+	GroupName textHash
+}
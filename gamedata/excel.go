@@ -0,0 +1,174 @@
+package gamedata
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"strconv"
+	"strings"
+)
+
+var (
+	DataNotFound      = errors.New("game data not found")
+	MalformedExcel    = errors.New("malformed excel config")
+	LanguageNotLoaded = errors.New("language not loaded")
+)
+
+// readFile looks for name in the layout of the usual data dumps first, and in the root of fsys after that
+func readFile(fsys fs.FS, dir string, name string) ([]byte, error) {
+	data, err := fs.ReadFile(fsys, dir+"/"+name)
+	if errors.Is(err, fs.ErrNotExist) {
+		data, err = fs.ReadFile(fsys, name)
+	}
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("%w: %s", DataNotFound, name)
+	}
+	return data, err
+}
+
+// loadExcel reads ExcelOutput/<name>.json. Dumps either contain a list of rows,
+// or rows keyed by their id, nested once more for configs with two keys
+func loadExcel[T any](fsys fs.FS, name string) ([]T, error) {
+	data, err := readFile(fsys, "ExcelOutput", name+".json")
+	if err != nil {
+		return nil, err
+	}
+
+	raws, err := flattenRows(data)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s: %w", MalformedExcel, name, err)
+	}
+
+	rows := make([]T, 0, len(raws))
+	for _, raw := range raws {
+		var row T
+		if err = json.Unmarshal(raw, &row); err != nil {
+			return nil, fmt.Errorf("%w: %s: %w", MalformedExcel, name, err)
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+func flattenRows(data []byte) ([]json.RawMessage, error) {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	switch data[0] {
+	case '[':
+		var list []json.RawMessage
+		if err := json.Unmarshal(data, &list); err != nil {
+			return nil, err
+		}
+		var out []json.RawMessage
+		for _, item := range list {
+			rows, err := flattenRows(item)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, rows...)
+		}
+		return out, nil
+	case '{':
+		var object map[string]json.RawMessage
+		if err := json.Unmarshal(data, &object); err != nil {
+			return nil, err
+		}
+		for key := range object {
+			if _, err := strconv.ParseInt(key, 10, 64); err != nil {
+				// A row, its keys are field names
+				return []json.RawMessage{data}, nil
+			}
+		}
+
+		var out []json.RawMessage
+		for _, value := range object {
+			rows, err := flattenRows(value)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, rows...)
+		}
+		return out, nil
+	}
+	return nil, fmt.Errorf("unexpected %q", data[0])
+}
+
+// textHash is a TextMap key, dumps write it as {"Hash": n}, a plain number or a string
+type textHash string
+
+func (h *textHash) UnmarshalJSON(data []byte) error {
+	var wrapped struct {
+		Hash json.RawMessage
+	}
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("{")) {
+		if err := json.Unmarshal(data, &wrapped); err != nil {
+			return err
+		}
+		data = wrapped.Hash
+	}
+	*h = textHash(strings.Trim(string(bytes.TrimSpace(data)), `"`))
+	return nil
+}
+
+// value is a number, dumps write it as {"Value": n}
+type value float64
+
+func (v *value) UnmarshalJSON(data []byte) error {
+	var wrapped struct {
+		Value float64
+	}
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("{")) {
+		if err := json.Unmarshal(data, &wrapped); err != nil {
+			return err
+		}
+		*v = value(wrapped.Value)
+		return nil
+	}
+
+	var f float64
+	if err := json.Unmarshal(data, &f); err != nil {
+		return err
+	}
+	*v = value(f)
+	return nil
+}
+
+// itemRarities are the names ItemConfig uses instead of numbers
+var itemRarities = map[string]rarity{
+	"Normal":    1,
+	"NotNormal": 2,
+	"Rare":      3,
+	"VeryRare":  4,
+	"SuperRare": 5,
+}
+
+// rarity is either a number, an enum name ending in the number like CombatPowerRelicRarity5, or one of itemRarities
+type rarity uint32
+
+func (r *rarity) UnmarshalJSON(data []byte) error {
+	s := strings.Trim(string(bytes.TrimSpace(data)), `"`)
+	if named, ok := itemRarities[s]; ok {
+		*r = named
+		return nil
+	}
+	end := len(s)
+	for end > 0 && s[end-1] >= '0' && s[end-1] <= '9' {
+		end--
+	}
+	if end == len(s) {
+		*r = 0
+		return nil
+	}
+
+	n, err := strconv.ParseUint(s[end:], 10, 32)
+	if err != nil {
+		return err
+	}
+	*r = rarity(n)
+	return nil
+}
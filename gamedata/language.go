@@ -0,0 +1,20 @@
+package gamedata
+
+// Language is the suffix of a TextMap file, TextMapEN.json for EN
+type Language string
+
+const (
+	CHS Language = "CHS"
+	CHT Language = "CHT"
+	DE  Language = "DE"
+	EN  Language = "EN"
+	ES  Language = "ES"
+	FR  Language = "FR"
+	ID  Language = "ID"
+	JP  Language = "JP"
+	KR  Language = "KR"
+	PT  Language = "PT"
+	RU  Language = "RU"
+	TH  Language = "TH"
+	VI  Language = "VI"
+)
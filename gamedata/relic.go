@@ -0,0 +1,109 @@
+package gamedata
+
+import "strings"
+
+// Slot is the RelicConfig type of a relic
+type Slot string
+
+const (
+	HEAD   Slot = "HEAD"
+	HAND   Slot = "HAND"
+	BODY   Slot = "BODY"
+	FOOT   Slot = "FOOT"
+	NECK   Slot = "NECK"
+	OBJECT Slot = "OBJECT"
+)
+
+// Planar returns whether the slot belongs to a planar ornament
+func (s Slot) Planar() bool {
+	return s == NECK || s == OBJECT
+}
+
+type Relic struct {
+	Id             uint32
+	SetId          uint32
+	Slot           Slot
+	Rarity         uint32
+	MaxLevel       uint32
+	MainAffixGroup uint32
+	SubAffixGroup  uint32
+}
+
+// Property is a stat as named in the excel configs, like HPDelta or CriticalChanceBase
+type Property string
+
+// Percent returns whether values of the property are ratios, flat stats end in Delta
+func (p Property) Percent() bool {
+	return !strings.HasSuffix(string(p), "Delta")
+}
+
+// Stat is a computed relic stat, ratios are not multiplied by 100
+type Stat struct {
+	Property Property
+	Value    float64
+}
+
+type MainAffix struct {
+	GroupId   uint32
+	AffixId   uint32
+	Property  Property
+	BaseValue float64
+	LevelAdd  float64
+}
+
+// Value returns the stat at the given relic level, ratios are not multiplied by 100
+func (a MainAffix) Value(level uint32) float64 {
+	return a.BaseValue + a.LevelAdd*float64(level)
+}
+
+type SubAffix struct {
+	GroupId   uint32
+	AffixId   uint32
+	Property  Property
+	BaseValue float64
+	StepValue float64
+	// StepNum is the highest step a single roll adds
+	StepNum uint32
+}
+
+// Value returns the stat of a sub affix with count rolls and step as the sum of their steps
+func (a SubAffix) Value(count uint32, step uint32) float64 {
+	return a.BaseValue*float64(count) + a.StepValue*float64(step)
+}
+
+type relicRow struct {
+	ID             uint32
+	SetID          uint32
+	Type           Slot
+	Rarity         rarity
+	MaxLevel       uint32
+	MainAffixGroup uint32
+	SubAffixGroup  uint32
+}
+
+type mainAffixRow struct {
+	GroupID   uint32
+	AffixID   uint32
+	Property  Property
+	BaseValue value
+	LevelAdd  value
+}
+
+type subAffixRow struct {
+	GroupID   uint32
+	AffixID   uint32
+	Property  Property
+	BaseValue value
+	StepValue value
+	StepNum   uint32
+}
+
+type relicSetRow struct {
+	SetID   uint32
+	SetName textHash
+}
+
+type affixKey struct {
+	group uint32
+	affix uint32
+}
@@ -0,0 +1,452 @@
+// Package gamedata translates the numeric ids in game commands into names and stats, using the game's
+// excel configs and TextMap as found in the usual data dumps (ExcelOutput/*.json and TextMap/TextMap*.json)
+package gamedata
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+)
+
+// Resolver holds the loaded configs, it's read-only after Load
+type Resolver struct {
+	relics      map[uint32]Relic
+	mainAffixes map[affixKey]MainAffix
+	subAffixes  map[affixKey]SubAffix
+	relicSets   map[uint32]textHash
+	lightCones  map[uint32]LightCone
+	avatars     map[uint32]Avatar
+	skillTree   map[uint32]SkillTreePoint
+	items       map[uint32]Item
+	challenges  map[uint32]Challenge
+	groups      map[uint32]textHash
+	text        map[Language]map[textHash]string
+	// language names are returned in
+	language Language
+}
+
+type LightCone struct {
+	Id     uint32
+	Rarity uint32
+	// Path is the AvatarBaseType the light cone belongs to
+	Path string
+
+	name textHash
+}
+
+type Avatar struct {
+	Id     uint32
+	Rarity uint32
+	// Path is the AvatarBaseType, like Warrior or Knight. See PathName
+	Path string
+
+	name textHash
+}
+
+// SkillTreePoint is a node of an avatar's skill tree, Anchor is its place in the tree like Point01 for the basic attack
+type SkillTreePoint struct {
+	Id       uint32
+	AvatarId uint32
+	Anchor   string
+	MaxLevel uint32
+}
+
+type Item struct {
+	Id       uint32
+	Rarity   uint32
+	MainType string
+	SubType  string
+
+	name textHash
+}
+
+type equipmentRow struct {
+	EquipmentID    uint32
+	EquipmentName  textHash
+	Rarity         rarity
+	AvatarBaseType string
+}
+
+type avatarRow struct {
+	AvatarID       uint32
+	AvatarName     textHash
+	Rarity         rarity
+	AvatarBaseType string
+}
+
+type skillTreeRow struct {
+	PointID  uint32
+	AvatarID uint32
+	Anchor   string
+	MaxLevel uint32
+}
+
+type itemRow struct {
+	ID           uint32
+	ItemName     textHash
+	Rarity       rarity
+	ItemMainType string
+	ItemSubType  string
+}
+
+// optionalItemConfigs hold the items of other kinds, most importantly the names of relic pieces
+var optionalItemConfigs = []string{"ItemConfigRelic", "ItemConfigEquipment", "ItemConfigAvatar"}
+
+// Load reads the configs from a directory, see LoadFS
+func Load(dir string, languages ...Language) (*Resolver, error) {
+	return LoadFS(os.DirFS(dir), languages...)
+}
+
+// LoadFS reads the configs, and the TextMap of every language. Names are returned in the first language, EN if none are given
+func LoadFS(fsys fs.FS, languages ...Language) (*Resolver, error) {
+	if len(languages) == 0 {
+		languages = []Language{EN}
+	}
+
+	r := &Resolver{
+		relics:      make(map[uint32]Relic),
+		mainAffixes: make(map[affixKey]MainAffix),
+		subAffixes:  make(map[affixKey]SubAffix),
+		relicSets:   make(map[uint32]textHash),
+		lightCones:  make(map[uint32]LightCone),
+		avatars:     make(map[uint32]Avatar),
+		skillTree:   make(map[uint32]SkillTreePoint),
+		items:       make(map[uint32]Item),
+		challenges:  make(map[uint32]Challenge),
+		groups:      make(map[uint32]textHash),
+		text:        make(map[Language]map[textHash]string),
+		language:    languages[0],
+	}
+
+	relics, err := loadExcel[relicRow](fsys, "RelicConfig")
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range relics {
+		r.relics[row.ID] = Relic{
+			Id:             row.ID,
+			SetId:          row.SetID,
+			Slot:           row.Type,
+			Rarity:         uint32(row.Rarity),
+			MaxLevel:       row.MaxLevel,
+			MainAffixGroup: row.MainAffixGroup,
+			SubAffixGroup:  row.SubAffixGroup,
+		}
+	}
+
+	mainAffixes, err := loadExcel[mainAffixRow](fsys, "RelicMainAffixConfig")
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range mainAffixes {
+		r.mainAffixes[affixKey{row.GroupID, row.AffixID}] = MainAffix{
+			GroupId:   row.GroupID,
+			AffixId:   row.AffixID,
+			Property:  row.Property,
+			BaseValue: float64(row.BaseValue),
+			LevelAdd:  float64(row.LevelAdd),
+		}
+	}
+
+	subAffixes, err := loadExcel[subAffixRow](fsys, "RelicSubAffixConfig")
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range subAffixes {
+		r.subAffixes[affixKey{row.GroupID, row.AffixID}] = SubAffix{
+			GroupId:   row.GroupID,
+			AffixId:   row.AffixID,
+			Property:  row.Property,
+			BaseValue: float64(row.BaseValue),
+			StepValue: float64(row.StepValue),
+			StepNum:   row.StepNum,
+		}
+	}
+
+	sets, err := loadExcel[relicSetRow](fsys, "RelicSetConfig")
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range sets {
+		r.relicSets[row.SetID] = row.SetName
+	}
+
+	equipment, err := loadExcel[equipmentRow](fsys, "EquipmentConfig")
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range equipment {
+		r.lightCones[row.EquipmentID] = LightCone{
+			Id:     row.EquipmentID,
+			Rarity: uint32(row.Rarity),
+			Path:   row.AvatarBaseType,
+			name:   row.EquipmentName,
+		}
+	}
+
+	avatars, err := loadExcel[avatarRow](fsys, "AvatarConfig")
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range avatars {
+		r.avatars[row.AvatarID] = Avatar{
+			Id:     row.AvatarID,
+			Rarity: uint32(row.Rarity),
+			Path:   row.AvatarBaseType,
+			name:   row.AvatarName,
+		}
+	}
+
+	// Optional like the item configs, it's only needed for traces
+	skillTree, err := loadExcel[skillTreeRow](fsys, "AvatarSkillTreeConfig")
+	if err != nil && !errors.Is(err, DataNotFound) {
+		return nil, err
+	}
+	for _, row := range skillTree {
+		// Rows repeat for every level of a point
+		r.skillTree[row.PointID] = SkillTreePoint{
+			Id:       row.PointID,
+			AvatarId: row.AvatarID,
+			Anchor:   row.Anchor,
+			MaxLevel: row.MaxLevel,
+		}
+	}
+
+	if err = r.loadItems(fsys, "ItemConfig"); err != nil {
+		return nil, err
+	}
+	for _, name := range optionalItemConfigs {
+		if err = r.loadItems(fsys, name); err != nil && !errors.Is(err, DataNotFound) {
+			return nil, err
+		}
+	}
+
+	for _, config := range challengeConfigs {
+		if err = r.loadChallenges(fsys, config.mode, config.floors, config.groups); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, language := range languages {
+		if err = r.loadText(fsys, language); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+func (r *Resolver) loadItems(fsys fs.FS, name string) error {
+	items, err := loadExcel[itemRow](fsys, name)
+	if err != nil {
+		return err
+	}
+	for _, row := range items {
+		r.items[row.ID] = Item{
+			Id:       row.ID,
+			Rarity:   uint32(row.Rarity),
+			MainType: row.ItemMainType,
+			SubType:  row.ItemSubType,
+			name:     row.ItemName,
+		}
+	}
+	return nil
+}
+
+// loadChallenges reads the floors and groups of a mode, they're optional like the item configs
+func (r *Resolver) loadChallenges(fsys fs.FS, mode ChallengeMode, floorsName string, groupsName string) error {
+	floors, err := loadExcel[challengeRow](fsys, floorsName)
+	if err != nil && !errors.Is(err, DataNotFound) {
+		return err
+	}
+	for _, row := range floors {
+		r.challenges[row.ID] = Challenge{
+			Id:      row.ID,
+			GroupId: row.GroupID,
+			Floor:   row.Floor,
+			Mode:    mode,
+			name:    row.Name,
+		}
+	}
+
+	groups, err := loadExcel[challengeGroupRow](fsys, groupsName)
+	if err != nil && !errors.Is(err, DataNotFound) {
+		return err
+	}
+	for _, row := range groups {
+		r.groups[row.GroupID] = row.GroupName
+	}
+	return nil
+}
+
+func (r *Resolver) loadText(fsys fs.FS, language Language) error {
+	name := "TextMap" + string(language) + ".json"
+	data, err := readFile(fsys, "TextMap", name)
+	if err != nil {
+		return err
+	}
+
+	var text map[textHash]string
+	if err = json.Unmarshal(data, &text); err != nil {
+		return fmt.Errorf("%w: %s: %w", MalformedExcel, name, err)
+	}
+	r.text[language] = text
+	return nil
+}
+
+// Language returns the language names are returned in
+func (r *Resolver) Language() Language {
+	return r.language
+}
+
+// Languages returns every loaded language
+func (r *Resolver) Languages() []Language {
+	return slices.Sorted(maps.Keys(r.text))
+}
+
+// WithLanguage returns a Resolver sharing the configs, that returns names in another loaded language
+func (r *Resolver) WithLanguage(language Language) (*Resolver, error) {
+	if _, ok := r.text[language]; !ok {
+		return nil, fmt.Errorf("%w: %s", LanguageNotLoaded, language)
+	}
+	clone := *r
+	clone.language = language
+	return &clone, nil
+}
+
+func (r *Resolver) name(hash textHash) string {
+	return r.text[r.language][hash]
+}
+
+func (r *Resolver) Relic(id uint32) (Relic, bool) {
+	relic, ok := r.relics[id]
+	return relic, ok
+}
+
+// MainAffix returns the main stat of a relic by the relic's id and the affix id from the game command
+func (r *Resolver) MainAffix(relicId uint32, affixId uint32) (MainAffix, bool) {
+	relic, ok := r.relics[relicId]
+	if !ok {
+		return MainAffix{}, false
+	}
+	affix, ok := r.mainAffixes[affixKey{relic.MainAffixGroup, affixId}]
+	return affix, ok
+}
+
+// SubAffix returns a sub stat of a relic by the relic's id and the affix id from the game command
+func (r *Resolver) SubAffix(relicId uint32, affixId uint32) (SubAffix, bool) {
+	relic, ok := r.relics[relicId]
+	if !ok {
+		return SubAffix{}, false
+	}
+	affix, ok := r.subAffixes[affixKey{relic.SubAffixGroup, affixId}]
+	return affix, ok
+}
+
+func (r *Resolver) LightCone(id uint32) (LightCone, bool) {
+	lightCone, ok := r.lightCones[id]
+	return lightCone, ok
+}
+
+func (r *Resolver) Avatar(id uint32) (Avatar, bool) {
+	avatar, ok := r.avatars[id]
+	return avatar, ok
+}
+
+// SkillTreePoint returns a node of a skill tree, only known when AvatarSkillTreeConfig is present
+func (r *Resolver) SkillTreePoint(id uint32) (SkillTreePoint, bool) {
+	point, ok := r.skillTree[id]
+	return point, ok
+}
+
+// RelicSetName returns the name of a set, empty if unknown
+func (r *Resolver) RelicSetName(setId uint32) string {
+	return r.name(r.relicSets[setId])
+}
+
+// LightConeName returns the name of a light cone, empty if unknown
+func (r *Resolver) LightConeName(id uint32) string {
+	return r.name(r.lightCones[id].name)
+}
+
+func (r *Resolver) Item(id uint32) (Item, bool) {
+	item, ok := r.items[id]
+	return item, ok
+}
+
+// ItemName returns the name of an item, empty if unknown. Relic pieces are only known when ItemConfigRelic is present
+func (r *Resolver) ItemName(id uint32) string {
+	return r.name(r.items[id].name)
+}
+
+// RelicName returns the name of a single relic piece, see ItemName
+func (r *Resolver) RelicName(id uint32) string {
+	return r.ItemName(id)
+}
+
+// Challenge returns an endgame floor, only known when the challenge configs are present
+func (r *Resolver) Challenge(id uint32) (Challenge, bool) {
+	challenge, ok := r.challenges[id]
+	return challenge, ok
+}
+
+// ChallengeName returns the name of an endgame floor, empty if unknown
+func (r *Resolver) ChallengeName(id uint32) string {
+	return r.name(r.challenges[id].name)
+}
+
+// ChallengeGroupName returns the name of an endgame season, empty if unknown
+func (r *Resolver) ChallengeGroupName(groupId uint32) string {
+	return r.name(r.groups[groupId])
+}
+
+// MainStat returns the main stat of a relic at the given level
+func (r *Resolver) MainStat(relicId uint32, affixId uint32, level uint32) (Stat, bool) {
+	affix, ok := r.MainAffix(relicId, affixId)
+	if !ok {
+		return Stat{}, false
+	}
+	return Stat{Property: affix.Property, Value: affix.Value(level)}, true
+}
+
+// SubStat returns a sub stat of a relic, with count and step as found in the game command
+func (r *Resolver) SubStat(relicId uint32, affixId uint32, count uint32, step uint32) (Stat, bool) {
+	affix, ok := r.SubAffix(relicId, affixId)
+	if !ok {
+		return Stat{}, false
+	}
+	return Stat{Property: affix.Property, Value: affix.Value(count, step)}, true
+}
+
+// AvatarName returns the name of an avatar, empty if unknown. The Trailblazer is named after the player in game
+// and is returned as Trailblazer
+func (r *Resolver) AvatarName(id uint32) string {
+	name := r.name(r.avatars[id].name)
+	if strings.Contains(name, "{NICKNAME}") {
+		return "Trailblazer"
+	}
+	return name
+}
+
+var pathNames = map[string]string{
+	"Warrior": "Destruction",
+	"Rogue":   "The Hunt",
+	"Mage":    "Erudition",
+	"Shaman":  "Harmony",
+	"Warlock": "Nihility",
+	"Knight":  "Preservation",
+	"Priest":  "Abundance",
+	"Memory":  "Remembrance",
+}
+
+// PathName returns the in game name of an AvatarBaseType, the base type itself when unknown
+func PathName(baseType string) string {
+	if name, ok := pathNames[baseType]; ok {
+		return name
+	}
+	return baseType
+}
@@ -0,0 +1,157 @@
+package gamedata_test
+
+import (
+	"errors"
+	"github.com/Fesaa/go-reliquary/gamedata"
+	"path"
+	"testing"
+	"testing/fstest"
+)
+
+// configs builds the configs LoadFS needs, empty but for the given files
+func configs(files map[string]string) fstest.MapFS {
+	fsys := fstest.MapFS{}
+	for _, name := range []string{"RelicConfig", "RelicMainAffixConfig", "RelicSubAffixConfig", "RelicSetConfig",
+		"EquipmentConfig", "AvatarConfig", "ItemConfig"} {
+		fsys["ExcelOutput/"+name+".json"] = &fstest.MapFile{Data: []byte(`[]`)}
+	}
+	fsys["TextMap/TextMapEN.json"] = &fstest.MapFile{Data: []byte(`{}`)}
+	for name, content := range files {
+		fsys[name] = &fstest.MapFile{Data: []byte(content)}
+	}
+	return fsys
+}
+
+func TestLoadFSLayouts(t *testing.T) {
+	const relic = `{"ID": 61011, "SetID": 101, "Type": "HEAD", "MainAffixGroup": 1}`
+	const affix = `{"GroupID": 1, "AffixID": 2, "Property": "HPDelta", "BaseValue": {"Value": 112.896}}`
+
+	tests := []struct {
+		name   string
+		relics string
+		affix  string
+	}{
+		{"lists", `[` + relic + `]`, `[` + affix + `]`},
+		{"keyed by id", `{"61011": ` + relic + `}`, `{"1": {"2": ` + affix + `}}`},
+		{"lists of keyed rows", `[{"61011": ` + relic + `}]`, `{"1": [` + affix + `]}`},
+		{"surrounding space", "\n  [" + relic + "]\n", "\t" + `{"1": {"2": ` + affix + `}}` + "\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := gamedata.LoadFS(configs(map[string]string{
+				"ExcelOutput/RelicConfig.json":          tt.relics,
+				"ExcelOutput/RelicMainAffixConfig.json": tt.affix,
+			}))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if r, ok := data.Relic(61011); !ok || r.SetId != 101 || r.Slot != gamedata.HEAD {
+				t.Errorf("relic %+v", r)
+			}
+			if a, ok := data.MainAffix(61011, 2); !ok || a.Property != "HPDelta" || a.BaseValue != 112.896 {
+				t.Errorf("main affix %+v", a)
+			}
+		})
+	}
+}
+
+func TestLoadFSFiles(t *testing.T) {
+	tests := []struct {
+		name  string
+		fsys  fstest.MapFS
+		fails error
+	}{
+		{"dump layout", configs(nil), nil},
+		{"empty config", configs(map[string]string{"ExcelOutput/ItemConfig.json": ""}), nil},
+		{"flat directory", func() fstest.MapFS {
+			fsys := fstest.MapFS{}
+			for name, file := range configs(nil) {
+				fsys[path.Base(name)] = file
+			}
+			return fsys
+		}(), nil},
+		{"missing config", func() fstest.MapFS {
+			fsys := configs(nil)
+			delete(fsys, "ExcelOutput/AvatarConfig.json")
+			return fsys
+		}(), gamedata.DataNotFound},
+		{"missing TextMap", func() fstest.MapFS {
+			fsys := configs(nil)
+			delete(fsys, "TextMap/TextMapEN.json")
+			return fsys
+		}(), gamedata.DataNotFound},
+		{"malformed config", configs(map[string]string{"ExcelOutput/AvatarConfig.json": `[{"AvatarID": 1102`}), gamedata.MalformedExcel},
+		{"not rows", configs(map[string]string{"ExcelOutput/AvatarConfig.json": `"AvatarConfig"`}), gamedata.MalformedExcel},
+		{"malformed TextMap", configs(map[string]string{"TextMap/TextMapEN.json": `[]`}), gamedata.MalformedExcel},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := gamedata.LoadFS(tt.fsys)
+			if tt.fails == nil && err != nil || !errors.Is(err, tt.fails) {
+				t.Errorf("error %v, want %v", err, tt.fails)
+			}
+		})
+	}
+}
+
+func TestTextHash(t *testing.T) {
+	tests := []struct {
+		name string
+		hash string
+	}{
+		{"wrapped", `{"Hash": 1234}`},
+		{"wrapped string", `{"Hash": "1234"}`},
+		{"number", `1234`},
+		{"string", `"1234"`},
+		{"negative", `{"Hash": -1234}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := gamedata.LoadFS(configs(map[string]string{
+				"ExcelOutput/AvatarConfig.json": `[{"AvatarID": 1102, "AvatarName": ` + tt.hash + `}]`,
+				"TextMap/TextMapEN.json":        `{"1234": "Seele", "-1234": "Seele"}`,
+			}))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if name := data.AvatarName(1102); name != "Seele" {
+				t.Errorf("name %q, want Seele", name)
+			}
+		})
+	}
+}
+
+func TestRarity(t *testing.T) {
+	tests := []struct {
+		rarity string
+		want   uint32
+	}{
+		{`"CombatPowerAvatarRarityType5"`, 5},
+		{`"CombatPowerAvatarRarityType4"`, 4},
+		{`"SuperRare"`, 5},
+		{`"NotNormal"`, 2},
+		{`3`, 3},
+		{`"Unknown"`, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.rarity, func(t *testing.T) {
+			data, err := gamedata.LoadFS(configs(map[string]string{
+				"ExcelOutput/AvatarConfig.json": `[{"AvatarID": 1102, "Rarity": ` + tt.rarity + `}]`,
+				"ExcelOutput/ItemConfig.json":   `[{"ID": 1, "Rarity": ` + tt.rarity + `}]`,
+			}))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if avatar, _ := data.Avatar(1102); avatar.Rarity != tt.want {
+				t.Errorf("avatar rarity %d, want %d", avatar.Rarity, tt.want)
+			}
+			if item, _ := data.Item(1); item.Rarity != tt.want {
+				t.Errorf("item rarity %d, want %d", item.Rarity, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package gamedata
+
+import "fmt"
+
+// MAX_ROLLS is the most rolls a single sub affix can have, one from the drop and one from every upgrade
+const MAX_ROLLS = 6
+
+// Breakdown counts the rolls of a sub affix by how much they added. A low roll adds BaseValue,
+// a high roll adds StepNum steps on top and a mid roll anything in between
+type Breakdown struct {
+	Low  uint32
+	Mid  uint32
+	High uint32
+}
+
+// Rolls is a sub affix reconstructed from the count and step in the game command
+type Rolls struct {
+	AffixId  uint32
+	Property Property
+	// Value is exact, ratios are not multiplied by 100
+	Value float64
+	Count uint32
+	Step  uint32
+	// Breakdowns are all splits of step over count rolls, the game doesn't keep the roll order.
+	// Most counts and steps allow more than one
+	Breakdowns []Breakdown
+	// RollValue is Value in high rolls, 1 per roll when every roll was high
+	RollValue float64
+	// Quality is the share of the possible steps the rolls hit, from 0 to 1
+	Quality float64
+}
+
+// Upgrades returns the rolls added by leveling up, the first roll comes with the drop
+func (r Rolls) Upgrades() uint32 {
+	if r.Count == 0 {
+		return 0
+	}
+	return r.Count - 1
+}
+
+// Rolls reconstructs a sub affix with count rolls and step as the sum of their steps
+func (a SubAffix) Rolls(count uint32, step uint32) Rolls {
+	rolls := Rolls{
+		AffixId:    a.AffixId,
+		Property:   a.Property,
+		Value:      a.Value(count, step),
+		Count:      count,
+		Step:       step,
+		Breakdowns: a.breakdowns(count, step),
+		Quality:    1,
+	}
+	if high := a.BaseValue + a.StepValue*float64(a.StepNum); high != 0 {
+		rolls.RollValue = rolls.Value / high
+	}
+	if maxStep := count * a.StepNum; maxStep != 0 {
+		rolls.Quality = float64(step) / float64(maxStep)
+	}
+	return rolls
+}
+
+func (a SubAffix) breakdowns(count uint32, step uint32) []Breakdown {
+	if count > MAX_ROLLS || step > count*a.StepNum {
+		return nil
+	}
+	if a.StepNum == 0 {
+		return []Breakdown{{High: count}}
+	}
+
+	// High rolls take StepNum each, the rest is spread over mid rolls taking 1 to StepNum-1 each
+	var breakdowns []Breakdown
+	for high := uint32(0); high <= count && high*a.StepNum <= step; high++ {
+		rest := step - high*a.StepNum
+		for mid := uint32(0); high+mid <= count; mid++ {
+			if mid > rest || rest > mid*(a.StepNum-1) {
+				continue
+			}
+			breakdowns = append(breakdowns, Breakdown{Low: count - high - mid, Mid: mid, High: high})
+		}
+	}
+	return breakdowns
+}
+
+// RolledAffix is a sub affix as found in the game command
+type RolledAffix struct {
+	AffixId uint32
+	Count   uint32
+	Step    uint32
+}
+
+type RelicRolls struct {
+	RelicId  uint32
+	SubStats []Rolls
+	// RollValue is the sum of the sub stats' RollValue
+	RollValue float64
+	// Quality is the share of the possible steps all rolls hit, from 0 to 1
+	Quality float64
+}
+
+// Score returns the roll value weighted per property, properties without a weight count 0.
+// A nil map weighs every property 1
+func (r RelicRolls) Score(weights map[Property]float64) float64 {
+	if weights == nil {
+		return r.RollValue
+	}
+	var score float64
+	for _, sub := range r.SubStats {
+		score += sub.RollValue * weights[sub.Property]
+	}
+	return score
+}
+
+// RelicRolls reconstructs every sub affix of a relic
+func (r *Resolver) RelicRolls(relicId uint32, affixes []RolledAffix) (RelicRolls, error) {
+	if _, ok := r.relics[relicId]; !ok {
+		return RelicRolls{}, fmt.Errorf("%w: relic %d", DataNotFound, relicId)
+	}
+
+	rolls := RelicRolls{
+		RelicId:  relicId,
+		SubStats: make([]Rolls, 0, len(affixes)),
+		Quality:  1,
+	}
+	var steps, maxSteps uint32
+	for _, rolled := range affixes {
+		affix, ok := r.SubAffix(relicId, rolled.AffixId)
+		if !ok {
+			return RelicRolls{}, fmt.Errorf("%w: relic %d has no sub affix %d", DataNotFound, relicId, rolled.AffixId)
+		}
+
+		sub := affix.Rolls(rolled.Count, rolled.Step)
+		rolls.SubStats = append(rolls.SubStats, sub)
+		rolls.RollValue += sub.RollValue
+		steps += rolled.Step
+		maxSteps += rolled.Count * affix.StepNum
+	}
+	if maxSteps != 0 {
+		rolls.Quality = float64(steps) / float64(maxSteps)
+	}
+	return rolls, nil
+}
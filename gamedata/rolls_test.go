@@ -0,0 +1,123 @@
+package gamedata_test
+
+import (
+	"github.com/Fesaa/go-reliquary/gamedata"
+	"github.com/Fesaa/go-reliquary/state"
+	"math"
+	"slices"
+	"testing"
+	"testing/fstest"
+)
+
+func TestBreakdowns(t *testing.T) {
+	tests := []struct {
+		name    string
+		stepNum uint32
+		count   uint32
+		step    uint32
+		want    []gamedata.Breakdown
+	}{
+		{"low roll", 2, 1, 0, []gamedata.Breakdown{{Low: 1}}},
+		{"mid roll", 2, 1, 1, []gamedata.Breakdown{{Mid: 1}}},
+		{"high roll", 2, 1, 2, []gamedata.Breakdown{{High: 1}}},
+		{"ambiguous", 2, 2, 2, []gamedata.Breakdown{{Mid: 2}, {Low: 1, High: 1}}},
+		{"every roll high", 2, 6, 12, []gamedata.Breakdown{{High: 6}}},
+		{"max rolls", 2, 6, 6, []gamedata.Breakdown{
+			{Mid: 6}, {Low: 1, Mid: 4, High: 1}, {Low: 2, Mid: 2, High: 2}, {Low: 3, High: 3},
+		}},
+		{"no rolls", 2, 0, 0, []gamedata.Breakdown{{}}},
+		{"step too high", 2, 1, 3, nil},
+		{"too many rolls", 2, 7, 0, nil},
+		{"no steps", 0, 3, 0, []gamedata.Breakdown{{High: 3}}},
+		{"no steps with step", 0, 1, 1, nil},
+		{"single step", 1, 3, 2, []gamedata.Breakdown{{Low: 1, High: 2}}},
+		{"three steps", 3, 2, 3, []gamedata.Breakdown{{Mid: 2}, {Low: 1, High: 1}}},
+		{"three steps mid", 3, 1, 2, []gamedata.Breakdown{{Mid: 1}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			affix := gamedata.SubAffix{BaseValue: 1, StepValue: 0.5, StepNum: tt.stepNum}
+			got := affix.Rolls(tt.count, tt.step).Breakdowns
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("breakdowns of %d rolls with step %d: got %v, want %v", tt.count, tt.step, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRolls(t *testing.T) {
+	tests := []struct {
+		name      string
+		affix     gamedata.SubAffix
+		count     uint32
+		step      uint32
+		value     float64
+		rollValue float64
+		quality   float64
+	}{
+		{"low rolls", gamedata.SubAffix{BaseValue: 2, StepValue: 1, StepNum: 2}, 2, 0, 4, 1, 0},
+		{"high rolls", gamedata.SubAffix{BaseValue: 2, StepValue: 1, StepNum: 2}, 2, 4, 8, 2, 1},
+		{"mid rolls", gamedata.SubAffix{BaseValue: 2, StepValue: 1, StepNum: 2}, 3, 3, 9, 2.25, 0.5},
+		{"no steps", gamedata.SubAffix{BaseValue: 2, StepNum: 0}, 2, 0, 4, 2, 1},
+		{"no rolls", gamedata.SubAffix{BaseValue: 2, StepValue: 1, StepNum: 2}, 0, 0, 0, 0, 1},
+		{"no value", gamedata.SubAffix{StepNum: 2}, 1, 1, 0, 0, 0.5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rolls := tt.affix.Rolls(tt.count, tt.step)
+			if !near(rolls.Value, tt.value) || !near(rolls.RollValue, tt.rollValue) || !near(rolls.Quality, tt.quality) {
+				t.Errorf("value %g, roll value %g, quality %g: want %g, %g, %g",
+					rolls.Value, rolls.RollValue, rolls.Quality, tt.value, tt.rollValue, tt.quality)
+			}
+		})
+	}
+}
+
+func TestRelicRolls(t *testing.T) {
+	fsys := fstest.MapFS{
+		"ExcelOutput/RelicConfig.json":          {Data: []byte(`[{"ID": 61011, "SetID": 101, "Type": "HEAD", "Rarity": "CombatPowerRelicRarity5", "MaxLevel": 15, "MainAffixGroup": 51, "SubAffixGroup": 5}]`)},
+		"ExcelOutput/RelicMainAffixConfig.json": {Data: []byte(`[]`)},
+		"ExcelOutput/RelicSubAffixConfig.json": {Data: []byte(`[
+			{"GroupID": 5, "AffixID": 1, "Property": "HPDelta", "BaseValue": {"Value": 33.87}, "StepValue": {"Value": 4.23}, "StepNum": 2},
+			{"GroupID": 5, "AffixID": 9, "Property": "CriticalChanceBase", "BaseValue": {"Value": 0.0259}, "StepValue": {"Value": 0.0032}, "StepNum": 2}
+		]`)},
+		"ExcelOutput/RelicSetConfig.json":  {Data: []byte(`[]`)},
+		"ExcelOutput/EquipmentConfig.json": {Data: []byte(`[]`)},
+		"ExcelOutput/AvatarConfig.json":    {Data: []byte(`[]`)},
+		"ExcelOutput/ItemConfig.json":      {Data: []byte(`[]`)},
+		"TextMap/TextMapEN.json":           {Data: []byte(`{}`)},
+	}
+	data, err := gamedata.LoadFS(fsys)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	relic := state.Relic{Id: 61011, SubAffixes: []state.SubAffix{{Id: 1, Count: 1, Step: 2}, {Id: 9, Count: 3, Step: 0}}}
+	rolls, err := data.RelicRolls(relic.Id, relic.RolledAffixes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rolls.SubStats) != 2 || rolls.SubStats[0].Property != "HPDelta" || rolls.SubStats[1].Property != "CriticalChanceBase" {
+		t.Fatalf("sub stats %+v", rolls.SubStats)
+	}
+	if want := []gamedata.Breakdown{{Low: 3}}; !slices.Equal(rolls.SubStats[1].Breakdowns, want) {
+		t.Errorf("breakdowns %v, want %v", rolls.SubStats[1].Breakdowns, want)
+	}
+	wantRollValue := 1 + 3*0.0259/(0.0259+2*0.0032)
+	if !near(rolls.RollValue, wantRollValue) || !near(rolls.Quality, 2.0/8) {
+		t.Errorf("roll value %g, quality %g: want %g, %g", rolls.RollValue, rolls.Quality, wantRollValue, 2.0/8)
+	}
+
+	if _, err = data.RelicRolls(relic.Id, []gamedata.RolledAffix{{AffixId: 2, Count: 1}}); err == nil {
+		t.Error("unknown sub affix was accepted")
+	}
+	if _, err = data.RelicRolls(1, nil); err == nil {
+		t.Error("unknown relic was accepted")
+	}
+}
+
+func near(a float64, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
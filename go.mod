@@ -7,6 +7,8 @@ require (
 	github.com/goark/mt v1.0.0
 	github.com/google/gopacket v1.1.19
 	github.com/rs/zerolog v1.33.0
+	golang.org/x/net v0.30.0
+	golang.org/x/sys v0.26.0
 	google.golang.org/protobuf v1.35.1
 )
 
@@ -18,6 +20,4 @@ require (
 	github.com/mattn/go-isatty v0.0.20 // indirect
 	github.com/pkg/errors v0.9.1 // indirect
 	golang.org/x/crypto v0.28.0 // indirect
-	golang.org/x/net v0.30.0 // indirect
-	golang.org/x/sys v0.26.0 // indirect
 )
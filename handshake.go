@@ -0,0 +1,88 @@
+package reliquary
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+var MalformedHandshake = errors.New("malformed handshake packet")
+
+const (
+	HANDSHAKE_LEN = 20
+
+	handshakeConnectStart uint32 = 0x000000FF
+	handshakeConnectEnd   uint32 = 0xFFFFFFFF
+
+	handshakeEstablishedStart uint32 = 0x00000145
+	handshakeEstablishedEnd   uint32 = 0x14514545
+
+	handshakeDisconnectStart uint32 = 0x00000194
+	handshakeDisconnectEnd   uint32 = 0x19419494
+)
+
+// Handshake is the fixed size control packet send before and after a KCP conversation
+//
+//	## Bit Layout
+//	| Bit indices     |  Type |  Name |
+//	| - | - | - |
+//	|   0:4      |  `uint32`  |  Magic (start) |
+//	|   4:8      |  `uint32`  |  Conv |
+//	|   8:12     |  `uint32`  |  Token |
+//	|   12:16    |  `uint32`  |  Data (reason code on disconnect) |
+//	|   16:20    |  `uint32`  |  MagicEnd |
+type Handshake struct {
+	Magic    uint32
+	Conv     uint32
+	Token    uint32
+	Data     uint32
+	MagicEnd uint32
+}
+
+// Reason returns the disconnect reason code, only meaningful for Disconnected packets
+func (h Handshake) Reason() uint32 {
+	return h.Data
+}
+
+// Type returns the ConnectionType matching the start and end magic
+func (h Handshake) Type() (ConnectionType, error) {
+	switch {
+	case h.Magic == handshakeConnectStart && h.MagicEnd == handshakeConnectEnd:
+		return HandshakeRequested, nil
+	case h.Magic == handshakeEstablishedStart && h.MagicEnd == handshakeEstablishedEnd:
+		return HandshakeEstablished, nil
+	case h.Magic == handshakeDisconnectStart && h.MagicEnd == handshakeDisconnectEnd:
+		return Disconnected, nil
+	}
+	return "", fmt.Errorf("%w: unknown magic %#08x/%#08x", MalformedHandshake, h.Magic, h.MagicEnd)
+}
+
+// NewHandshake returns a Handshake of the given type with the matching magics
+func NewHandshake(connectionType ConnectionType, conv uint32, token uint32, data uint32) (Handshake, error) {
+	h := Handshake{Conv: conv, Token: token, Data: data}
+	switch connectionType {
+	case HandshakeRequested:
+		h.Magic, h.MagicEnd = handshakeConnectStart, handshakeConnectEnd
+	case HandshakeEstablished:
+		h.Magic, h.MagicEnd = handshakeEstablishedStart, handshakeEstablishedEnd
+	case Disconnected:
+		h.Magic, h.MagicEnd = handshakeDisconnectStart, handshakeDisconnectEnd
+	default:
+		return h, fmt.Errorf("%w: %s is not a handshake type", MalformedHandshake, connectionType)
+	}
+	return h, nil
+}
+
+func parseHandshake(payload []byte) (*Handshake, error) {
+	if len(payload) != HANDSHAKE_LEN {
+		return nil, fmt.Errorf("%w: expected %d bytes, got %d", MalformedHandshake, HANDSHAKE_LEN, len(payload))
+	}
+
+	return &Handshake{
+		Magic:    binary.BigEndian.Uint32(payload[0:4]),
+		Conv:     binary.BigEndian.Uint32(payload[4:8]),
+		Token:    binary.BigEndian.Uint32(payload[8:12]),
+		Data:     binary.BigEndian.Uint32(payload[12:16]),
+		MagicEnd: binary.BigEndian.Uint32(payload[16:20]),
+	}, nil
+}
@@ -0,0 +1,51 @@
+// Package accountfile keeps what trackers know per account in a JSON file, along with the version of its format
+package accountfile
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/Fesaa/go-reliquary/internal/atomicfile"
+	"os"
+)
+
+// File reads and writes the accounts of type T
+type File[T any] struct {
+	// Version is written to the file, files from a newer version are refused with Unsupported
+	Version     int
+	Unsupported error
+}
+
+type content[T any] struct {
+	Version  int          `json:"version"`
+	Accounts map[uint32]T `json:"accounts"`
+}
+
+// Read returns the accounts in the file at path, none when it doesn't exist
+func (f File[T]) Read(path string) (map[uint32]T, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var c content[T]
+	if err = json.Unmarshal(data, &c); err != nil {
+		return nil, fmt.Errorf("could not read %s: %w", path, err)
+	}
+	if c.Version > f.Version {
+		return nil, fmt.Errorf("%w: version %d", f.Unsupported, c.Version)
+	}
+	return c.Accounts, nil
+}
+
+// Write replaces the file at path, see atomicfile.Write
+func (f File[T]) Write(path string, accounts map[uint32]T) error {
+	data, err := json.MarshalIndent(content[T]{Version: f.Version, Accounts: accounts}, "", "\t")
+	if err != nil {
+		return err
+	}
+	return atomicfile.Write(path, append(data, '\n'))
+}
@@ -0,0 +1,34 @@
+// Package battleinfo finds the battle a command started, for the packages following battles
+package battleinfo
+
+import (
+	"github.com/Fesaa/go-reliquary/pb"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Of finds the SceneBattleInfo of a successful response, the many ways to start a battle all send one.
+// It is nil for anything else
+func Of(msg proto.Message) *pb.SceneBattleInfo {
+	if msg == nil {
+		return nil
+	}
+
+	var info *pb.SceneBattleInfo
+	failed := false
+	msg.ProtoReflect().Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.Name() == "retcode" && fd.Kind() == protoreflect.Uint32Kind && v.Uint() != 0:
+			failed = true
+		case fd.Kind() == protoreflect.MessageKind && !fd.IsList() && !fd.IsMap():
+			if typed, ok := v.Message().Interface().(*pb.SceneBattleInfo); ok {
+				info = typed
+			}
+		}
+		return true
+	})
+	if failed || info.GetBattleId() == 0 {
+		return nil
+	}
+	return info
+}
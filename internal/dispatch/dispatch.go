@@ -0,0 +1,132 @@
+// Package dispatch routes game commands to the handlers of a tracker, and holds what a tracker finished
+// until its callback can be called
+package dispatch
+
+import (
+	"errors"
+	"github.com/Fesaa/go-reliquary"
+	"google.golang.org/protobuf/proto"
+	"time"
+)
+
+// Handler changes the tracker S with an unmarshalled command, captured at the given time
+type Handler[S any] func(s S, msg proto.Message, at time.Time) error
+
+// On adapts a typed handler, commands that unmarshal into another type are ignored
+func On[S any, T proto.Message](f func(s S, msg T) error) Handler[S] {
+	return At(func(s S, msg T, _ time.Time) error {
+		return f(s, msg)
+	})
+}
+
+// At adapts a typed handler that needs the capture time of the command, see On
+func At[S any, T proto.Message](f func(s S, msg T, at time.Time) error) Handler[S] {
+	return func(s S, msg proto.Message, at time.Time) error {
+		if typed, ok := msg.(T); ok {
+			return f(s, typed, at)
+		}
+		return nil
+	}
+}
+
+// Do adapts a typed handler that can't fail, see On
+func Do[S any, T proto.Message](f func(s S, msg T)) Handler[S] {
+	return On(func(s S, msg T) error {
+		f(s, msg)
+		return nil
+	})
+}
+
+// Handlers maps command ids to the change they make, commands without an entry are never unmarshalled
+type Handlers[S any] map[uint16]Handler[S]
+
+// Apply passes the given commands to their handlers, in order.
+// The first error is returned, the commands after it are still applied
+func (h Handlers[S]) Apply(s S, commands []reliquary.GameCommand) error {
+	var firstErr error
+	for _, command := range commands {
+		handle, ok := h[command.Id]
+		if !ok {
+			continue
+		}
+
+		msg, err := command.Unmarshal()
+		if err == nil {
+			err = handle(s, msg, command.Timestamp)
+		}
+		if err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// Requests holds the last request of every type until its response is applied, for responses that only carry a retcode
+type Requests map[uint16]proto.Message
+
+// Remember keeps a request in the Requests of the tracker, until Take is called with its id
+func Remember[S any](id uint16, requests func(s S) Requests) Handler[S] {
+	return func(s S, msg proto.Message, _ time.Time) error {
+		requests(s)[id] = msg
+		return nil
+	}
+}
+
+// Take returns and forgets the last request with the given id
+func Take[T proto.Message](requests Requests, id uint16) (T, bool) {
+	msg := requests[id]
+	delete(requests, id)
+
+	typed, ok := msg.(T)
+	return typed, ok
+}
+
+// Each passes every command accept returns true for to apply, in order. Packets without a known proto are passed
+// with a nil msg, any other command that fails to unmarshal is passed the same way and the first such error is returned
+func Each(commands []reliquary.GameCommand, accept func(command reliquary.GameCommand) bool,
+	apply func(command reliquary.GameCommand, msg proto.Message)) error {
+	var firstErr error
+	for _, command := range commands {
+		if !accept(command) {
+			continue
+		}
+
+		msg, err := command.Unmarshal()
+		if err != nil && !errors.Is(err, reliquary.CannotInferProtoType) && firstErr == nil {
+			firstErr = err
+		}
+		apply(command, msg)
+	}
+	return firstErr
+}
+
+// All accepts every command, see Each
+func All(reliquary.GameCommand) bool {
+	return true
+}
+
+// Queue collects what a tracker finished while it's locked, for its callback to receive once it's released
+type Queue[E any] struct {
+	items []E
+}
+
+func (q *Queue[E]) Push(item E) {
+	q.items = append(q.items, item)
+}
+
+// Take returns and forgets the queued items
+func (q *Queue[E]) Take() []E {
+	items := q.items
+	q.items = nil
+	return items
+}
+
+// Notify calls f with every item in order, f may be nil
+func Notify[E any](items []E, f func(item E)) {
+	if f == nil {
+		return
+	}
+	for _, item := range items {
+		f(item)
+	}
+}
@@ -13,10 +13,16 @@ type kcpSniffer struct {
 	Kcp       *kcp.KCP
 	TimeStart time.Time
 	logger    zerolog.Logger
+	// resume is set when the conversation started before the capture, sequence numbers are rebased onto
+	// snOffset (the first data segment seen) as KCP only accepts segments starting from 0
+	resume   bool
+	synced   bool
+	snOffset uint32
 }
 
 // newKcpSniffer creates a new kcpSniffer instance from the provided segment.
-func newKcpSniffer(segment []byte) (*kcpSniffer, error) {
+// resume should be set when the handshake of the conversation was not seen
+func newKcpSniffer(segment []byte, resume bool) (*kcpSniffer, error) {
 	logger.Info().Int("segmentLen", len(segment)).Msg("creating new kcpSniffer")
 
 	convID, err := validateKcpSegment(segment)
@@ -32,6 +38,7 @@ func newKcpSniffer(segment []byte) (*kcpSniffer, error) {
 		Kcp:       _kcp,
 		TimeStart: time.Now(),
 		logger:    logger.With().Uint32("convID", convID).Logger(),
+		resume:    resume,
 	}, nil
 }
 
@@ -49,7 +56,10 @@ func (ks *kcpSniffer) receive(segments []byte) ([][]byte, error) {
 		return nil, PacketNotFromConversation
 	}
 
-	segments = ks.reformatKcpSegments(segments)
+	segments, err = ks.reformatKcpSegments(segments)
+	if err != nil {
+		return nil, err
+	}
 
 	if num := ks.Kcp.Input(segments, true); num < 0 {
 		ks.logger.Error().
@@ -77,7 +87,7 @@ func (ks *kcpSniffer) receive(segments []byte) ([][]byte, error) {
 			ks.logger.Error().
 				Int("code", num).
 				Msg("could not receive from KCP")
-			continue
+			break
 		}
 		recv = append(recv, bytes)
 	}
@@ -100,8 +110,8 @@ func (ks *kcpSniffer) clock() uint32 {
 }
 
 // reformatKcpSegments reformats the segments to skip bytes 4..8.
-func (ks *kcpSniffer) reformatKcpSegments(data []byte) []byte {
-	var reformattedBytes []byte
+func (ks *kcpSniffer) reformatKcpSegments(data []byte) ([]byte, error) {
+	reformattedBytes := make([]byte, 0, len(data))
 
 	if isTraceEnabled() {
 		ks.logger.Trace().
@@ -112,11 +122,20 @@ func (ks *kcpSniffer) reformatKcpSegments(data []byte) []byte {
 
 	var i uint = 0
 	for i < uint(len(data)) {
+		if uint(len(data))-i < KCP_HEADER_LEN {
+			return nil, fmt.Errorf("%w: %d trailing bytes", TruncatedSegment, uint(len(data))-i)
+		}
 		convID := data[i : i+4]
 
 		remainingHeader := data[i+8 : i+28]
+		if ks.resume {
+			remainingHeader = ks.rebase(remainingHeader)
+		}
 
 		contentLen := uint(binary.LittleEndian.Uint32(data[i+24 : i+28]))
+		if contentLen > uint(len(data))-i-KCP_HEADER_LEN {
+			return nil, fmt.Errorf("%w: content of %d bytes, %d left", TruncatedSegment, contentLen, uint(len(data))-i-KCP_HEADER_LEN)
+		}
 		content := data[i+28 : i+28+contentLen]
 
 		reformattedBytes = append(reformattedBytes, convID...)
@@ -133,7 +152,25 @@ func (ks *kcpSniffer) reformatKcpSegments(data []byte) []byte {
 			Msg("after split")
 	}
 
-	return reformattedBytes
+	return reformattedBytes, nil
+}
+
+// rebase shifts the sequence number of data segments by snOffset, returning a copy of the header
+func (ks *kcpSniffer) rebase(header []byte) []byte {
+	if header[0] != kcp.IKCP_CMD_PUSH {
+		return header
+	}
+
+	sn := binary.LittleEndian.Uint32(header[8:12])
+	if !ks.synced {
+		ks.synced = true
+		ks.snOffset = sn
+		ks.logger.Info().Uint32("sn", sn).Msg("resuming conversation from sequence number")
+	}
+
+	rebased := append([]byte(nil), header...)
+	binary.LittleEndian.PutUint32(rebased[8:12], sn-ks.snOffset)
+	return rebased
 }
 
 // validateKcpSegment checks the validity of the KCP segment and extracts the conversation ID.
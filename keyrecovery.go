@@ -0,0 +1,221 @@
+package reliquary
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+)
+
+const (
+	maxRecoverySamples = 256
+	// maxKeyRecoveries limits the versions a Sniffer recovers at once, garbage traffic would look like new versions
+	maxKeyRecoveries = 16
+)
+
+var (
+	KeyConflict   = errors.New("observed command conflicts with recovered key bytes")
+	KeyIncomplete = errors.New("not enough commands observed to recover the full key")
+)
+
+// KeyRecovery derives a dispatch key from known plaintext in commands encrypted with it.
+//
+// Every command starts with HEAD_MAGIC and ends with TAIL_MAGIC, so each command reveals key[0:4]
+// (which is also the version) and the 4 key bytes at its tail. The header and data lengths always add up to
+// the command length, which pins down key[6:12] once commands of two different lengths were seen, and key[4:6]
+// follows from the command id when it's known.
+//
+// The dispatch key only encrypts the login, so the full key needs commands ending at every offset and is rarely
+// recovered from a single capture. PartialKey is usable as soon as the start of the key is known
+type KeyRecovery struct {
+	version uint32
+	key     [KEY_LEN]byte
+	known   [KEY_LEN]bool
+	// prefix is the number of key bytes known from the start
+	prefix  int
+	samples [][]byte
+	// lengths are the distinct lengths of the samples, solveLengths only learns something from new ones
+	lengths map[int]bool
+}
+
+func NewKeyRecovery(version uint32) *KeyRecovery {
+	kr := &KeyRecovery{version: version, lengths: make(map[int]bool)}
+	var head [4]byte
+	binary.BigEndian.PutUint32(head[:], version)
+	for i, b := range head {
+		kr.key[i], kr.known[i] = b, true
+	}
+	kr.advance()
+	return kr
+}
+
+func (kr *KeyRecovery) Version() uint32 {
+	return kr.version
+}
+
+// Observe adds an encrypted command, id is the expected command id if known, 0 otherwise.
+// Nothing is learned from a command that conflicts with the key bytes recovered so far
+func (kr *KeyRecovery) Observe(encrypted []byte, id uint16) error {
+	if len(encrypted) < HEADER_OVERHEAD {
+		return errors.New("command too short to recover key from")
+	}
+	if v := version(encrypted); v != kr.version {
+		return fmt.Errorf("command is from version %d, recovering %d", v, kr.version)
+	}
+
+	learned := make(map[int]byte, 6)
+	var tail [4]byte
+	binary.BigEndian.PutUint32(tail[:], TAIL_MAGIC)
+	for i, b := range tail {
+		learned[len(encrypted)-4+i] = encrypted[len(encrypted)-4+i] ^ b
+	}
+	if id != 0 {
+		var idBytes [2]byte
+		binary.BigEndian.PutUint16(idBytes[:], id)
+		for i, b := range idBytes {
+			learned[4+i] = encrypted[4+i] ^ b
+		}
+	}
+	if err := kr.learn(learned); err != nil {
+		return err
+	}
+
+	if len(kr.samples) < maxRecoverySamples {
+		kr.samples = append(kr.samples, append([]byte(nil), encrypted...))
+	}
+	if kr.lengths[len(encrypted)] {
+		return nil
+	}
+	kr.lengths[len(encrypted)] = true
+	return kr.solveLengths()
+}
+
+// learn takes all key bytes, or none of them when any conflicts with a known byte
+func (kr *KeyRecovery) learn(bytes map[int]byte) error {
+	for pos, b := range bytes {
+		if pos %= KEY_LEN; kr.known[pos] && kr.key[pos] != b {
+			return fmt.Errorf("%w: byte %d", KeyConflict, pos)
+		}
+	}
+	for pos, b := range bytes {
+		kr.key[pos%KEY_LEN], kr.known[pos%KEY_LEN] = b, true
+	}
+	kr.advance()
+	return nil
+}
+
+func (kr *KeyRecovery) advance() {
+	for kr.prefix < KEY_LEN && kr.known[kr.prefix] {
+		kr.prefix++
+	}
+}
+
+// solveLengths brute forces key[6:8] (the header length), every candidate gives a data length per sample
+// and with that key[8:12], which must agree between all samples
+func (kr *KeyRecovery) solveLengths() error {
+	if kr.allKnown(6, 12) || len(kr.lengths) < 2 {
+		return nil
+	}
+
+	var found []uint16
+	for candidate := 0; candidate <= math.MaxUint16; candidate++ {
+		if _, ok := kr.dataLenKey(uint16(candidate)); ok {
+			found = append(found, uint16(candidate))
+			if len(found) > 1 {
+				// Not unique yet, wait for more samples
+				return nil
+			}
+		}
+	}
+	if len(found) == 0 {
+		return fmt.Errorf("%w: no header length fits all commands", KeyConflict)
+	}
+
+	dataKey, _ := kr.dataLenKey(found[0])
+	learned := make(map[int]byte, 6)
+	var hl [2]byte
+	binary.BigEndian.PutUint16(hl[:], found[0])
+	for i, b := range append(hl[:], dataKey[:]...) {
+		learned[6+i] = b
+	}
+	if err := kr.learn(learned); err != nil {
+		return err
+	}
+	logger.Debug().Uint32("version", kr.version).Msg("recovered key bytes from command lengths")
+	return nil
+}
+
+func (kr *KeyRecovery) dataLenKey(headerLenKey uint16) ([4]byte, bool) {
+	var dataKey [4]byte
+	for i, sample := range kr.samples {
+		headerLen := binary.BigEndian.Uint16(sample[6:8]) ^ headerLenKey
+		dataLen := len(sample) - HEADER_OVERHEAD - int(headerLen)
+		if dataLen < 0 {
+			return dataKey, false
+		}
+
+		var k [4]byte
+		binary.BigEndian.PutUint32(k[:], binary.BigEndian.Uint32(sample[8:12])^uint32(dataLen))
+		if i > 0 && k != dataKey {
+			return dataKey, false
+		}
+		dataKey = k
+	}
+	return dataKey, true
+}
+
+func (kr *KeyRecovery) allKnown(from, to int) bool {
+	for i := from; i < to; i++ {
+		if !kr.known[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Coverage returns the fraction of key bytes recovered so far
+func (kr *KeyRecovery) Coverage() float64 {
+	n := 0
+	for _, known := range kr.known {
+		if known {
+			n++
+		}
+	}
+	return float64(n) / KEY_LEN
+}
+
+// PartialKey returns the key bytes known from the start, nil until they cover a command without data.
+// It only decrypts commands that are no longer than the key
+func (kr *KeyRecovery) PartialKey() *Key {
+	if kr.prefix < HEADER_OVERHEAD {
+		return nil
+	}
+	return NewKey(append([]byte(nil), kr.key[:kr.prefix]...))
+}
+
+// Key returns the recovered key once every byte is known, after checking it against all observed commands
+func (kr *KeyRecovery) Key() (*Key, error) {
+	if kr.prefix < KEY_LEN {
+		return nil, fmt.Errorf("%w: %.1f%% recovered", KeyIncomplete, kr.Coverage()*100)
+	}
+
+	key := NewKey(append([]byte(nil), kr.key[:]...))
+	for _, sample := range kr.samples {
+		if !validFraming(key.decryptCommand(sample)) {
+			return nil, fmt.Errorf("%w: recovered key does not decrypt all commands", KeyConflict)
+		}
+	}
+	return key, nil
+}
+
+// validFraming checks the magics and lengths of a decrypted command
+func validFraming(data []byte) bool {
+	if len(data) < HEADER_OVERHEAD {
+		return false
+	}
+	headerLen := uint(binary.BigEndian.Uint16(data[6:8]))
+	dataLen := uint(binary.BigEndian.Uint32(data[8:12]))
+	return binary.BigEndian.Uint32(data[0:4]) == HEAD_MAGIC &&
+		binary.BigEndian.Uint32(data[len(data)-4:]) == TAIL_MAGIC &&
+		headerLen+dataLen+HEADER_OVERHEAD == uint(len(data))
+}
@@ -0,0 +1,169 @@
+package reliquary_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"github.com/Fesaa/go-reliquary"
+	"github.com/Fesaa/go-reliquary/reliquarytest"
+	"math/rand/v2"
+	"testing"
+)
+
+func randomKey(t *testing.T) (*reliquary.Key, uint32) {
+	t.Helper()
+
+	raw := make([]byte, reliquary.KEY_LEN)
+	r := rand.New(rand.NewPCG(1, 2))
+	for i := range raw {
+		raw[i] = byte(r.Uint32())
+	}
+	version := binary.BigEndian.Uint32(raw[:4])
+	if _, err := reliquary.EmbeddedKeyStore().Key(version); err == nil {
+		t.Fatalf("random key has the version of an embedded key: %d", version)
+	}
+	return reliquary.NewKey(raw), version
+}
+
+func TestSnifferRecoversDispatchKey(t *testing.T) {
+	key, version := randomKey(t)
+
+	// Every command ends 4 bytes after the previous one, so each reveals the next 4 key bytes
+	session := reliquarytest.NewSession()
+	session.DispatchKey = key
+	session.Handshake()
+	for dataLen := 0; dataLen+reliquary.HEADER_OVERHEAD <= reliquary.KEY_LEN; dataLen += 4 {
+		session.FromClientData(reliquary.PlayerGetTokenCsReq, bytes.Repeat([]byte{byte(dataLen)}, dataLen))
+	}
+	session.FromClientData(reliquary.PlayerGetTokenCsReq, []byte("after recovery"))
+
+	packets, err := session.Packets()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ks := reliquary.NewMemoryKeyStore()
+	sniffer := &reliquary.Sniffer{}
+	sniffer.SetKeyStore(ks)
+	sniffer.EnableKeyRecovery()
+
+	var got []reliquary.GameCommand
+	failed := 0
+	for _, p := range packets {
+		gp, err := sniffer.ReadPacket(p)
+		if errors.Is(err, reliquary.KeyNotFound) {
+			failed++
+			continue
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if cp, ok := gp.(*reliquary.CommandsPacket); ok {
+			got = append(got, cp.Commands...)
+		}
+	}
+
+	// The first command has a single length, which doesn't pin down the length bytes of the key
+	if failed != 1 {
+		t.Fatalf("%d commands were not decrypted, want 1", failed)
+	}
+	want := session.Commands()[1:]
+	if len(got) != len(want) {
+		t.Fatalf("decrypted %d commands, want %d", len(got), len(want))
+	}
+	for i, command := range got {
+		if command.Id != want[i].Id || !bytes.Equal(command.ProtoData, want[i].ProtoData) {
+			t.Fatalf("command %d decrypted to id %d with %d bytes", i, command.Id, len(command.ProtoData))
+		}
+	}
+	if last := got[len(got)-1]; last.KeySource != reliquary.DispatchKey {
+		t.Fatalf("command after recovery was decrypted with %s, want the stored key", last.KeySource)
+	}
+	if got[0].KeySource != reliquary.RecoveredKey {
+		t.Fatalf("command during recovery was decrypted with %s, want %s", got[0].KeySource, reliquary.RecoveredKey)
+	}
+
+	stored, err := ks.Key(version)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(stored.Bytes(), key.Bytes()) {
+		t.Fatal("stored key differs from the dispatch key")
+	}
+}
+
+func TestSnifferSkipsKeyRecoveryMidSession(t *testing.T) {
+	key, version := randomKey(t)
+
+	// The same commands TestSnifferRecoversDispatchKey recovers the key from, without the handshake
+	session := reliquarytest.NewSession()
+	session.DispatchKey = key
+	for dataLen := 0; dataLen+reliquary.HEADER_OVERHEAD <= reliquary.KEY_LEN; dataLen += 4 {
+		session.FromClientData(reliquary.PlayerGetTokenCsReq, bytes.Repeat([]byte{byte(dataLen)}, dataLen))
+	}
+
+	packets, err := session.Packets()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ks := reliquary.NewMemoryKeyStore()
+	sniffer := &reliquary.Sniffer{}
+	sniffer.SetKeyStore(ks)
+	sniffer.EnableKeyRecovery()
+
+	for i, p := range packets {
+		gp, err := sniffer.ReadPacket(p)
+		if err != nil && !errors.Is(err, reliquary.KeyNotFound) {
+			t.Fatalf("packet %d: %v", i, err)
+		}
+		if _, ok := gp.(*reliquary.CommandsPacket); ok {
+			t.Fatalf("packet %d was decrypted without the handshake", i)
+		}
+	}
+	if _, err = ks.Key(version); !errors.Is(err, reliquary.KeyNotFound) {
+		t.Fatal("recovered a key without the handshake")
+	}
+}
+
+func TestKeyRecoveryPartialKey(t *testing.T) {
+	key, version := randomKey(t)
+	command := func(id uint16, dataLen int) []byte {
+		framed, err := reliquary.EncodeCommandData(id, nil, make([]byte, dataLen))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return key.Encrypt(framed)
+	}
+
+	kr := reliquary.NewKeyRecovery(version)
+	if err := kr.Observe(command(reliquary.PlayerGetTokenCsReq, 0), reliquary.PlayerGetTokenCsReq); err != nil {
+		t.Fatal(err)
+	}
+	if kr.PartialKey() != nil {
+		t.Fatal("partial key without the length bytes")
+	}
+
+	if err := kr.Observe(command(reliquary.PlayerGetTokenCsReq, 4), reliquary.PlayerGetTokenCsReq); err != nil {
+		t.Fatal(err)
+	}
+	partial := kr.PartialKey()
+	if partial == nil {
+		t.Fatal("no partial key after two lengths")
+	}
+	if want := key.Bytes()[:20]; !bytes.Equal(partial.Bytes(), want) {
+		t.Fatalf("partial key is %x, want %x", partial.Bytes(), want)
+	}
+
+	// A wrong id conflicts with the known key bytes, and its tail must not be learned
+	if err := kr.Observe(command(reliquary.PlayerGetTokenCsReq, 8), reliquary.PlayerGetTokenScRsp); !errors.Is(err, reliquary.KeyConflict) {
+		t.Fatalf("got error %v, want %v", err, reliquary.KeyConflict)
+	}
+	if len(kr.PartialKey().Bytes()) != 20 {
+		t.Fatal("learned key bytes from a conflicting command")
+	}
+
+	if _, err := kr.Key(); !errors.Is(err, reliquary.KeyIncomplete) {
+		t.Fatalf("got error %v, want %v", err, reliquary.KeyIncomplete)
+	}
+}
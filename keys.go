@@ -1,75 +1,48 @@
 package reliquary
 
 import (
-	"encoding/base64"
 	"encoding/binary"
 )
 
-func getKnownKey(data []byte) *Key {
-	v := version(data)
-	b64 := func(data []byte) string {
-		switch v {
-		case 2648100823:
-			return "ndbP19HXCGD4M9TemBcLtW5fkh2v6oA79p06A5/1ndlX85dC6p7Y2kRdqod1BpeHCXnmo37aKLO/d2jCykrSBfb5mK1Xcc3VG6NuwJet15nGGpCCm+xE7CqOBHgLnJASYF35OAy+dn0tXulhEhTKjIfPSReEfTwE6gG0Zm/zs7r3YCmSQTtkSZ6QNRTusIk95QjJTE//6Ad379gj3fUE+U2mxm6h+r5adj8ODVIR8NM3X2n56zBgzRaHJXn6KFJMgLl8AJD0lK6OAZFnXcQknxhebnWSHPUyiAENH6AiSqTNtI4vdtHzh6rWjEa6bArlAVpMdOyoE/slcig+/NlwehqSKL8B8KJFD3sdouwowGgEBQoapd3Cxwu5f4kUHs3K9+0aV3n+o0sVljnvtB2d+XXv38KtkYg1+VrnKs4mcZsYSDnOacQ8odTQHhcyIl0ofM+7WQ2fXVt9bt3/IQw7sdv3trWoYvsqesjaGp9Ye/JgeCZfKLDD3BMrTepwJgPIBc/TGD09Dp8B0h3TVAoSE2aI5JDf7UxF6jrj0Qhndpaee6QMJtzHi2MuByq/RDEz7aD5nWrOcVLGPSxK5HqkmTni1DDjWKI5SlmIzsMSNQbOcxYvXSSUV5aYJwP2RVa4dITnVkS5Ews9kReIfvUbDKdZw0xIvX30wywiQyykQoegWOJ+U8TOTUHvTFOx+Vivf9aS8K5zmrH35cfuUdOrydtdiadLxTK+zChtqQRIw5Gw130MmpeblVb8oJcB8uO85MCFZeMGATxapwrHwC4LYG7zyBxXh3oWRgFevrUbyffkFeqipKWh5q7lg6KGpqX264qTOBdAesv6wYplgmiMeSq2zguKKmpTAR+kB/wAOtoADKX5DZNgcguaa3xA1SKY5k1HReklZtGa7NnJSTEct4sz8tOuIBXmz6Je9JByQMZjZcNmSKmcwQnz/jlVYQ8QSdmJz2yiT31nlwKwoqXUWbFzwYfAzsGpVGphw37uZnKItNvOW5aI5dL7f4WBLpd7LB2BW3GIq5CW4+xez1iM780MJ5zWyggVGkou/YFDLoNKzYGtTAnwtc3Jy005amRHJRZxp2mXNLUjaWdyMt/v5DNg6jQC3MdetYjfK3acV7ZtYtTpuDY3SJjJkMgbMA2aVg/sIlL4jpLqrHxKmhN/gojappFjdMwTGVOyRx7AuMedeNVRMuCFhq37mAeeNx/Xy68bQNLuN5Xt/JmjyNCn50FtXDeKv7mJza7H/L1GKVpymB8YvdJZRVlczDqtYDiMAUsEG7BU+2B5eqzwfNRSlU/1q9nIA3VMrIDYVGVluqe2DIEh9n8pfHohBdh8W0O9eqyaJcn/xlQMpx6fqnLscgCo4g4u7EeNQp13jKgEuD/QwvutJlRCXnl3tS8dx317YFI8vIiNDw1XAPTARD9AGbuOH3IEjslQ4h7SRDxh/cS52bXWsYRp4U6kY7MXHQSZ3OY5ayUEB5zetYTGKNg/TrHpqojq3Rtu6w4wTWXErOREQFCJvGQlGvvoCYcEnuCOdhTb1YEuJxS9X89h73rrGGsWbrHtROJUiPg3EjARJlfZGUM4A3yF57oWleG9Lu7b7IwuihfB6sAg4FSgBjdN2iBZguJiB5MZDBLiEfGHf/unMJWf4ueFsS/b3S2t0hk8TiWxOYcv2UcwPuZEoILVa9R3wrR8uhIc6jUJDhf/g1FuQ+db68D7/suviUB0AmxEbZW2gqOMxgewzwsEm1bUwHb8ZQKkrfEcsEVAZRH7a5dPSgMHxAQPnT2pNnVVe6t00TgLtwSFPCj5hBO+wn6Ivb8RT9oge5YfNNfFe205ShUMz3cvtyOyauoVwrlPpKtg3s58rvjnwnklu4ByWBTDYp3IwHZlL/6pXzFphfLoJcrSi0E/iaTxLK0Z6Oiz7dfcZXcdz+j86eNf1zdRfP6mOAmaOFpON302NrByYLWstaGDjhRkJ6oG3/p5eBjSIyYK04GcrOLluQmfOm5PFrcc3MbnIyWZf9Yj9bWEv5B1f5w669vzvUV8WTgkrVVVVnqQqKE7vqWy0pBFyechI7SQxv9UMbq2pypNbVhmGQ7h1AWOeVZ/RbGl6oQal78cfpItp0v6SdCGTOGxaM9LBcOHAv7u6VIaPBngweHsJElSUjdkhwuk/Ugik2Koy5J9SOKAM9Y5jQbbJKX+Xf3DnYyCjpBz9SjQsd8EQKn+bbOdcQ68jfsTF601zQRvw0fNMOX7PhBRiBm9XULHRyot+AHXRruySLh/C5vlEVeX82qBInIM0v0MuLxOk4zyW2JVx9os5ro1sdAlQ6xAqyow6sgzq0XJs/gwRnCWFOfRa9d6hSwD61pzVI+xPTUx1IE8y8xR8jWVkOF7Q/sRWB7BWaxlW/TRWkG+HctreYv5kJIwGP8COGQZm3rjVfRoxLGaQXp3wnL/uU2F6g8OhIVpgLVaFox4VyI7YgVWkbkBlZnWUYpcEsAC9zc/yoRn+gMWoPLIWO19X6qX+dtAZsjfllQ/0Fntdgk84pL5Dz0FywE3rbsDvYft3RRQVyAC1CMQYISrb0nYH7o/oPN1fM6ippJe6BTTB5gHxbzuIu/Tjl2G8VutMUEZKRTAyzYP5W3a8SqFrErLcp5CYTKsyeFqLptAFW5w/XJdu0bOzLDb0iAQUTpYvl2FcdMmNi1oeyjCGUc2c/gQepvq12leFSwbpp6cG1t7CuSCv/SqusqCTnQ1rTqJvgFlN0YUcyQ1KHW61+IUVOTvGelFdgST0KNiEYWSM1ingxAHADE2ziUnQ5AqOQsRcWkHTLtCRnBz+aNjcID1Un9vAx8JfoP6hi+L6osNNiBqzrQKvOQCpk5mfq7LapxRWjXQzliihts2pf3N4QeyRsGKe+r5W5Q+my9VMfnRL/OnqpNwW3OnmcgQe8mQyyfBe5ioTgXMo+s6VplLBGvuHk9e4iSrptE29dUdDyMUcLqVCag+PmYiWfQMYB7yBEESVXPdmGR5Vqk9VmdWKNXSRjwqHqkmpMFICBYcVncLPoGL9LrYcRHETf6m/eNRULLoYwlp3+XM6zObloO55zmqslAAx2xA5IN4/BMk4aBceNA3F3VDs1KFI8H80QsZ/HrJWSFESM7TtkzQTiIvmDI6ok+8qAEJbQN9bs5kL0S7ylQr5Ros/uzKI6V8Md7mwgAYMASva8VXyHEQx3v8EMcLAUKN2TDBVpl8qcQrqrStyh/UUZUxvny3Onp4/gkWQs9tATGoHz7PmBDngnvyZMtOq+dtcGFSEHsVTn5+kXx0yeQh0Jo+vpfUD+fVBwvKno/tCuDrQ/NeGm5MXow8hwJnaHERyJwEPZkmD2oZEZr6CEO91rpjhy6YvBnjXEI0DlLuK7dRV89b8S7vwIByOes1z8N/dOgCtvJBkGX1uhtFb6yJcoJpOAPL92zJhZ2xPY35R3pQ18wbgWXEtdo5vwtgRb/OAptFdOOHXpDYD/I7NpA1w0uxRZ4Zr7gwbKQrj99knHjfyKb2bQ/11atNG+aRjfm5KLWpaeXkMRvxpgG9EQhGFFlpgjhQEgmnqX3H4Y9iHpXIgQ/w7MV4bWYXLAF7cPUq2zFwuNnd2f7b+0O/XkVbWYHpBRxUmh7IhxlAHHfJE3iKBZJ/am8SAMtcn0A+YAmhKVTk6Z258tPnM+v7MxP4Q1lcu1FSutflzUbJXcR9GWAsl/Q97vwY37uVcPzBhW1ghs/6JiiiccrL71aQzM5EXs708E8SMlEoJ5nbbKTF6Jv8X9FNRkVMbZOahII77r5wjUIfK6O3nPYw6P+rAMAXSySKhm2XnARaDcc9Q1RTa8XyWjxgXA+OUbJlBZTSTDKz5AuXJHjH9tnpZBq8VUiVMVH3YVC29NEzZ3Pj4eW+v+9QvYecoKHauekmaNgHNNMfkZFu8PrRu+7TvGyNs3v3l8cUtx/ikDGiHIjjERNuQSlG+3vwNTA/tT/q/HRaF7RffJ7b8CCUC4jzEx/2fugW6AbFrASHWUBx+EkjL87me0V6Wba+V1cVucs7/+s//nMoNcpG9fkBOx5im5jXnGYh01J3p0Pb57PtBs7axCnbSK8YBvVgYD+XbAGFOqbp3ekygSJUK/aR91Cdd5TyYNvYQXd1C/MfqW0/nKOYuVyO9KQOFlH5UXtXoyz+oIRya8Ri3c0vkBKsDrzJFvSAMUeC63X+69d1+ZvnZdPAF9XDVq67jJ9gFRIIvZrqopqFZFNBE61Abla3dcblDHWHBY+JuUoFM+S0Oc+7n+T0jBlgW03dnr8748lTIoBX878pbnIp3QuoIcIYv/isu52csEc6TFvEL7g+cdwmgrElcyrgY217yZ7ZAqQh2zqiiHvuwF10JxKtYbe2I4H0FJV1vVgkr3ALPMDbqoHiP7WxPqvsOJszRICH4IL7PK0AjhvHzzHwsyfC+WPR60fsDrz7+RbBjOOPEoqZ8SncKsO7j1rN5pVqlzMkgg7COYlmGc8PpJWI9J1FRRpFoOjyApVIIO8Do86TYpqy3cMc8tnbFab6hhe45WyQnL/ieQ7MkaubDaZpeXksTMuFzpgtGcjscrQqmsxx8iQze+3DAuqoNNKurgPTk70xVNxLr7Gzp2ibAiFGAteJdj3hdL/AzC6Z34v7sX9HyngNQifAwh+mgU6DqATIv915MtdAZRgap8LH5SwM9mqd3hBOXaGfqvNgp1ZFWZFQbk7QGX1lY96u3J6LVC2BfDSwOF2SZpBFcck0x3N+Dhn/6aBXj/z8zJo3Z/bl6TckOMqTiMqYsWoACxifwa9KV5kqNhwEXoyZtFsmEshK1/nRCouI7KsfW/RwoTynmQuk3UrRX5prOWrQCWp2Euc+lMW8QpMWmVVavRG9kfRGPG+RA9LztjTfjfwyD6S+RS+yyB1ntdHzAuhEMvvaueBjL1HElYEfDYodjhHlIGIClDVJ9VGyNEehuSyv4ydyaaqy7IoA8F3k+b8vfYdc2S7DgfqzjIk7hbz4w7KQGRA1Ltj2Ai5bZBknxtMAUNUkvA15IM/S3zFoD1zYSs3be2pya2r9LQjMd9mrt0doOkXxbDQ2LCSKkHXbVDeFYdmwTxqAJyqtIcmI2VZL+/dqDQcNOR57Ug6H2BSjNeVpzqMVatm1BdRsdtyFQW1M66WTaPWJvAAKCyo/+jLPV2P1sFT8XE4UrGn2v8staI3wNsp6g7d/RsdCmCAVqKE8jaQmcunZQobDgv8wWdjYuaaqJVCg+VOGcFynCG4U9GJf6TsgUpStq/gQU9kTOU4sVVajSbtNESxv4QzATaM77YbRFKkufEfJOkCPGTfIq9sb/EMqEtYBaqhRw0VHxSxzlqvvW2ONJfP02dMXwK7TY6mjPkHjxwxoGKbQfiuEJxTOGBfu3pYy7L3kJvBiZMk+MTd6w6CBSYogkLLLFqEqGGpgupfKQcUZzfPXTG+uM8Q9mIT9hOJgLrVzVtT+xeK1FQ=="
-		case 2224474369:
-			return "hJbJAUQilXVaUmjc08w7kbMDey46RtP+Lk1M3KriFjLcB4/hoyUOjPmpn3ZJ/ULOAvwlBQ0xV2+yhXnWOCJR3WQgQ3zfuP9xn2EtXibCU0MYKprwagm0H0+vMOi/UUy723xKASSgxrc7zKpZ895SGbY4vhsc7mFf6smulUDKUbCq8MHj2nXD2DWgKX8ls9jdTZgoTxpPIcAYCzgIDzTc+6yr05XzJqTOLl5aRHpph16/qR5k3IzniVKl9aLZ4nFp7d7qxwEbkcEZOzmFmZUO4oycWPRAwDXJ2L7KWLUmFWQ3YqAZ6G2jMaoLJbF6y1OEFYBmcwvqbO5qpbpnmghuiyHRdwtXrbrqI/proYyXPTNElQvwa9le1fA5KonfvZjmrU3zhzisnwBc4TJWNVNN4LsXDlRfgsIUdU5WKyHqVBIYYZacTUMXereCzsN1O2853M+7jo6VYSCbZkfyOU/LGmrICDNgGkmD3F4v9QOsitVXPAvaPauVr8jLQ/TBtZnm7JxOarXfblkGlzQwtEBuiJaKfvFLIks690COEHP47PQ8oNJhC6F2+9CD5otubSQLKsYNP5pUm7P+qAz1OZ0o/HvKr3ZbfYkrg7kKK95CIk/6ds61gXWcdlqbbfsbyedtgamUWYcaifbcgsXAVrNG026lC3sbMiQoFc3heT5TbzMimfUF9SPO2f2oD60w2jmOBUxbH85fac4T7c3eWOZrHYcBxDkXzOx7TqTDo2bT+3BLyjcA5DbzMK8PdC6/CuPLxwlCNRaV4kiBozDqbDYjMvOjDrFXhRJmaeWg3dIfLFjX56/KdLWTGO5HvQrR2/4tv3sEcTA/TjC2ctON0UqlxYbTlk2NG5Clwc3CG/SxZgTx8RIYY8ArXjvCN9f0TvBhcJCNaT2fdw6t9sG14j1ktapiaTpA2BCa8WmubY+anUNTix5o66nE5wN+GAhFLmxzp67XyAvg9Xd4bBGRxc08000rgUczsujYxwQifq3Vj8feV9DYq5oPKPQ9xz3uPy8eQdZcLckctTggXLIrBtKWopZ21bpqLzqNy+7NzZk/opi8DbF8+fQ6v/hfcMXzObuUk9tDp3XNlmtoJaMsfljFQvswp/Nf/4X+U8bTL+75n4CJdvgXTyHuG347huxfLLTmvooYvojpDN2OWZPnmBr8f+MWGIMAlAPlpXxT2vDyI0A+1byYNGBSjXS1sIyS2JXOnjNgU9MvfaxIXw72GmntpzqCau8DDOiBu9NP72sBtxH906hGAgy8ZN66v3+4pok02IcHkh3pkAtha5s6CE4eySWrfUJhfLmrqYPJJzIfGF6z/ilIBeg8jWiZTF7vU+TDF8LF8C8jN5Y372Svzzk5R2sQqXYHuzDF/knGnPYwj1Dz2aI78iuLNgj+etuWwW55GJAAt1dRHXldBmCjstpNNKgnGmBcH3+L+hT54nWEya2jy8wSl9dqAATy/qswXgds9BmiI+ArOLHZLNl91xfZzXHU+09zLrTELx6eKubuG+pEBbRsTmdgbgM7uorTrD0kEzZanNg4fzoY3FL4QaZp0SyWUZQ1VhMgzlKNPQUgZX70EYSiL5mizv4z+LqdjqGkzKoFfOQMMQNg/5ihulanFaAhKCLrP/SdtVHpWByRvWdcwzR0QLUSA+5rU0TQTrIx9OsgniYHcJHGUmxvJVDoQklOcjzWFSPViticUf/G3YE0mlrhl8kHhBNzBuxLo6AyiAKnEKQsgc/IpQ8eN77eDm2kd/3ADtKwWAlG3hgF6J28v02srYMu2Lsl9U1VCV6wW4RHYyc1mw8v+k7WaY0Y6RNxvODkqc3af7FK7rt8IJAZZ4fuUKGHoKBQQY8RLBVTSUObLQIu8slKsYHwNNa/ZZgHRQUYeoV1uT+/x8LGFRh/YKcFsPKxfonKC6w3EVGGUaiyryfABNGsnPy68Low5xSNLfYmtWPgUlM9z3puPdvHfA2X9k60xfC3tiuFw0ZeJYCRtB+9J6Nb0lMTnMclA0sEwm0oL8ue/vu2wVQ/Z9vI5ivRmIoupwzXT6MuFypT0P4PK1KEMkAw6Ln8OabJBuchnpQdi2vIBjOcUahjWC1X97IOmrkP3i/DT++wMT1lqcN9uoLrqKYjuMLVYFmL/Uzh2u9qc5yEzdciKvNfiRqyrWYviJLwLB4KFHxY5xj/Zw1Z3rWzGxvHgozgRxQW3qNxmOjX/GjM6V6DlgOoE6zwVqa7p+wvLh+Nc8rVr62tkFoclPsy3lp5TxNT1zo5r0pztX9DVXHLSdQMQ64DFFcPNrxvtIWPmHYZIij7D57L1SS1j1IRydPoVwU4a5XHZhU+z8xdI/osmtD0/HNHUR1SbW0no3JcSoh0rTf6zisHk3Lo+QcsWbb0+UeCyw+/N49+rT/AsKZmhdUS5eF9iT4YaUf0DzLuaXcM6npwxqBxnjYGiS0EoRWWN6BDX1fQ2iSkCEJy9Dek5788ZL0Qg8Ss5YVqRhNf0GPO63xW55iTFqr59mOvzI6rmhA7bNelv95Girze5+6v9yPlDwW0LKmcUW4MVUgutH8F2gv8KTbnUwUpi2vbXyxT+RpdLdhpcdcJMvvGC84eYQ1dG4zkcAj7nj3tGYXlEWYQISEEl2rpJ9C7Yb/KVKKYPI4gnEUlwXZXIgNXUA1m+jzMScKHjcY6HCvyA8Mg2I8KDItOK7itSktUphYVToqRyGLTI7Pw/ogb0/PBB4j1OuWrmEIu1HBxnCeY6ufgqESkkxLPHQ0jY0fCqW79wyDdKUndDP0WJKNWp2BILedYTvF3UE2jpciWpTK8QPyqdEijPI+RvFTBeurzZXan7dNxK3CvOyPlawurqf7ZVYFv1D5616gk059b13DrnKcETB5WolWz1P7foxzou2pD3XJsg5OXcjiCQylfipHM+t1Z6x6hU2WMXaRf03ifJeP4w4ZxMLQz5j9r+J+ay9fNmTc7NfpJAEUTBgBQYjW2tDNbA0P/+cpRA2PjzjvyTlaItSMq0eCRLubIsdDLrRy7e/0fKQIJ9mh1+jwjrd05DAkmCpXRmCicjcklv0CWsl/TWl3wwkW8N1FF3WaJFtP+yjdGNwUULLRAGH+5xIG9xrB6eBJziw8v16bTdluHbEJn30V1QyjskP5R2oPXmQhK3spBhX94G6yMUhUEL0hOXxNTT2VLX4NXK8Z/k5mH6dZ85Kga4xgV7nJEW0qpJUyYANHMLxDH1ycbA2YpsQmAKehxpElpudglUTMpu7sh+QlWb/o2Etw7L0OR6fvdRtDiLqkjneuSpxauF28ShxtkxLxIIGcYvBkNCLNcGflTwVd7qRQm4ZuM//6r5/qU8kd4hSJwWklWihrejyAB132FYwm4Fs/hS3XTh3yUpu5sTavygCvmzw5An46DxfQH1gMvQ3Se061VBQfQ1GNf/r+Sq3AyOAcyLNUjgX+72VCjuy9HNo4S2RJ1dvSvdH+LOORUxThgjI/9a4O5jgBB9gRanSyqHYFI55d9WlURfkaARK1DxMVnTBppv6dL3Wf9CowBwtvv2KmwDnfPucWG2t7g04XbkDQz0UDxGqEMFGBQ1SKGLzQHrrh36sJKZ9S3BOawu6aHbAjvYuabcCUKcGs0B3OP7Cbj6NIFm7JReSQ9XSc6y+9pvHTq4poK2zKAw8f0DAtmAmjBPL2dOhJCJ5J3nk6My1/cfbs8R7x3qOTt5GOUsTZj1b0KDKRNVbLIGPRno0cihNHl4TXWnnT8PrN0+CjawYA1cDXKLCXF0LvM0x944nO/D0wO6TVknNip6MIZec/hR+2q92V2OlRYNXwfsEHJpZ96C0cSeADs1f/7kmhNg2dTluZ18kB+Sdf+Vu+yR0/AIXauai16olhflmE2MoUAfFC+u63/i53xEvZwRd59wr0TYF4ZMfAfLV+sBue3cWVawfILuhz21dpJJ6vXA+PCdDtGZOtuBwQcPpEKUEZtWJ/GFfAU2L1rZgmuu+EP8nhqxUYLrQrZxQChEFW8/KB7X7PshS9dMjCoqRrL2X1xExn89ixNO1M7UFZUgYFk1hSFMO+9+EWF46HyF3z2J8557WjPuvhcdMrAniCtTdCKH1oKduQVmW4EHZ7ul8ilx7P/aCFvAHkAc99AOlKQLODBYchb6ceqgBSzUBVrNymXhdO0H5TfNMi418XrPsPUuCs5WpPrsIUHflk5kGqp3UqDgrU4hIGZg+1BOToMzG+OdlIAv9H5OukOe23tlK4dvokoFTZS+GqY2XNceMpq7hFUVuAtZmqRkp/D9tjAqJmzciPARBKv83i8RIHNGPFGi10jR2E+kb9ub9w9JuMqAgSd9WOAELDh26eoMQZ5uwMAQh3wok7HB66uP0001wb2KUBkU9RiKVJ8Bbi7q7KqVFJWQZIOyT4iXLudi4z8ev5rhTj66SCNLmCWJiYzgnyhM/Glo5R/w6SUu3K42YoGLvJS1YXkPtuPKIL9IEN7/gmUokEHm2k5jcRldeaM7EbRPbqi3urEbcV0V+bAxXU8PXBIPbre5YADqCC2gfTN2ROsdVhIOOuKqLwiaAmxban6C5LKRfIr5F4XWJM+7vVVb96p6ZjskOMTp/Bd4R4pi3xauz9RRiElnDmveKA89i8yiUJbjDK45bVQnTGlJtSfh4rvk+WoNXyegR4jIGWZrobqk8bi/PoheefJujmRDq4Z/n43uubFPd6LcH0Ekov0T3tN1xs3ob+KsD4bwUUqmCLnVrihcxmbR9KbM7EEynsIew59FpKIyLUO7FJZxDdWV59a1LzMjTMVuaSr1RKNfZcXXGwAxWYL8XZl3eQiBNUV2bqQ6iygX+TXKhNobwtwmJln9ks7La/qe3IiKpRVBnqvPtGJ/8zJ/vdSBfFtQkFz/jvUdkUcMOSF460nHA8S9AzyBrIhfhchR/YYRaXLTionKxaSXqP2BBYO7x2u9Ur7BSjfoKPkHwacYKf9O502tLhWD/GOa4qcdtD9/Mj5JhaY5V1x0dmqJwGwDaqKfeff9Q+3a4HZuv9Vay+5FVg8pjgv0YsV4MVNu7WTS2ZGKtpSj21lq/qtCztU8eamZ86EECyIL+UHOV3Y8qEC8PIZSFYwUaodfpzhWVFroS3Ocyip+8bupq6TUiSCHD9I1ZrABx8ljDIrtS247Q9ABfnH+zg5x8axi1GdDktegv9xTrAHgn5kdTNVoF44T7SI8+tN7C5HlJybOgwarB1zaDoX6U5pJUWrtYZZfE8J0cbF8pDhtL/4E5ilY7UXiWjsswTJMLouu7O5hfhNXhE4FEPspKDRtMOy2NbeOq7tKv8KkrVsIVs2Gsw8ZrLkWNvy1jeEefbNfPkLPKCWHJepslmthQPtnZAICB3XpJTp0xAClD2ETNmYYnXtypAHnubIMg7emhZurtooTd/Pfdns14H1HpJMufMn9i1Lew0eIGGbGoxkF3jzxhCX3v3whmoRTWQSvw/8qqe0Juqrmw=="
-		case 392989049:
-			return "F2yJebtTdisIKi09V+JrvQhNeLOwmSSPCGKhhq81NbmyPF8htdpLI0SU9KbY8oGy4p3nXLqbme6JY15s5kB+CpGyXceM1np8Jg3CGVlnCXmRA5MEDh3DwabKyUJP3vHLoOBLoXEsJyy3dLeOH+whZXc0ZbucGN7dYxEMVs5cESvSmGtgaUU8+hGlu+0dnefKYll4qZ2YbK/1b4geO7tLr9db66aAac3EPx+LUGVk4UjN5pyJC/MyDJr5O1qAS9J2CCHllut+n49W5xgM5WNGVoN0O+5dw4xdvutRc6Pp4e6aUHzovLyTGmuBizl4T51URKOxji+VkXYdFPIEKjR92tWPCsWjIgoXK/x60X0hd7OdqFLo4aG61/S+6BiDc/FVbqeekzo1/R996I/gox1tKC4Aft1nqk55THnee4SsZunrtmQMm/vkx55JEf531CKtqQE+H7Tqf+C70H/DOrDrWlXZjidDGIMd/KqkfEUVY2JvW+uHi+JoEDSLgKywvuC9jziLlwj1ox5Hox8viEknlMS1XGJQyntUeVTVC/v6JdpWV1KLMQBITG74fkPRbVC5UHcaV5LrkRWUDvf4R9RWham2fyLXMVz2fa5DKQPLEtahtyn/AmTVZF4t8rQKiTWLx66isoP/3tA5r/xkH4qBIW+TJ25sFrrlonq/IWKHlK8lmRJIh6ygxZykzvHsjEgPrXm1Kf8SHemLNZ730o/ipGKV9K2a6y/Xv1ntbUxZVrrXoshifWkHCjT9vXZ6Ck5l7DWkcwMCAlVe0urB5DxD2kVcscy7lIyJzBPMIVBE+GiZTZJd6lhc2pqD/m0cIPeHaHAFF86BfxZKFN8c/uyaBYk1bTK7wVdEZPCKAZWwmPaDtmFe7RKOZYkfRJrOT2P5Ro6FDDTrktP7Zt4PaZgX4XIfRaOjQ58xSquPln8s3qU4oqv4PZ9ZOX+CW8GMU/N6+CKHL2hTacUzcgUFnSJpdGFO69uVCocXJ4Wg6s+bdlXNaGSxqcF/oKAhHsFVuIvmdfRiiA2elf2XSiHrPcYfJdkYUEqwXUrc8ffdTMqJsFOlpmrIOq9/BU68qv1ETj8vm0aRlYA0+T2v8o6A33LY00ozXA8/m0QkKjhnvjxvRma99LAFGmPkVragtsr/4ThYNLhrXdwJ4O5vtVh72U8TEX2InJSulLWTCjwJH/NIuN0en5AQRDcKrSvRdRyszb4nAkPI8IVv2ENSRpOvjtXp1RLJovme+kNIyiG8OtJXC4sCv0Bkm0+quLpM+Zkoq+k2sZlBsQ6OS5ocX1+lcuo/U47ChbdEsL8aHQoOijIx0x9cmUWL699Aq5iU1LvV07ezfxR4/jFppoixWfTiTIwyqSsEzeV9H1AEnZMTkk3Jq6gFUVqroGehHuCZyWhOCbmGIWwY+MYg2JwlIYAPWsh3nEN2oS4JDP5lCk5RCw4LXpS7fyF6MNdCsU2SbRFbKlNKm/2oWycZtz1ENNq913yoJrpzgzRDcaRtJXK1MHsgiOAO+HS4tgQgkcpyI5oFLtk5s6c+CdDMZPw74nyMbou5Spzh+i0905vTZ+jYHKqDM2qTYQvR/C3SSdDSK0wXtpzUj+0q+GvfKzlp7k067K5hF0X5Hg0jJEQm7+ywxSlkwF+c+gVUACRNlzf+wWBkD8d3uWObavKv1s0nMuYBlfXff36ID6At9Du0Pw0a2aZaul0Lb1AhtNNQXiwZM3DrZdnlKnXCVj2nkrQXoCWrL9yXuRanpeYzWIy6mr/UFP2bT+kCnmRMGwMu9wkTgKa9GED1NdthwbXWiadG73gp0TlJbcqjktctopE012TGmQfLnSWsucUrk/nWfcNMka/vks4StHCfoPSIeUZQQjQnAqVnJWrxgcjqLToCnp1xZAneeqSNIJgiBCaJBuDkivjtmZd8tN0J3PYa4djYdz6Um0YwHKDpmH8CWqpyW+sg9c6MmRUtPLJLYEnDg3l+Ui+kOIM4DTH7hqjWyhjvTX3aWBO/eNdaCENTX2+CR8G3QoSJCFkJiqadS5LoSvdr8zQD0bLL8eOQvv3CAM7f7CSGigLel0w+mOec8RloxHfnhkrK+5d7nkL1mfZ318rTsViQaSjPiYnLuoUMTHmTQ7bpbEbH668Ir2yLPTNvuw/2b2RWHlipusEgQjItKdSg46KQEmKlm7H26udt4SO1ruWl3pmE6M/VjewbYhwqfcnV+r7glbuT4W3ffLAsCyXBhI4+fgsw9sFKTAuygzy+BhQemQZavTXvVqDAoHtmzr8+HA1kFJZ62Du4rwkwGvuDyXmF5Rz/kaUM+TIpA2YZwOVOZcRbRYo2DvIozUabMDFrOtGm2RnytUMT4dSlBKC2fQLLOtrpUgjJk/iv2LaJ6c8foF0t0IOPcInOolYAPFUmzRQzs79t8StFWOl4YTZtezk2TXck3IRr3SG3vvGuK/7h2Pcn+7ja4cp1ZXWXvleFwovuPEEGQpBpD2/wBo1JcwiZYYPe17DCATfNKMz8giHUvXq5WKv1PSAhFrIgoI5LcBYp9LcJGLdMEcMLWpcGuX1nURBU4CfOmc5XFzOlKZy0jDdUlVxkcweWB63uctPROzPIy3S+m7xKZhGWXVGEOw/rFbc7zYgH96t2nwHTaEOU3BGpySK270PZpxnwCf8Gg30TTZlRouWof0wJD943BW9Z1zMlo4aaL+umubS+HiJ95Twlez8pxuI18zdNafW3xhZYTAjfw3GA107GmUK4biqvefNznWpTkY6/nvKUkO5Kj+Oi+juKEUgoZ82Yom/FzcjMlH/g+lLS6hcm2FPkYzjltb8KyMPCmZ6dJzkNhiV2ZFrNIhQ72XQ+14+nzY1okNFqMkSnsQk9OA1QQj3OZ0VrwLeCp5H1OMH7N6Xgl20LTXngcd2DoOUO/+rt5r3L6OvVvwsI9NMB9Lsxpb7rPlcYQWUPusR5ge78V8zUmSUeqcVew4YvaglVabLtNk+M7czYqezzmmF3kf4D55EAJug20bCPFeQX46lwknVdU3lUd5RJfZebH4DfQ0LRPFRgUtETuzFsdn4pj0aHTE/cxlfMluAautetIq1SHGia+hCxBrsKlsUkp1GviXo43j5IODjUr0bWo79C5a/i+EnY5dpnictKNJTr+0Q56wX41TkRID2yAiEJzkIJKZSwUReagmIm+GSHM+iZIU5jpM96ICx/7Vx4Ku7O/IZ6OyJsxTGYqRdzQg9jhRwiE40zTko4GGl+MOWe27LJOtYwk431rixnM9DR/TeWlTKgVMHLDq4nuZrzZ6L5cIVBBc9W4H7UG/sre8SA6EN3+R5HJW6OCv5W12ywuy32echmigqfrg32VAJ1xA/d7aFEr2uw8H4E5PK5+iXdciw5asXC86BEii4hewTgYMmV5QPy5KdCL/KW7klwQeIfvvwnmcKz7cZ7g6Qgppi+FAn3EfHKsh6ImvsbPWgUZ349iqGLQGnLZP9S4fUSo/4hIqyffxH9pQqWuIUq6DsIaIoaXt88LzdS6JCpb8EuNpwVJY4/prTEi9BGmkOiiEyCNGJyFov+2FCcigjgdrlHDLo2uxl/OCSl2oNc408HYHRxtjh5yP+G53/Ug3+OfhgEHltztvZDBYlrOSaSft24G/teTivYK99uda7aP33B8s0++BJS+dICU2nNzTyFXWj44oX7qjpH40tXPCHqJy5W8twhvmti6Ee5M+HTOyH73ZUyn0R3TmIdtaAp/axN3kjUUhjziLYX9/s6ohPaj07v99vqhckU5a5BacX0nuxBnh0CQJo9MMTIvmrFzjF+h58FjejLkLY4fOTG7A6v30WooXA2KCI6JN86GPlwayNirwCFq5XbEN3gzt8QuApK2ZjefY4pZx+cgfSeJZj+z+d4WhHT7ZhflOAsfnazX7XrPIy46GMAMXXVmMs1iLCNZLr5ubleOxzgiPbGEJz0HX3zLDTkW/v30AjU/sKMQMOSOSzbLSOLZfU3//CrHkdP82aXHo3eSOPEaUYXk/zMhTvF7qn7brBQcpsJfrpV5KxcVfXslaPt47ADHeGEkHE7MC+WqysqHdsuTMohJKTpc3oBkIdIxiMwWA3RQlWhbJvxvn6N6cycK34lYvjebf+5jDKaiE4DAoXwa6ApoEMuBDX29FfkmQmZgE9umR7b3tb7J5pmt51WW4bTauLgngOgYwPiEyfPNJTCEIcFoXcNK1rlWANZknwpFSWahkObcouBCQEKOXeCZQucc/wOoObXM5wasb9sre3bQ/TW8Uz5t07k+twFYrg87W2zxz71P1XLdlYAF94LeFJ+TTw2ceZzCtdsZ/9kzcWxeS+kPStkbUeVCBCpIDJpP5Ela3yG0pVrQeBLvOtumP/TgDfIB5d6FBx327tDSqUsDDpiInhmr/y7/97FuPXSzHjY7p9je3+ER+Uq6izcs3T+JqGQoDdBk9WmpPfbrKztFGmjyWM3jQo6vmD8/tNPJcbpUWhDuxblIdPBdm5KQvvOR5wg9pRZykWvQ1ZVWZMaOY5FOPQPGrAQ3SbtERgOGbWLMKRcZ2sbsytiUvLR05J/heKoTrDWGv4xCsudHq3j9gH4bgT5N6Afj4YcTkZgVWKFYB+p5gdJlQHqq/NyJSH1irFVQBQ5hZ70/fXhxOsJHxFTrediiZFW83mqAr2LwYQikPcOi9i4iIleFL1feLxeB2URAJoICFTf373x8yc8CM6DQLTDchHjbpkO4qmLiGxfvKk/dUZ2CXexNsScee9MrshycTAEPWMMRRPa6BOatFfqQoy6e4o6/pFB7lm6AATp0GhCMOt3r5RnzMKtCJs6gs7xGkSMvZakgu5cL/YF0gpsHeFN0Ou3XQQgUTjuMPwI0n0vuJB5FH9yAAD8cDzTRNurB1xZHbw/Ckqynyo5bnfvZ2nHv8IvLZVE63pfCfrECnixHMOJajN6GHHmKobH9P0scZTCKvT96Smg6BjEHpC1Src3Snv1zMZTach+W+vHsMpwHqsbmNMkbpet/1tIVm+lbi5jJnBh7yOaee4Eo+URCjKoWJNrC/yzhxL2dfmxHkOzd5BpeRWetjlS/LguGahBlXPoKA131ZpVID9CapPmWleOFHTI/3t3odFsiD+f0SBCtIjBL+X2NxifQJ9PBvHp4l0fDAJF2O+WqydGkI9O0OIPYWbhU72q2ASPhaM5iR+YQMtfLIqWQpNA38t8ev8Qu61fL6I6F8sTck41kJ/nSJBeLwQ2wejqmVVyfcrtFXnmSQ7Oe0bbqxKmjIOf/BxwpToIWx02vbyo+F+8ZD2H2vI0sPHVPYHewKOu+BS5PuQg4LsPNF0llRB9XFCQL7trXNaGcBSq52Vujcx2IgBfMeIkyouqAfyeKB+gliCISCMe0L7XPApaXVZR5Gj+OrUIeQZa1Ied4JQu+PVphf9wSS0RWqRAIkOIcgKnwOXDKjuXGBJILttA4OJsxXIheigvgcf8Rg=="
-		case 2142109362:
-			return "f63+sqbxpNbZxFN0NJIfTvEmHvlUeejp8xRvOyrGF9ui5VcWZGi3pN16OO63d225SSSdg0ISwc3eTZBC6J2yvNdcMrpMQQkScowKVe0mxzaIuyGkqAhY49VOltILLzOif+klm6ouEz8kMBqzBg3cnwr3N4MLHBPcogVTxTaAZUzQttlu6aLohuUmA0xw07x/SFQuHtOCMI2VzX1aJHdPjcrF+tt7Qdt42Bc9knHcDZgwRdP3E5O9ZHA80sTSb7r8zmWHR+mkDG98MiPv+/1g7CH/z2e1iiN9MAIT5ikLU39gPCCcAhrIZV3pSQGlB06M/uO4rN6rPIui13wciMFhekCGXkzFhDCJDZciOBqOV0WWZ+px3ZAGXCV8wFktMygzcj1/dAOGuKlGxokCofQtlo8urZpylp4EUeX3FCHXi8e+00F9JPj9WELp6nmZvb6vBdGXwOjmaoiad5YMr9lTmOT92qcui0/SmirxLwpFGoLc4VeeuWSe6m9Bdx2Fl8mUHuDxkGAoZ8KaLl3d5ZGcMu18iGIOv7lMzfI9WlDnbnnsCPsiSYMCX0KQhu/GL3uBLx9LvgQ97grwX8RN0Gyd9Maww68kl232cG5IwLBySksfv5WWuNcRGGn9+0zG8B5adxGykDpgMRWD/wFq42EjxCPqt00aB2aRXgyfCX7eLvfNx27QRVWgqgYgEb5Hi5KGnEpTCbOdg9iCuZgdjZM5Z+SYh/oS/R0V6gIUKpRDVPfG2hAZJpCn+zsx5m7gHl96ZCOohIuUjwJuhCBIlWxmAFq3E8xhp/ly/eKCIKr9yL96RYWzBwK8paJj+hbr27/C6vDr2/w7SXy5RtDNPI7phADBS+vrwJTVJI7UCv1zIhR4fZ2FoqUkWy9e7uIRRXPRGcCYaTu7yijTf13QBUlpsRJz9qJ8qir2Ub5D3/gTI+xoYdfb6WpDxfG+5F/ucfH5Y5d1YpDCETkVH6YqQ344wY5MlD3rfvcFlXyA9NmR/mW3el8ZvRB51Pj8ZpCla81GAbV+WJ/0l1lCpbJzct2cU60hvrSzz9R4wy2KJVFCLgtpPi33ibGHcQmzJAxMPxdz8j/lnSEpuhgMQ2b1As/1hX9gCwbuhPZs107+ublQg/bOts6ieYoA5rO57PRoB5FllPCdY+Ws2tkg1miis8KGK5lKkWCDjwMFh9z3bAwQsOitTViNjzwNPHB1uJKfcPsRWhvV/Am2xDHEaGMlRdslpDoSpIkkS7kigC9OwEczJ5H2XvlnVmehJ0WLsyqU+3byjy5swo6KyacXfHVPlmfjXj1u5KixGAGD57Kl0ebonuuaK99DdpfEsmMGru4BGtNcW6ZXaAWTzmDVYrhtxcE2Mja23BsSO3RiWFusQfu9Pfz4REduyZmmD8u1I3RKjxJ9JHaOe2moGS/ahzZuptZ1rUE99OZZ0jpKoxNFhBIoaqd509NUnU2PgvIAIvGj8yd35X8O2xvHYYD0Mz8SMXiX+SMguDtRbqn9WIU9Hj//vZhwEhiqlcm99mplwRgvc6QkBQDj+7dM3Bk27cVGMvp5sXGpmgHEy/QgVxGPCOCzEBkI5mXnBWzXL5DU3C5FxzEAE7sP+ny8eNsMR8wxJY8avXNgf5bAUaVO2VWyoHJj7qGPS5im1g7lOQYq1WGL82JzK900XKAtZ1kvhPhqfvvZJoylkNqmmlTg5ZVbnE+NIc/9D35JSMPBVTsqCPFxcgBf/iPEvXC3zG96Fljq7dfDvL1ACKVLneaEHc0qsfhPkyesoMv+N+YmPMRsh7lDOPlkrA8x0KkJffsKGQg1ds8xjso4Ki/5ZATpH2rVkWDDrd565nABA6bk+2UmbC6ywF0eDuRTt81UN5SXlVp3bj3HjlPrt6Z/fd4jh5T/uOGPFH5Y5ytahJqT2kSAFU3rwkk++pczhs2X9BlHU0OQlKQ4kZr76ePYg0DsnEVctRjkJME9t/CBvLeYeQJZzGNjuN0YHz4RzzO03EQLs5qK2mVeivkPL5pWNOxC4v84qURbZeY6iHV9Nyo3l6Imlgo8KCKur6nM4hvASKCq8Ogj9AU/UEI3a9wUCY6qD2A8pOkMiICa35WnpCNCcCqLGSAr7quR4I5ok/NT4wrx8rH4arzQFdzULnqichaNdfF7blGjpDKpTU5Zl1nSjBfOu96/EonzX9WbI4ygmddzZVwWe8hdlN0uzcputLR8BptX0Y4PzsR9ZJ5scbpEolOtCI1NNrfMKW6Ss4qTfKs6mD7kHStWVJqAIfw/w3mlExYuIYH+Nt+jPICVMIOivgE6ySw036OgDqnaR8DNDwzJUwEMsXVwO5yho6ZhKHB+Zbh1HolIglIHp2TPsuA6cfvYVIH6DAmHeyDLZMMXcToQfhqfEslShaHY881IGbEAQPofqWol1q0RyAEOCR4x5IS6n/9CQvY/iTmK4yYOQ21d1yNhQbxilwNKSk3qeYEhNYgtnHC0EcLgNm+Tw8iu5g7hAp4i1Snp5e4RE9+tX2WCoovz+Y75AHKQR4aNWGszuP415hMyIyA8p5MIHkRvTkoWrVA40ckt5DyM9E1CqR+ChRotiy7T5bOcIHz1hWgveZHNgtePPGiIxC2rnmq+LIdeE1cGbmlQhPTA2G+jQtBtv/GRFx/cELSj4zOr6cEHInu2AijW/X0Ko03H14Jo1HBoZuUGYIi6CRJ0JnDutSistSWSwTIAll6HeCV9HQyVOUSsEJItxGpY9LPHqUuRxXs5trhEU56D5IH5MqVLpQoNuXMlMrawW9xQIlc2Mj6wC7+i5mP0AN8JsOevW8vIy5HTmFr2TCGesJfXqYJa25MZYQn0aldX40zzLU7ZSCMx9SfItG8HUzZKFn+L3zgSZkOlLVChOAQYAxuVOtywwJ22qNaqaktHJiKB/rZCozxN/LHE2hKltpnLnVwdgJRmWXLI3dXUlufgHW+oRHA9LL3c5Y14ww1qlPwnMSjKxYJOu1DSsxoa9M3VWhiftanmIYxWVxJxqtvdldmFnekkt4/cVBiA0Gi+GkPpyUFIA67BU7hQox2/QWqs46nYBJrMixcAH1bqENq7G29uaZ6Kckj6cdR+dsUbX/2XHIJuBB2b+dcwACeMpc7O+GD7tfBpi4eZKqUtC2pngoczLiZedVyV2j1iN/aRve+NqaJjdYLz6+r0g+8Dk/6zUrBFubnX88EX8NBtovnPuYEDtyT26HyF08HQEqRhmL+Q6ck7K3EKDLhMQeGqf8dNX2KwzlOi1sc8ddN4IrMPntE2r6cn2HcLIPE8Xo+xo1NrLvhk9CWvJCweKeXLWA52TarQffd3sPhV9q43hVwey15/KqAoGZ+U1jYZdY76PXQ3n9rjS8lq2CAoN9ctRPOpH06Yue7j43yRjz18Gyf7qvPPMdNwYPqExG7TNYh2C4fRx+gF7nW6OgAHqqD2K6Z22ZeBry2B5vOqFPGQtjgG2fJTLcObpDnPUVs4voJEWdCm1qXgpWuq5Zq4uCs93W/Q4GEouB8O/hZtaYKJyx8ExusRmlSvLfSsXNnDCiUi0kxiNYg5E8W62N4Eg0ff8CdcnBPuQZw3tu4TxNDbedG85sXCekxkjy2D+3Oaff/f0wW6IoztEGMUc3PH4y2DBeHQaefOr3cWBhuae+InRip9hG9ljKKH34oz2a13o4fnFSnsjrKxLvZXV6dycuLXAUkCTLageMdhHRHiUvXbNrr3aMxquxLYW49hrcFAazK/V7Ih5GrB6DEZRNCw0XBraBY954mtkcXirRdsyFsX5HPiZW28Dm5p2Pf32GHUiG38ZifYekNqN0NUap1wTmLnLOur+3JuEeZeOKRl6P7T8btC1zxZrWpvroSqwcgr+hHzxwIfS47NmGbp/8CYf9jJka98/YTnup41Z+07n5JmAZSrOb/yO30UGiHezo47k7rVz0apZK8AI5TLN6mX+OlY/cE0DVuNbAFHyRQ+JgCKwrocWcxTze66yPoEli1WW2tP25hmrxEKZNAgvXCsHQBoYAJSZH3Sbvk7JJSR2K7WurAXYQiFXYPzGoQ0FrNjRXV/n7V0LWu9S04VivPVFGoFPUSyCCzMvLARhWIwXFvyIyxQ70HS3qPbEGns3ezfiuvEBlwxd+HkgmfdPZvXhfqRb6Oq17i1xL10FJCih1XZMyqeMPqfjADGLTFqbxPDcgS7BMMR4pfZ1I5RBACfbgT53/VCYzTmg2bgSlDHTk5nCxf8Ccery55kSRI14C/IQf6osy9FSWTtHIE/5Matdm/X0QK+Wyefasv3XkpvgygSKNwEuKj6o7JA7cXrjlziwjLigKhtVRtsOLFLmIp2Yy4C1jexvepfH3heGNL9BKzx42QU0ZxN/D7Xu6LCS+fg5gA31GP0eQfzALKqn9TBaAi4HzIZArjKta6RyqKxKMG0P31l/a7IoKpY7/i49ZXQJdJOsFLX9edNbOQbqCVLwopXpcspfC6IZTNdJ06taGanhWl/qkkQm+KxsHHajfCyCR3jqml1kepxal2mWpEpb+bUuXDmVjb1fsbx5kjtjNdm6isjYgASrxkTanP6dp6uGwMtRvPLPqaV4QsXmgm+j/ARBd9HHRUgKgpJMb8BrBv5hWaRhbBwzWmTVhWhkfsOnEFkp6vgSz9ZVYfsH7c7ONjB/nDMNdYSpBKn9miG6JJ/oldoCYI3IfR2mGawItfm7rr9i8yW/FdNdS9BuQQ3bVAnBGcKDp+POwGeDcQHjRKP6F7455OHYQwBi5Vm34WQmM1zRUTjMKlysutTsyVu/ID7llAF6LYDXDu9cqyb7J7BYo42FmlZbnjZAYaikUy8hh4GZjxIp1KdO9z/YNt8K2b73YAopCwlqSZvXFE5SAre4teOGpvvS4OdBjar4TMNzLmV/iTzJlNe8lsT/Lh2B8nCaOC2tMb09rMKaJ8wE3sZ0RtvGi6i/Ucp+2GQUZvdVQ5FjC7o8Ud+pwcWWzuG0M0pcWaxYXlAbtqgcIDQFtqQYpcCz5Tuks8dp6plXkA0nE3ES3Xin8h1HkjUIDaXXc7x7M9EYw1DcJF89q01cQUTcP6Sl8dOeSWG2C6N1POLUbv5K3oT53D+7NmlKF+luK+3EhxXERpUFkTPwFFkKuyKeAImmCBBv68laOvKkU0C+NtMBQdNReNMG6E8y73RNw2zAFzts3SgpJwTl35GlD3+HDN3DU08s7Zo3kGGm/b1JjPKbtelpVJAEbrTpTYwfYeVtpOZI7s1ypStxDnGfM2Icy8G8araepf350DA6j+dNWh8PU/7rrkAJnpA19Z/opyZ8gwyG+FtAAzKRCZNjGupX26UtaHErI4VCcQ3AtaoCNoCnLoWLust7gWHSCHrLjnNirDGKiwsR0nrdg3k+jkKcAJXP9Ar10F4AXjycVMY4GH29RKv+vMpeaVgK1Nk7KYIp6xYdQKlod53Uuvh08wBa/yk+otTopLjevUZwYjXP8nzWg=="
-		case 2069501919:
-			return "e1oX3xq6YTnm8pKBDACD/2opxq3AKg29Z/MHO9HmbYsBYXfM938xFJ/Od9IsGFIWaDGV23Ntqg6ns8ET72K57fduMwIcFBdpSkf+GKT7DxrrlOpXnE1F4rxJ5WIvIbUbgPO+kDDH0QUpF9P+DyioA7Fqg+Q2rJMCMHn85uusiyMakij93w3Yw6Iw9n51xsxCXA3smNqn4pd/q6z7iMICHnApp4E66eVCUmYV2T4g2RLi/cyjn3C6RCmm3v6dTdL/Lj+WDmvwV/HJ9IWTBBUHh9wdkxpT36aR+QAettaGh/5INeEzMYb3eGrluDiWSoZo+AR5F5A8U24//a9nC2drasvb6bfcl6sxk8G76lsZSF9rNY7SWkKztRo3qc1oCa2s9o7tFRjLcXlc+CELkp99/ovu8u8uNMJWDvE7SkxMJBJ9DmBpn0n9WqlPIGvcCTkcL/nN9mOgdPZa//pfBSmel/ufeyZR5mW9SuuFlraGjEmsZljMYUp2G2tVjjv+ZaUs4Ukul4qvjAF9CWWKJjXZwbH7LR/2vlHulyV6jewcoUmzSsmiou04r60/YL+9GkPqa8lu/AnOTNIS9uCNDNCAg58KxCUFpUINiZcT9tMfImUAUyGRY4Axwgpgey1PrEyLY+0NhENvLKSGF0jIoMS9nSX4aeAViTtQ5GexW4c2xzOJIYy3d8IKi9b0ZX22ZcFEknbplQGfW+GltEyDyLGntWD6Tu6C52xs+bBagtcoIPOJBLimI49AM7boq7GQt0mLT3egrHkkfaeq5ob7PdN58and9y/fDNZF7MX6kMm53XyvOST4hgc/qbintS4dgCKE7CVQR98I59HAFrzy88ubE50/eIH4eHQU3XbdzsxW+6qcSfM7fKC+c/d12EdgvZ2vYeT+80TgZ9DKIiMb3iVzRY1h5hEMtr3qHLYkAyhVO389a0mqdImH4r/YA/Qiuu4d6Mi1Kgd/gPj0sF6xa5ey3dUisPq+LJzhPbCMPP6Vddo9S2+RCNmtFZK/4DLRSV7RsODAYkRYZsyGmThsEk5ZUgLSpAgZ2OiBuniB1ZgH+Opm0/fg1u4xptcxJL0+D57Er1OYvFZRDpxJSHVTWEyg174HUlqWn8AEbytEKXt0yv5vnkDzS/5873b6pzjTw3fYFQmC8NutPGURCNT/6m3D6lKvcrKJPCfBx9nKauRGk5jMs++vgpycY0mbWoeoKcu5vPhc//+h1H/woFPe08N5gBE3t2/Vb+Zh+vbQv2MabS9S+rNcAjJxFBbp9ymNb7cHGg8GgSn9zRNZkjNKBYtY2y29oRg0T12WD/7B9JLaETwFVB0tkSB4DJT+gz8WhCDw8/ygvYtBQeNuTssMFrayDQ6ilPPpnoslza7SgwiPtdE4/bj9M5MOgRs5OdoFjHOr5uo25NQ+VDtB4lgGG9/oFWV86hj/lAapgvN4/mD+AID20iC/OrOufdyxAU29/AdnzkZP8y0Rce9IayRHOHBQ/Ox8rCyUZWrHYM/LBpw1onKRmwMsse0BTyBZl0nypYDGfWGxDbWTgQEA6AugnLETTRvA2o1d9Vxk1Rp+5JEhUw4BKGOXkbzJl4pKIbJ87B9cARXBUYtwF6KqliLzU+gE120OdrCejiNvVZD9YRL7bfHIrtPaJ4aGA42Wsl+lGT9Z7qgCz49foNCAcff1XOHdnf0SYSTWlfZ1a83iBe59UqnWR6oWlGaNRGG0hl24h58qvSYL/iANCCkHnQSTIE/aQnwzQYKJfvS6mJppHddPM7Si5z11gVIY65kTsyLeG65zNu3Q3apBW0UsaCzdCmswbp1RUZgR/CBJSahT73NoPTtwErrRNf/FNerrarm9LMbu3eceTguuQttkYCSAMzMDGsxbFyCpStRsX9eEtqfKKSrD+9mGt0y0MCj8vTPqQm86JgBv7/7lJtQnMUivWyuv19ZeI0cOlTnVS9qwCpCmthQJl3h9oXVXurxOwiXvGIJIvNTplLFwDJkAPOVSomiw6bcXo07iEH8rMqXUaxOi6A8YOec0lt5JbAZa5MfJS9LSYPxW+i29Za3PmtpdEiKggVSuySjPcOgF2OHOXEwnYzADIJ/r3YGaSJjESUOT4HLkhgqRb6iUJYJqPLSr649PjhZy0NLSgEdqbnzfwxGE3Q23/Oon4SGX+LCLinfSL080ck7YRnIneHsQA+MTNdO27+FOgRl+/yHFBMIazaTzSKX23GB+FPKatN5UvvyUXVF9R8ZKeefiANma6HvCJC4YLvfpt6hUrZVuNPzOUuCo2BxmMSQPT1vrmv1afuissrQPH4YttWSDTg7YfFRqgRKQZnjYPbaUtdAVccQWbyq8xc4C9Fr/uqQPOd2qDjXViSXBw+VHO4R0MihSF/vuQMgwBLT5eeitsvWxH2udLtiDHLuKRCAllMVqt2q++fB+PryXo17/J9AWSF+TFQOI5MkUOUOuFv8OYEzU6+lXDmEsCTZdTotRh69O7DiC8gGsCl5ES3/JdQxwingoZy33a/fswnG/vZ1i+x9ldqQpF3HqaLGNzjKGGoY/bPh9cD1U5JvIo/Po60udDvCxjFCs43f8oLJ9Vx+v41Vcaj0nn3NI+mG3J57+PJ5Hf/PdgXAY7X1Y5kM5p16aQruMJGCyx6QwwQND/h8dCFZ7ifULKq1rPgQfsRZwAXXCKy70vKXsvvmq46v2lnWKEZR7VVUrevKDBKiaUzdaZFwbrUyb3IXQqaPmCEuKEPya+XKLnvz4wiJPsI71qQyaaLYxsTpkPxGhtseg3lmmOVYEqrmF1++dhxpS9VjNcwwTokzpa8iXqnCqOZynorwpTkL6jEdD7vSwvYVyvSUA5zJ6hkxqZGmM3YbGk0j1xJJgaMe4OsIfmwjw8FTw03WLHTGJ2vgmS0Q/iQhSrKYppPC2y2cQxVqiKg12PxL1l9nR+cE5HFtp+3LBeyLiPrccGOBiZqDSgdfJoD7/yBw+4RKgKMDjuDVFaxbP/1Y72TuWxQUvVnRwDCYutql7gYA2Zv1tEe1BOeaF2/C43T8oEeEUD1vpqYaXqwhHTl/hZvrEuaqr8HTfr2Lrvt1bidy+bX1efnHy6DHespGGaMncFxAufkQn4m0kwEAFaywt32ACgmiVxQb1QUVSmBjq/AEDvI0cuKflFA7yBcp8e8sa8EpliCy9cOIZOvLTmC8NYWXs6+OIwpczBAbeFCi855b8dJNZuj+ApiVvaNR2Eyo9KMmlSGJp75NzU+5jAHLUTjhBf6N7rRr7QzzgpvmrVxpC89EZxX3oK+b/kW705LFOctmn2jx3nlI2XdbcpInZOcpMPeN0OioK8o8dtWy1y8BiOylXiz07vDBTaYKOfj/DajO78hIFBJClSOGxWssRlFlf7kUs8tYIHnaOX868Y8M23wySyuYljMnpcU++iHYVMp/Q6xBflKQOm3YvvFXLfhpOIt3shps227M/SeHMq/xh+Cs3b9Q0OzGfNRNwtaDVS5oV2fE8S3rHR4ImERdSiFiE1UNe6pUtYgbc0Us18mQbC1gAc3sT+C99Dd2BCqG364WT/Q08Tbzqqeq95Lu3A9MdSqC9X3FHYN7aQvSD1h0fF/li4XhwAFMZ7lWUPQjeZjb7gOphYBrzzL5s3OUNAYInRKDR6/Rjd//8BSIXsS96e8oYhWn5lo3ulxHM24hC+sBm/9GJB8tcxS0T7eLpBZXLUzGHJcFdpjOr6KGzUxSlNLrea1IV1oY7M4HsK14pAXLG3M3Rp69uH3C523RKseU56Wa1iAl4JtkvHQocFIT3M0IGiZ7jYRqqWCJ3nka1YzQ27tiXA4GO0aeJYpxwzFTLFDR9YZFz8Tcra1OMj7q1j2vAXsJTlm2YS+v87yspT+EqmSHR6NC598vSLqNA2wAPHA0U/bYdeuyQErq/hLNSh0ISDmMOhKqbsBOSgjfNEjnZ+h8hNXrM3xfWg0RtlkeW5RTmiN4x7meA8uHbTjhHoivXTN10hHGvv1ucovyIb9bGSGo4zoA5ksDeeAXjElBTahE7Lc5DB4XRHD9B41icgKkHHTG9sTdnGred6ctt0lJ/r34HlynPLXKF8ECjLGPXrAgDaWK3UrQ8vvUwdaLVcPmc2wn8y+Z4HMUw+cQu/gD07SdcYYRWLAoIPMDZk6jeFJRtYJ4enxiVqkXlL6RaFmHKDVvyGFk6olkiN5JOMklk+wNl3BbGV6hqskocz3EUZUrJyTCBKPMWJ4RvVDaAKFUykq/aTsoobRrmGhEQxr4SMYo7l3FWZGe4pMxu8u1Jj6dE3I6k7ixxxX1qLHnJAyEr9HbGbAeEM4C+vUXM2UiPkT4zEUiTUuGaBMyxB9wRqoFXw+rC26RATYlcD3d/GA0YaAYQmvLb6lui0ibHKDJgH0Xh/Pb7+ScMACPQbkzLTrrLJlc2X5znvYcv5CT6ZObQHWyxZ8uIQ/uzUU6NIL02a+xe6QHQ8qE9zmOUM5y8huyw+AIxnxI4h5OSN3+i79yQKBncQUoKFFhZ3+ChS9WTiTGdd8UEg9HHRgyhPNQc5Uaxuxta6WJGaUAFiZj7LCT+hZgbiqUo7dORV1RWaLMETP8hLhmY5qagmKjUG4hcKZWntQjpQWKgShfjiVLNebxl34qT9WUMbJX5aB5Jq0z1MfsRzkRWaT5rE9EgrxIMo8HCrh9Tcyf3NQlNLmvz9ZRYLAHVpuABocay+1tILV0WiZ+RJmfAE/ECOvsk053W8lnc0ts84C5z2o1wj9rr6EqF8Lw8+wHPRyktXHO9a66VJb4KYSg9zezLggIeseJxqlkaugv5KNt7RGvFJosH98NB0FaPMeUDF237y9XHfE03uwYGcKkAS7HudlgxC1acCDHu0p883HCmE1ljqGB1+pcwp3f4f7dloS4DDVJxt+IuUMB3VMxE/yLVcbvAkF4zmZo2hax+h7srEgxStJq6UkV/sEhTwW2kSem+Rxid6qujLtBMnV4xvXHWcxYu/YoVDqENnQcjBtW1Vm6O9YRhyxpt9pbjPdiULT2ML73rGsLFmdpMYePDDq/JXVEdYOEsmaGWSgKWmm3wJTOTSxP/k3UvaPwFoCZNjR8qd2Bw/e81/yih7ddLXKo94SCFohjvJpfWYCYpEMiaS1TI0XmtgvttImanyiaTyUA+wJHA7BP0luUCwPn2fSsesBVcib75+H+SuJZHp1uXBaT03Kygscd5/Ytx8mhsXFN7y95bPH9euVoebP7HrrI0I6ieQVy/Vap8djSijkv0C+c4boByBRX9HRcdnW3A8IMtTUCX+UxL+SDu6EMcn2nUmCt5I25mczsrRWy6i3k3q7+YzqMRT9TCOR9TI3ICgylnDFJSHnUC3VMruGNNNgdL9BbJ8758kvO5hrwjRGGl2YvLrvWvxoIGL0c2BCMYBVovRwjOkpEGZP+igpYZGWfJSyMjLMUunoAxlauf3CzM2Sl+P+NfD/Ekt1R64EOp8w=="
-		case 2755164105:
-			return "pDh3ybkMcMz4DPAfdPSt8YWITQy1rRr/SbTnx5nPIFtw7KJVqK5KFU+V/FaZFlUnoRvlQSbz3S4qwkTJCeYbYenrNrADjCVzWNRDlBR2iX6Q+kmYsNVw5Q+rW1uyCe6PmY3jShBeFxW/hFJkfa7vYZaLfgkWEU9l+NqK75SEM4QSt+6BF3BhmcD8BE4BQXkZmHunXPwoKWLeRqpHRTJ2Nud0xYuATIKZybBu7Fp184rttbL0ITzT6DKtMKD5ewXBOcLiDfEU+bxFrs3urxek1RiZ9X1/MB04DW3rID0FVXyIbSauMHIutYZ5tXZpajGZ1RHAaqFGqgt4u89in3Jme/mvaPEzLdYIO7SYXH4vKALcGTGAfTP/bvgM0dl4fz9BTtHcfDXgKlSPbMWg/s/eoJ4hAeExuFtcMs9qcwTcbcFZ4Sh64dAjTjVEQsPy4jfLTwdAN7GB2VatZnSB5Wc2QHNjP5l3HpkmRIvGHYEtebCBYTQAa242/1a6iudu28drMrsSIEicXxn9TIKKY1Khizptc94eyygioAUYI1lS1/AAzBvDPgQRRnZrBmDHCWRQBov9+TpqPraOt55vM04l3iTxRhv6z6mVlQ/q/K2XMy8lOPvoE0D8vrrjmpcb1uqPT5vB8oKUlCqXgA2DOIbWrB+QmbYPLJOtIM2YL7nQa5dunMUlOM17rKl3s53wVgkrTmnsCXy9VhfFs5a5Jh4775TsYXgIGM6JtOe4I3Ag9ue48CZHYWc7tzzk8jRYId5HPZY1d0sRpK1efv9PbRhbj7+etM2kcFA75Q7VpK2B3shl9ixNyKg00OiNo6l+MUMbcSeMEOqajMjnu3FGKAlMQMPon49jg+hNHDeew/sj1nS1GQzX8Y8OVDAnazEsF+TOdP/dZQWDppJIfgy9Dr3W+G7CEYsKzCU1Tsxops4oK7Hy2Qo5DzA62zcSiOZl9NxISbVcBdgmGGWYr1k8A0wSZA2LHx4WLl4UKGmxse8bp39jbY4gE7eYm2mMRVxhB194akHrK7+Rdy4C2qsFW0R6auZ88u2UCUUhmU/W8LwpR7EKSAGC9DB//Xs9HI/GaK2HqrDuvh7Q9BDubtEfZPMiWGl1Vrqc4msQHAicdeJXh/8MEGhaZT5FTnSSP7GYZx5zvk8X1VnDB3PJqQ/xzBl8tCMYpK+rn5d4jDQyCBizzMKcuawB60Bd16doR5uxUX25yIPHvIol/7alVQBNfsgkRL1sXXKIXm9guaQXBCRowt74zy3tgn9rfce5wHCWYCue8uoHvr49kyQAbhtyPXaOxY8ei+sEie+wsFlIWn17UYhVoojcu/9U5RyNSpFAxasqCfU6danIDb6HCnrzveHrh6/oJ8EtNaGZwBwQwgwyd3go4fgL4AsMVkT49HNKvSExnYEqKhCv9PE7nOdvzgDMZtBrikYgDjFWi27g+nwoccgcZDNzyEXpj4Lcp44+BZGTtACswDKip8fEfcdRTi6jdIadOlwGVZVenVo04Ihppw77WP5IESeB3JKUyxth95uechFUrOYjFC3AbWeVvNmqUb6nsRHJ7ykayfCPIisbu440jtVc8Xvs83SvW5GOq8U3ZLanlStwmb2MB5LZK8xIxYq+I0k36CK8eALJmgsIyKWj6RfVac9d0LbUxXn73m4BNlqq6kOvw2iCqOgb+Vt/OGKRT/C/VyF6jPKY0vAq74uhhXUr741AlEIhIuFGtH1HqoeM1enD0WgqzkJvHHOMza8sYXUWm1XawbkSD2kByrjQ2XA//TNeOIgZxpuwzCIHzDHzEsjnmbku9PcqYphNv4D05j0abHJ21V7PnC1/YpzXVGCVpbBMibTOfafK/jPT0oNkHEC/cFL5vTs90RVNvHW0LwUHgTaC1M1FG0EE90G8P/63i+5S2tkd5shfVC8Pjn8orqpIJBbCbm0ttt8nSwkOEujB8uSXGqFxS3mejjZEz+ksGqlo8vJRdE3smyn9858uk6/poh4I9dSIFy+d/9OFBiQ0XzA9lCRAGDjWsxBCESa1XV3Oohe3xqPJzhWln28h4cpM/bLhktcI3bevMUnPTwMDqgXWd6tbk5VUgT3VbNfIBioCeQ7MZPkS5BVTODwZQlysbgLTPRrT4zt+hVn8waN+z8CHK3h78yEOpSyIVrzkrNfWL3oRaZYTL1Cv2MARbNQyiOKqo0xVEZUpPLf8bSM7ASRS8jaK8cm8bH82Gwej/WHD82QyMvc8gpRh9YNFLplFPNrHF29QBPN8jDu0QA/Zf2sHznQWlww/tbxRTKmDNElilj9yWOORlfpQkzMKf7geMTvzYdJme2s9ycrZFihUFiTERm2hIVS+M8zt1hp4eulBRAbzGpwZoF3EUwsJjir2qVhXNgoDZD7GcsPAeliGFRLxmfcf3xWgZI2kdLjjCczaeJNq5O3ij9khexsUfAzpZY0J2zQUfLK8QDZ8uOJkZYlHmZyhUsCB8tvJok7YX5o1KpSKSNAlpxsMTgXu8HceK8AP1/1fYPzU0hpnqbKPR/L8jP0sys84itOWLYpwILB1lL4c60DCTreCjOOgg9zKYVwgzJu9L0O0JHEN5TZPuc8eV2+smB7xHCEi/QlMHv4QxxBMBtPsPBe5sumEWflfbdBrpANJGuLAFAnv1IPYQTCF0Nc6ecZG9kFsnDG9kjLHY77awGCeslPXzwiuMG8KejPfGBBR50mJiwjcX8zXjgxvsQxqH9Tf7GtxJSxUb/jVwM7KX6taWvwtxqVs9KY6ZOGLUlXHdr8o7Eb+tSjho7RHCTaiXwIRzSffyU/pCnNSIO//936aV1R5SXebRrSWosSQGyJPHVtYwMqwVNXZ+TBxRFp5Rsg+043QakwfXVpLRqZ1Keg+8AQAEEYTRBaUbdRyeo2FGLHUztKPSntAw33OAVPP193CIZ/ev0ssPq/XbBQQHeUrzTEtvC8cDjACrmdAgUiIDefS93+Lp1qSemMv7nCorqKdeUUFJje/5HWPmQ2KyZ0eMAD8QApUIAk+I4GJO600sw8nwGd8ZlBiHEY9jnJurZ4PPQX9VW/DsJzDOGwYS6NqJy8FDTSLdnqwiYPtX4VaEpcaFQc84q+YmitY6GEFFvr5UJVbY+rbVC/DNmSrB8n/afmeBgEL4GrxUEsT/aU48hN7KxcfurA+ByPftpUQuRl/LoB60bDB6Gv39YwE0ohr3C6XS6RWqZLmWISZlqaG3pVPQNWh64bCBxKXhwIWz4hEnJRWlGRbhNJyE1D/47Uan2CjoOMqdX+LERrtzZSCcHZcEcqKEU4i05NnNqRx3TZhdEzkkBxX5Z458aXTvA8OQ5SB5dTTN+5oUVJfKqhEHuvO1cOc4lT10opwCVZkS5DJswn4nC/wFOpMrt7duEQBlh1l0scK0b9VH7IAhFH9VtZLBAtN2Y+DdO+/mPx6z5Jrn2mJq5+YzPxlV0tyFmaVcVjFsPq0tRsE/H7WZzLp527/u4o2LzoSE4boFFkH/IBXWFFnln6TFCC6tYnWbp34gAm0uB1+QtmhSuFOsTtaczSENsOqKRNojJB5aQmffwl+TXwGLUWRHvgSOfgCLcasZI6/oE67bNJQPOpYt+QZLBvpl91N60ILfobL50VNMf2Nnq5RYcVsil2IKUscM6lvdq5YJQPAtS1+YuhyBj7rELz0m+iUhGnJywByLquNOhfl30AbgMd8btFBBG6fYAIY/N6sd0oRU9fwPduYncl/icHVhHGKSSpmVxbBeFMm0dnMzGSNKglE1L26Aw7ZqNSlRzhegkxr0VWbItXqvroyZkqpKTTqswDyz8dOm+UaVPQ3IM/MmAjXkEVcLX5WnZQPpS8T8UZ5+e6yp0EkqkGcAzdRnoSqa/RqtcuGS1kwcpPOykEHbn0gbGZzxpvtKEu8UILAdd6UD8CmQUKiUYlkwbbs6vukyS+3g3kg42Yh5qiD3YISoiU8qQnPmg+lESyPr/HMrfiiTF5JHT+Rm/cv2yBDbLv6wCqJnmVHubuTdM1/Ab1rbAxxTE0ZZYi8Hycx/phMMXLsieD17fM/4K7SoX4Hx5E8GcaCQrh9QQiGhZZeknm+Kc0HXfNQlaji1t5rxu5jYWHdS5ZFwVLP8PlPaI9OQW8nlXY6ibpo1FlYIOrciEOUtuZ2XkjwabI6dp4FWBd4cqIkZX1MFvmYqj63OuNpjAlMAyn7a6SWlfNsLfi6cdSgQQwHofFgdfUwHvWgjV/hWP80Vr0yx/m623fwLoMvEF7IFN2aL2NDL4mcKAdnilmQ/5u31p/rgIGZsUSgDlsIETz9WmYlzNFmEf1xmq7GdPI6kBEgK9BJJTxNMRdVpox++W6ycEyEaGPfQHWxOuC6fc3Lhig4jjtvlzIB3xQyB04eh+CTorlX56VkHbvc0nc8WSSJ2gx8fdMKNUpfZfOdajAtZMOAU87KWzxH16fdV+rXCIYcJJX/5Z0OOAEDybZoiEEAjWAESeZNccPYcU+hyMcM3M+AphDbDroNu7qDNo+2+UgrgZSAr9XrIAYigdks4F4YgGmxeINem2E6YZpXVlFmSl7Jy+GCI4qJWfRH2cPyZ6ohax4ZdS4OuB7X70JfIhJLQ3ltR0InCfPV5Y8JO+96cH9669qzsXWDor8rNR0QhSc9bJDg55zGKP8Bg6s5PhJ06aWJaa59fl38BjHjsXzrK0e1xF0kKetnmEPM3eACbNvYrUql8+JPIOujP1Dhbj7mpQY3ag+slN8eMJW6qXYJNybq5KkuNPHfr6/cW0gS9EC1+14VIj1Qg1Na+z/N7Rx6FgnkPIIRO6fGXnHf+nEyyPTrY4qGCY7hGz/dIRPPyUoOdKsaOyu4mzjRcH8z+Nym6KZIc4Uj/j888yID8bqQ6Xo1BocxxvmacKx1UeIlLGvEkWndp37mj1HR+OVT/hLA7dS1AK1zw4UaJVChU6bbOY3VmCSLg2dt0PY6llbuEzs9QyDBBNmMoTvaWqc3OzIS4m11gWiQ15pqhM1zzsk3yn8ZQB27pEK4QoaJYT66DhP+jh8YMBNj2zQsrcrc1xgFJjGb2YLhI7YMifrhheUd8mKKvrRdUec0gf1Qxp+V659cU8RsVUMcJQOv0AGc7EJY9JZJZmRWtzqQzzhszzHRZ9KJNKfFWE4D3WQMaqDZiKTH6iG9eevz1V70+CWQOY59iqcLO9iZiEWBxiRYz4kpthER4+nV6LJuMeITqYJkCnzaRMhrV18Q9cvgoNaTr1ESrj87ANGcQGJuD/zepPJF36NUN/QGZtpCwveDLZ3epAZ/BIMCV+bJD6dk+BGtXtvGq8jHXOQjIAOHO3p6OxyH3RZP6zqSx3+hu359B2U2w4th+xzDBpXIIvcDJwTT4eypHrHvoDT4tZsB5IGwnRoCwT9Ze3zj1k30T+I+qSQX0y+MOKhn1g0CbnOxd+jDB2V7rB1dM+p81fe6B4lGjhJGKmgXOzdvE0LW/poqIA=="
-		case 1207755620:
-			return "R/zjZOlZlSUXwZ9dKrrsxLRf36cwHSYMkF6a4XkV5A5hhwIjlmDa92tjU+x2L8qw0Yi2PatZPt4pn7M3eRo7MLA6UFdXPJ17RuoKJ7dPHib7T1Bj0iPFJrJTL8qnZ9t7E8hM6B1KXV0Tl3gAxtjIiiR2ZUf1jgQL71DhO064sMVXYsITEXPdsNn52yfhHFAKG0HFRJPHJiICAh3nBoHyRHZzYEBOLkTQ/jEncXLMwhtpM+qRiiQ0vZhn7SEIHpk2b7yD33t9DL+cds8LBj89hJJScp6nlG5RmCvdTOT38PIItTHnbBm/RYAN0RG4RYaMpQTN/jkkgN/hfJS8VpY38yeJrGNN9sTcAsB//uwCZBq8L/JLq9K3IG5w8NXMmBofgVLpIPgxzz9biwrAZhtDk63qP9rsGqg4DE1FEtHELq0cGwsblJhvRRPYbpvi+goIODfqtrmpW4j0E9M/b+O/tu5RGV2SOsq09IJHth9vjb88gHGKcFgY+uieV/AQeGUW+4l8yFLqecrQd2nBAK2yuytwCeAMnvFy7/h9tWW+FFfjagEm9Oo4XhUa+1zvuOOSQSHwkKyYpErzr8M7i6wjhJAjxUJTgLzE3GPOHKFksJeV/j2Ww7YwKNTr3EqZN7uh7eHOD1oUGY6TY704TKWwgNO8ABlvedY8w6GVT6KbpwJ+u/Yw+/hrFJze631/L40NeCfwgESkWZBPENqofBw6hSazGt2V5J9cTqctJ+E+jh+rKnS5m4Q9sxtwNyrZIF1INHZq5KqYwExeNmeh2r2d0e/hHJrxfg9/GuvOCZ0+AJcMIpO87VbPaYhkTKez5nBtfoyY78ghOXNI0epRL/S50LQPWnIeYX5TW2hMklgNorPghg+XhhQrrPmr/hGRtLzElsRqDFN0ToD9CIn5W1CDmxkvuj8IQeRdEZuHR1U9r6KbJ9+pBD7nv58Tknve+h7cFpqc0U3R7sxKDOuQJU2g6B/PQ2gFqbRQ0pOONn6S8TWLWblnDKo+JhboBpM5ioPcXdL0fhKq36dXkEJGVGRvF/uAwLC7KXfRMZVUdaJJQexDC/pCJZ71HjNCh7+FpfIiMdLz8916ksfKiQcqHOTdRIjKPNWEyHOqfWKSWuUWP+sOdI9ZCaDfFhMsaevqYsKECcdLaE0cOBSGPMpJh1COgNtBZv9bgN7JkD10Y7qCtj547t2DZETQ4HC9rGqGHh6pB8O6wz2g2jG+5cyQ1Fpm+FHhcS+aD6V/ButWm7AjOSFyRToj0O4lbxsxpEvUMz1se+PMdKVg2ELR5zttud63H0RI87HaUIyKeNqm44kPNKji26hQwvzR75O7nQcJUjGlVtcThacOvDH6xN0G6xrL+0XCag8mscCSqCqiJW29hASsrxKy+5GgL0kJSy0Sxf0Ez2Q0yT7a1wbYTD7iFfOSYdOL1WZVrSHNJhGfznHTPMTtmdZOXDqDs54ElRYR7kAPrX8KjonnOAHVdWVYLQqyRkVzq9UNcJ4T8sWebAPTi1cemq17xxdYsZWtjn/7b+qZmWvzM7aBgIiBmVWgSh+UJzstTP9PHSDzbILnTcatwQORYFMxqrLfsWPhCZ19xRQ7hf0ULFxTbsuI4IwK1EHbCT2Nk98J0fV/Mc3RnlsXYKcDqM57K7qiNDTOKs3ahTYZcn+0QzMwA4gBuf8Y7whw1RdWpzqIIIVQmJPegd29xzKI4d0Kf3F+6oeRNIpHyfkY+gDUylJFiNfxEBPpVkAN10u6JNIHyBfXO2daYEBdYCdnBzzuwVniIfTSc8Y8+25+cdupGZpRtbF04EUWqJVMkefqN1IE2fBa3TOtMhkRUseYtiH9MMI0he0D38DjOzdoVmXVb5FTUQWjU5l4obU+6RpozdEAPXATedXlDU//2pMUn7kbtkCmlMCdKkvWkxyVDThsHCz1VHn36FPUTwI3JyrAArlPp368EwinVGfzjPELXTVdyugOXnuAXRCiN5UypAjgtGXEFtokHcDHwQ0gxYJxAvkd9aVy8SfL+MmESrPkS6JpNDWCHf1X5bhNWNk8UKxelpuRgZar736Q+IpehAAEwpbWKY+YNPIU4NNDT7NO9Y/XIjQGVmcAEXyEcVCAQzJbIBQLicNV/Ewacmy5gWq6xG1xKYXBDw6OEkPSKo1CPWmLFoU+ZNkQ/zSvHOdaW8kJV6a8AR5Po4w7csHewJsCAeigUNZDZJD6Rut/59JK6SFeRXkaKW/t7YX4qXuY8EoOEC1ejhOXppVG93OFGOGIB3XhSIjtcmMVNlBE0K5IBsv3f3GhanvCvm8JuyuKgIIXspQjdJZEjCf2K56N9tZKAeVMBwIGbxagjlkAQ/9SXFKmU+yCqL+994xTFPs09bl/Dj+dqGJX3lTqQZ0dGUwPV62NWYTUTJne0Ywmu02zDpE107OsjNaqCRFbw7FTnEZj759m6fjzhG5+kJ9ech2gmhnEmsoyrLXKzYi5LEbckPUc74qPt1G5i91ZUEVf3yKqOZ6gPHxLVnZqu5xxgdJqRAsnXaf3E0h7MqcVcG+zuM7+I095q98KWMOMPOFZN8pnenghNKP+Oc3kq0pW0BlyN7KzLn+6ixzpNSxRhalPMH7jr9JGZUoKwpz+sXO+GKNXNuiRSEDQRfFn94aUesuXcyhlc8vje0jEWKRIEyXLXkGUJvnyCSv0xynxkflJkWtdzSrktnb/VBcuHTJQpFnE6BM9s6f6uUZGDGqNRKSMw0Qq6QFvKt05kUSLNwZpYBypU7l2K+J5/JlQjJ64hy7KO5Y1oMtrtboBJk0fS4moZsL/9lMKbTvEgqzVpcRc5pkflAMwypt4Yd7rA2HxZCkfsPWSTwse7bZdaFm1hMjEahiCCM3bKWKpSqpSZU6Fa31cH9FijBN4LBN06RdumDofBgamgqiErUTqSBlU5LFm5UJgG4WwlyYe6QMjD0oIFaUXCpIByqnLlYiAPCwFi2o+wXHLCCkDp3nVihOworVmGq17VT1p84bb8mGZ8bfztVaUG6Bp8Bhph6gg5aTgDFQXQQ/5XN2Pxv3HLvba/ZPWnqM5XGPYdPUsG4X1hsMgjQJliZNAMosmj0Ndq8oeKeKiPoecFUx6Lq9fOoYjCJ0nA+QGEhZVlbusCAw1U00HFs8NgtcyE09BI61/S8a2rGH60brh9yDWmip3kyCAQ0R32xa3wGD6x1ZV2rm4doYitbZUBDEVg84/nFVlD2xF942AI/b8G0RA35sseTcESrey1e3aNtEhndQQyRHlvvcHLbOLL83nn0zlhM9V4jCfZYIJDnVKAu0Nq8RUAZ4+L3GlU91EjEQ3YpSyuvHIotlvBWtPNOZ0e4+iTZVqbqrRBT2tXLzeLrlQ+7RuFLQviHZM9E8WM0N8cPgJIhLKIHyomy/8AFUEme/qObPRQJ2mT12i0kEPDDnhAX2zUe5y2Z/UM7EfZGErd+Sfv8srkZx1WgKpKQQ7xtpVsA2+jmOE2tN8C7jzykiAX/3FSbLS2v/OegfyMxLyD5o0cSQ6aNkh2W/fpO9Y2nlzbMgftYT/UvsM8Jb2/kOQxO7eCXYVSgF3dQJa5RR0TJEbdb3HHD63Fr8OWtTWUpnp+lOzlVeVFdKAuBAox90qhjtkyRyrn3aryjZmnK7EiQ+d5qtlVhfwUE+16+WZq95CA8QN1NtwE3Up5w4inrR/6fkxdVnRicoIFP8j9lmgcMTinwTgJmTp0Pp8WUYVEWGVMHWPcy22ifX44U5QbdWjdnQspRcHgSzfuzBxf81lfzO9fyDq2bHaJmTeQaT/2zduki/ktWp6h8aexbiPobGP/+Aups5pVscncXL8h5dZOAgYGIN8IZCiW03Wxf3yQcmkPH9aCsx+WtqLGWyzwCz7R0JIlqjd4R8FTkiHVhgVQ/NVOgC0L6+AQNkCVaal7j0YDo2sO8Jhw3E7iJltwaeWDbFXu/fwOgLtKa3QYWKpXLGoxqDhvjq8qWsGRbDv8+I/jJHstrGI6qvm6uDJ24lQMzMMgyLh8JajdxkfHXESha0BqaE0p6LM7g3bmgPEUbuexFxTZWS1N/cPNZVTfWUA4vQs6vT35AdX6DbNIz2zcRSxlztISMdPiOl3MqvdBjHPJ5yFiAAXMr2Nh0uagZzBNeXsnCpzbnXh6+6qVVEn5ZVCUVzbe2SA1s/SE/5k5XpxiMuMQjIRxaneFxfykmeddjxX2VNr4D5jsDbbtVGQcEZ36CczT275gYWUa13BHjyvXGBnyaoWV3G3qntUz7rd2AJznms48LNFaIu5+KPcMQyHJKH9554WFjbkNhn+vShgb8TSlnlWpSPQcluauyFB4MJxZJqDY4Nevv4LP4yI1YPxu0Kj3Qffaz0nEYPCyIbIUl7IGeBO7gsa2HcAaiUa4LiJzkgf1nj12hNtrGRfRLJT3dJpLWEsqhQBVWQadWjfgSmHO/z/l533jgL7559WlUfLwDSTdg43BCioowtUJ1kKFbxjKUiUBgRmJpXtRpKVjzmoTmdw6t4WERP6IC19vCn7X6v8XPC9zIqPgywOOqSDDGTou1ZwiXJFim8Jgz7wt4sXQPRj2+D/N8m81B8gD97OdfOa3DM4cFM5tO7usI2ehiB4YLuGzJUBbnLgmAQwgGCJQxrdqibErkkcLd77xPNi1B6Vmum0e1vHdQmcU/f5+FsEbkR2R0L5pjdQQPaD1l10ONSzwbpmbNnMPm2Zedeqi8rRxywkJOWtQXpnZupbwkaan5bjqVvNyD3clu+VARw3Vy97n6RcFeVY7lzTD87D7t+7tUcgoRmlulIYOR6eWwnbncWAJlGG6BwqgnfomCozzb0ivGDFS8Q8Oi0c9f1+4bcKeC7CbhxlJisO5S3aPF7eNX5GasUhodYjIraM4kGnhbZsRZmWRMmj5bjjuFwfCZV96AgAdFY+LHCH4w9/stHL0tiq2P9WexZVi1fHteSCebLh73ejhYsOsew/yxCLdVmgst8f+eE/9QtKIlKzwZRGnXN7lbSPXWmZMCcP9yr+EH4r9DmhYyAS3sM4fuyl2wdz0cBlE9NcUOzJe6RiRL0BoJyPGZSOeW6XYWgNG7jQ5mO6urke9nVrruDAVS6gKxLWiYrMbq94LJbI7BwFD6ArYcD1n+YkWudSvqCWFp2qrOGxgUEKa4SONcTm7ZmZDLM7NR+okjQfi1Pu8ysIAdz1Jhn4U+bqWNWnYS01ZWd4yFPNNplcB5irVPcZLoMD2TA1RgRbz43kAxnECUoe2kLKyco5R507ilPTnEI70cm0E/CkFf7rXbjLt354HkpPYVe2aq4lfU+VUbNFk0SCuLEafUNAjcc8qi3eU4Qn6lGiGVd4WqFdMpBWekCOaCfHsCwJvCpHQxLYsR9mt/SplNU5jYA+xQJD5lpzTetXVNj0cJ03uYDqFeFRyUM3Jcr/eG5q7yo/XbOZyYZPdcHKgFPme58LgxXbyi7toDr57v7daWsR0A=="
-		case 1185629493:
-			return "RqtFNQ/IypfQCISRotBTihsOXKECTJ/olSD6pQd8pAO5IgeEjdHiEJ5GZTjcgthgy5IgnW0PYieyHdgvXQBr15tCZdTCu2P7PsOSXwCF6VbExpsxo89Qd7RdSYTRh19c2SSwHbm0DDDfcP1XrsUil+VRMDNCeZIGdcipKPMPu5cSr2NsSTeXqPC2eTsRAJlOmLg23ZuqDKlKhHuyj8qH7kdzcgkRgyAoQ/4s5ZWOh8HEMMsPn2xrJbjdyRcrJJT5WvMRoi0QsPWVhZviwnsLI7MY4VYaGEYwlB5GBQtlR5fVXcmFPtc7tjxdDGK2cEeWwTIbtRS6AJQALGGc3GItfHK40oniSyOLLny7GN6tOmM4fMmI11To6iOdi5KjNtuxr+39/nyeNbnnPHwyfJuFeViwmaj+92NJvdgbgB3X4X6j935AWxtZJpm+55tcnVq0tSlLSWvrOgoRcRq8pdpdIGN+8h7HLT7jCbVkWTDY7ggllSzkhpEK7uKfSg/+Ws21Jhe5y4v+YTJrzHPtu9kT1D+Kskf612dW0+dwGOgPDUmoxlgcY4qpRVJFlbjQxUPvDhinfn/Qg3eFEKqG7NE65xwrb9S/C+1UBrVrSXzpoBKHLtWHzp55+NU6L+gssCJIGcAxaXpLtGCUxHKabzJUfoJpz2g+Lg8+g9mBvSPeOPHg5f+h4/B5ILzXqCvlQBWpAGDmTuAv9qCJyyip13aN+z6x1Zb0vhjJpzl8KlvA01el25Nd2DYK6ZTyCdXm6MABwqHQqSFsVJ264EzOJVnWM1ZpbtnYAnW2o/HCHRj5jwoJiZCP/8gAeQmNCUqKU0pYLFIrJHqXP+zu3YZYotzYeUEsyCG8PNQ6FWCLLRYgsPp6JqXws0bqIkIgZdywZv2Yan2MZvJaPmfCTGyETEmgcrYrfrje22Gv5DVe2zvDVS1PlYUSoo8P9hc4khmEPbjoHZ0ofOjbYJE9RkcLtafSCmtkOhpBSSvwuxlbXgg/DoNtG37kQqMcZ0Id70xIfjwZCTy1LXFE56pGMeJmPtzi6AA1cyR3LjUi4ZIMqdxfQ+8A5wzqbJmeiWzTrwCLUICyr279z/kLOFZMqnbo6B87vpvoBUO1BOhWnoKSY/fApfHJ/QvVJMPC9hA2/g3r3FoHEnPJ9l/Uy/BYNlKWXp1n4cDd85o2p+oxWc54KjVEIxJd9Y/OWnRecfVj8vewH3FyZqSm2NURr/3hAkPVeAwXozX4HAvYhjeEcVUuSYsm/JVPP4oMD5KT3JtC/ZI/XKc6xkWi6jbsYMQG8scXCYC4zzgZlcU0UoA94WJR3ZEoO1593+chzA3dO33YCk35FVZA9bfkKqf2iEPU6pmlLlSFnL7zHlxYwwwRv7QAs6d8sMnKXGqhz5I8xELW4axf/nQwcgLtHEfHzzR4ZlX0lAcLeKLdSHH/zVOjxd2NgNPVF72rkROtZq8ltDqpAAdA2jix8yi65PfG7nv9Vl9w7/WZcat676ZKR3hkkloO4l0yjJxOGOVJW7o4WcAVoHyHi6X9P958HN9LLyWfON8qn9wL8DoNkjl9jgLY32lTpVVQblrzBAyQysFyUg9cbuKUKaSVtdc8yMq1wRjYf9kTuZMtuni2WflsURT9bIWY/OyWuTlKAlXh8TTiNKd+TPI5RId89zKoL62tW5/q28OLQ2p7NwkvfIUKw/Kf1CPFYJfaTXIc2z6ZQqJRhIpnoGbNuY1lDslvW2zZCWi2Is63WGOMz0FfS8YI17ojjKy48axzuJxYnhXZAfZGBSTJ8w/godKnzOwKxnAuylRToeAL3zrbZGqVf9f6Pbk/gPl6561HyQPCpUI9qZj31Xa7BWzRjpgW4y0C65BnmZ0Fy/K2IC3AK11Ab1O2B8HYpRE0MOIDu1q0uMo2mzZ8tPS1eCwVHMl0q6jCUxbUewYPq7CPY/btE6hv5YXkpg8O77pHab0xqlc1svZx0fUOgrMGZO9FZt0Eq+25Xo4WAEf6Rwcf9TXJM+9d47D7GlAUaiHPXf2/jEaZ5AuZpje8ADcO3gq9xfRxukBEF/i617l92mq8LUt5so7DiBa8k8BoHtBe/aZ7NxM2tWQg131tVwmUizyd5uZLbZPkXy1j8uWvG4IG6Vxq5A3+dMaH4Y/1i8fPwx9Bv5kSXLvZjDbQ+dQmvgnpiUFrdyBORXtIfnd4Y7oItPCKO1WBnDvvajvq5eOTniO/K+w0YugF0sjN74P15GQqtAzUvnYIl0qPpIhTyridMKkzqdy415tLYTXigKBolouI0RphnxlfzIBxNDq1dm+JdXcpiI0ZfzXPnf0QEGYkHGd/auu6+ztv9ImgkjtEW3YK577Cugj8jmXytx06iF5DKbQaiIqP+gf5Z+SVgQMFaE0kZRjY4x9j0etddzRErEwB4OjFFVCiWmzFy4r2yY2kw/rfdTjyIv/Xqlhx/g79ZsXgEOQc6gRXkFDhJkCBO3YVfoENujt3vP7+OSIPx5iXp7oca04LWO9l9it/Y4AERC0V0bm+7e6DVrt+27li5nm5l947DWQalja3nsadcAGSt5ZfBJiabpQwopyzcOAVnLt4aoYgxhIuzdb1yV+kCi9xq5/IcSYwiuU0+ifC3AVfxqPyBcx/MFKYuuvkJ96pbkJlQYa6yeAjosI7aP6W6rSRobooziWenlleFbpQAhEUkK3gOH8CnKNTVjiKt8OS5TAmot06+2lHEZubyzRwfRv4mxgBhHqBl0KVEHxyVItMJTDVSXyJn1vPhQodJwFk49VxOSmg0PifGEtvvLjjzbFxrSTPVNzkb6Ac829aDyO8Hfb+ENg9E8DxT3VHs2ptH6w4OQmtuhCETxIyZRcObuAzWIhP/rYT7nWT2DZCtgDQI1ORGzD9i6mkP75630LsCCQs5QSN4bXQqYMdqyR9toI+kIx8TkjaF0yBcnUMqDXTIyoECDbcIuPVuJp7jvGyhH9GAGQ/lz2oTwL/q2lU+QulBdKySlNjd2aGpVvfIMph8e4caD6sPgkaL2oyj49ITtQJCcvyfsqRS4pjr/HfAbY7G2yVRLxQL96qtcgmWNKpJtQUiEX1BWoYhan5lEMvGkCeW9XFRYgYSzszVJUUB4jYbQ9NPLx/hf6h00WyFtxrfPW9XA+JNI1x03fDentkIXRDHIn0Cbl553YQOqxC/RywQE7wehK2eHScBq13f3cQLyHSeGUtuNhHJMDBHJ4siqM/TGFalTgUMm06y6YT9GyMBqMiQN4ECTNEfPp0Q+TjJMHfvcvTExBlorQSCCCDzcklzoVe+JjXAZFQC+ICRdfM3TlexTt64sjJbShxpGKiOfWU2+qgVS7sgMmfbdMp6JtGIE6bKa41eDXVWFhEQzjtlRzOidIcgvcpO0Qzk4IDr9ExxLEyXrfx9IjMk9li8lYieFYn2mvsGJgOIjZAcBXZ0fDWkyPbbPXGh8hllRexNKIufdcMo3c51rTFS639tSXLdYIiWdHDA9KIT8Ny4jzIJKBAq+GeTrIFVYlw5tVXVwRy/ufFlUfFmedQLEKpzqFvx8dNHR0v0cTwNbM1XBCv1+No2FKF2XtmqHz5S3oWPxkWQrH3t+EWlHe43o+2Rus262Ov4MyOr4v2XSn4AGoDEDdBmsBDy9UCgmh2QdskGyGhYV8oh1ROAC+6oITo5PpC8FTv0eQprfHAT8GEwtqFb5/RJ2g7R5odkuU9slWF7AxIgnOyfk6OG1p1/kbthujjzQ5Rsp0m8RiEcNvAte6lbJhvzBBy5qkJonVPOVEs1fnbSoNOd4R9A+khdtJ0yJ/3ESUIYhBBiENqiMaHt8tgwKZvTmB/gn0b80V+YgnISBFexei76EheaU/aZfwNmG6MAw9tOzGaBGDnVPnNPNYnZsxVv8oF15rOY2ywIaNgapq3u1CswR7yRg+j/Oltt0au8zkIxVUjOMmhTX1OyMdNiIpHqVNT2GWUWBEvk5Lc0Pz4KL+74hmwCWm00ubzlzsJyDFFV6MjaYTnS0Vp7Dv7DJbWp6ZAa2DNbijt6TQRiUh5eMORo64yrR/DsLyJik+tl4nP2QECefGYnPANE/Lt2049mwfqKUPFA1plDZWzrwKe9KiRh/qun+wSQMPzbT+S99KIw2rIvNEqPi9IkguBmKjB8nrwhJUQMKT5fdAHsoBOKOsxm0PXiJ7rywoIkGiVfsCn88FHeWwwpaW8wCODp2KAzx1X9Kcqxx24FJIYxq2X0UP3AcGMYSEhiMoT3D+/2iTK+YsKdtIkUThIS8U/QSat4RWkx82YZKut98HgHdFZJshZvY+CwQaKQdDFSqVQuuC0VK0RfLR/km1aLzSLSovwaJ6AKioPtP395VzlP8ZcjdXOPq0N8W8zY7hFmjklsp1xexLkeH30q0NYozkWmV4CUSugdUe+XidFW7Tln/9kd0JjaW3CmoEkp/XUTTLeN2DPmUqnL619LsDmxF4wl6rk0hICIKk1S9NIDfSpMBmu8sQpAJY1HUoHAkUlmHzRIXislv9Dq2fjqZuAoEOwwD2J9E9hkMUFcamJ5CdI+WiRRDbDhNUT3W+toO2TZBTnF+ibpqaPivrgXjdd9HOExTfEmNPT7djZiZUjffOUAPemlH+LrDRt7RsbGKFLfQyyOdSvOioCTacHb6Sb3TY+5NQbf5Msv0uJHWcdsf2BQ86dZTLQIf3rMMh4K1ZDW+F5i+DotPgMr+zVNe0OBfvAXeEGTaZfk1+khzqTULogVMPtCoAR/7kP9gYYIdQhyg9OFbjYXNlqOOVdr16yVB3oOmVMXdGrKhJ1sb93S3U1w59ReHrxfhPmgwZeshGMKPMIwtBvwCOkfVG6HzYBtsicuEE4KGIxOd+W0daxTspInkbtUmmG1X+k82LF0W096mbgZmhao24itP3LTbrdlOs10C3toyaRLI9RB1HD3eRBtv4Oeu49mUAdh0ndiKVmTmMNHdcW6i79EJOzmwlSoqWvI81kJDT9EubzyWwVCVETTIu6XHz27/DaoLYCokRLipeLXYKQBv71uJXJYcPGFceCzFU2xVky6jqdHx4gn8wW8C0FFdt4CIB1kwj0OTBcQMSBWraugqOGzk85V2Hoq9dYqz9b5G68zd130WiAjmhBt3HqoQY94xGJp16Yg8NyHPkwcN5TsG/Wk1aYgXKUHmQwJKYzKIuZpmVxzfytPKIF9LpuedDvT1yn8t2X+5fFzjTtbXIBhpKb0T0TklhVaxk+USIHdAjNilwW1FCMnj98hZx8XyxS9lLeKHz8n2/O2iB4xCDXSL6yZP+zUGkckpYcNrrBcfU8d9sJ3iHXxxOzu0FrkWti2Y6y/UMKY5zCacEX0mlKOBJm6IlTFt3ggpweIqUKolBuen0HLpDICimIDdHI0Qjwurp7PvjYvmjjNumDur1hYhiMMoSHG/70nSWA5yVQhvxk55MWGfrXvBd351uCc74EQnjmtuBKqgijWQToVQ=="
-		case 315284530:
-			return "EsrcMmHTGqUIV7Pt/Xo3L2dMRw6iRMIA5qZ06DVgK6TBfGYucK7aCSf44C/A+HzTABpmdeY9pxoQpdd9GYmhP/d4gIm9iEK+5k7lgsuY+mP2HPDHMXg+EtSAcOHcb1hEDxW7To2SKmX4aXXHh+cMRJb7JQgHeWTS30FSB28Z2Kjwz7q2+woceTJM2VxJirGLZ8L2Chgt5pxcsBWxsbHizi2Nko5qBBL2zkiYZ+BaNGLx+oW203nwvc4adWTTtUg4zUMRvSPeYdPtPAG/z0o4GOXX4+MCv89hgSCZLUABe2MEO+gsEpyqnC4VRjAKjmD8zqMzQ5Qte23MUYWGCzU2LYl8RkZEbikTik2OBAVo5pvc1PZ7rTRz1a762jM83DEoJiKClfZ/RKKxhw7X6Pn+vvN7uvape8szImE3Y3gLW8AWUA9PtoBsH3aZ+GUx7425ITE3Wcj8TwyDDGHmzvrdSn5ANvYi0qGf7iCeJKI2Cyp3uwrmiNnzb0ckDyC33xZ1ZaxCv0245vDObR7EivZj6VWHn+linJGdTEaXnhSc/+usb3ANsdF9CDPQBsDR+T8CpmokgqE+9hLXJ2sq220CnhX6h26v9N+vQ9ni4blUrvUnosAy3BcI7sjpHuP0s6rko5NlmawBFhRrqglaFJW095aTcHP2b17hdw/3iB4MBs2PLuXF5n6W1OrvIhgfZjuohuQDuSnN1DJjDylyGxTqeTkzud1x01SCu87qWBoClq2iDosn6wcKLwMXocVEg+blEeKmaAH4ZpOxZUveZAApdQ4Jk9cLeAJxeyLwaf10MdHh6Bf+Gb3bn9e+6Tn0XeNzT7ceKa2059J+1S9NIc1uxCm8pAMKMTOei0TfozX56LeDl7fxm2fwtl2z6tTQjs6bI3KF0196uq8BM6aF7PYwgyvsqA1rekuDHxfGQtavfLbefxtrthCxVkuV2AyksCLJd4Z4bdqHHGRcdQDphIFyr/ruvNXrRLO6PvQkhUxl27Mg9zGTdprVcKVuuwOL5JcFKhjYjt0ItHaB7ysjQUblc2sQGR6xk3QfB/gSDGDBMi4G0DOojdgmtqDH+VNqv/AzLYNyPHR6fMbQZbdMZ/zHWAZ1iJjmq8LRnEiTksEief9JKTsSNzfXoXbWbR7OAdZPZYxVJMcHEIzJ6GdiLqNGomiqMRRBYE4EtTeKac73REYt4nsztYRk01Lfml1RkuFTvrU9UMezbm5TH5/d5ncgntPTVLjtvRgqehIRB00K1/kVf6TKZubGd24XPLHJ9BAtEXWsfkZ3lG3py+GzmVyKVykTHGbEV25OTMn85AJXYw22FV3ZNIc0gXeFz+4pfnjoU08B1rtFWbDP1yUXTVVss66JO0vN6sMWN/5qxGQcTKEI4Y/Dq5pzZMswh0Zyba4rOClkRRIrDHBGeT0O95vAas4v9FNVUg8505JLagCLun5+/9YFI/rv6Xwdr73+djci7BVWbRV/ICXyEvJ9mN9BMnsgMVH9aE5zDwGiX0w/wrb/oja1xw43HNxevOVqanYOOPbfsTfSzEh4tZGEaw90pZ3vUWPa9mQwW4MXFonya0+YG6SP4U5Mv7KoH2nwdrfoFZxVH9bFU83ERV95Ab4kuvrpHIJ1ZdMeuoWfMk6fHo6+yzCAzcjlqvkOO3BbI1cY8oEtsNI41aD2VbwAAxpQC9xFBL+DZQnwc0QNEIwLJWHEAkqxtSvDLEn+mfFsbvSWOK6BNwczmR+sh9M48pdaxfv6EqLBoyFyX0dmz/qGBUVyjzwPYhch7frPodzOYxhaAJP63MseirTly22DaVuAGU5b+8H/jilkx9Ax6tQn4pCXXMPwIRW7oSPbUahwTj894IMR3nWSIIun7byGHkIZIaWzCOgpaO/MsWuipGf0HoPiVfiR1jFeHgZrDK7nIqGBaLUoFe4IXMlXHky2gc03RegH1tfqe2P2RSh6eulUQlX91F6fsqjJlxIKJBWxJpfPtwZSlrco0VeEclE1rR+6u8eMPCgRApWH6E2U/5OuVim6776QxlTebIzcpdtmNZE969wtVshRE9br2MTN2sNZ66aTvH+U8cOANExurCavs22oNEhdKn3ho9NKH19EA4xkK40DV9v096D7l0K8lY0NFwTRgoY8RUeyeQygHC7A1dIJ1BmFf0KLoPVN0DsGPSAtbQDUSomw0uh4ZEb6fNcbinVYMJdFDT3HZNN5zDPImujuR5DeSAa1PYNNxbNq6T6vEiA1xY6kSHKncQlEO9bzqErIBCocOsMyawcBhp752xkPF7dBvxlye8SiPVxcA/Ow22sGaQSAvTdQ4p+a1/ifF7xnZc6jLIVPNpPxfmhdW9qJ+AvYECL/qjh26449xBA9xMVeod8z83s0FVj1E5gaN6c/w51O1p0y1uRHZNIpCv7NIdHyURzMiwOLAfGTsQFEEo38PuhhXZLP7LiQgzMQMm5XC7YmLgzJROmLP3tsTeYALnKJkMT6FaEPqXbMjEboRLoJ+RXsyQsfIm8Tyr2FNkOyG4Os4Iof6WT1LuHEJrpy1YqpAddD1X1wP97CjJ58toZrGznQBwF/mG3JI6lk8V1+YpgzwtlL1/yPgIut5DDSsjjIE8o4u2pw9lpGNE9/yMhO5pdml32Y0wRzCrY9hvVDBt0Hb8ZxFikaR7AcJT5oTtpsqJPTawq0LfVVSkrpXzt5UvENGRlda36QMN07bi0qCvWjT+hKEZhJTgbl7/JIPJ80OmQSIX0drwmagV4YI5qpmVNW5ZCuAOtiEb0zQqsXJ1cRzrahEbD5FH4OuMf5qW0M4+aLsS3qIhQXkMjH/gYp1OELE4NHsUM3jfoH+MNJImVeFppXt0xQdimq7hWSFFYWcCJf5/GGsLrwP5fxj930zgVV5vyLMNcsT+OpNdbpSPXCRzoiF41Kt/8/93Tg9L5q8TKP2bori/o1kM8hVPEhhIsCELZxD2RVZygz1Oc8ZlKaa8zaGcVxsvpuxjAjagOOpJ6HCZmBVG3wjQbxPAuNY1TTRiafWlm1N0zDkYTYrrUAXt7mnkiFdsERf+aLKVCuHuqpM4nDlTJH+6PviTiwx/HHFdL23hdds5CdjiD1bLEVct3iBNv9zJdhAjs7gBa/luyykrYbUhW92UFeSWw81JSY/IOfffVs6qzfufJNsZpt+xy5mhVopvylOKL32sgDHO3EVbLpPejG70a/wWJ99uAo24ZTRrzscQuGJBOjbCMI6MyDsq8NCiTotR/FYNShRR3/3B+0Je8qU9RAO+vyyNr7IHOvAXep+nr4ldlAxs2gn7lnGfjqXWxvwj1Ta9og/tC391FZlePINXzCaNSqIwArHEHeS+5VcPxrp9iS7+E1Eq+ltNcTKtz1r9m8yLNDkwGAGaVU7NaBKaJk6r6o4kJ8TwQyZy4UsEhS/aRFyudyOqLEEIO6jqe4dSla/xMCyYGsdS4Uh8Tw0Y8iyCTO/xDiAMrXu/63N4cWnBEtde3m09s6u+fntR8HfElR3KtauS4L8h2ApVa+AaIGRYe+7Cv2LliEauy2Lc5o+9w2+iAbHnKIZFFH36oLGxCAQRmmfoyc35DUL6/ylzBQByOZPtEVY8eoo3lKFDVVWz1Utx6DosnUwrRogzE49hdZzAvbFJCt+NY8u2/MTkwJ4o2hIGWoumCDcpDbSvaY+SWSdznfTD/CKL9CPEJ5ZV5FF5sYtUJIk95dLTpSDwGLHEtJapdSyr7LaTCJl2zVt7lub3WM8AHho1wj57kHALjutBaTPSKYXMEW6ZUTASfW2zQfUrVL1RWC6IhpdEl45pa3KjBYIwDvgwF8hyuBQVvTX4aAFyNmoObaYGYD26XjYVVYT8CUJ1bhAoM6iF+m75MJGHvfUEMXTl3FaWY/2gDKkJQQ2m11At7f360/GSYn8SdfhlFLCJNb5n5a218/TBHeMAj8TE9LGKn7MRiM3IAJ9KjZW9MVK8L8ixvqZa0HLu9Av0USaKkGNobE2oxbIz22DtDFBraHQ3u0DV6qjXyKdeAW1zeiSQ6XUNhCNz8YH3ru7NVDLCE63qf9IgNvfwW0Y5L9vqjWbxupHjF42FUNWY5HS8RKowjEB+ngGHnDwMvcz5ayNVqhA8fgAhJDjPZxv0QYvbqXfyesCsLf6z+lIHGJgYe/lF8PDTbJpLWa0/jmTRMzo+RuJfUjAjwmFVIE6I/36Tj365hgZK3HLMbd2YKuTPCad63RdK/dyjAfewT/GobGCh2U3uO2GQH3EGF9bL0UuehdWSLjRE3OuGd3HfkBjV370ao2Ssl3zxdp8wJLLnja5Vjy598ay1BFVMSWUS9OO/Q03UZEE5jgJFOgxPpO4gNlLVdZe7Xc4xQ016xXaNBog6QXjLEWR/kut/zybdkIXoujAMcivVkIBWYspHTstzASJFeZjal3oJeVGMXJqKRMdJmAFg/a/6TF35fkubpl2MhxNi6418MPNbBwQCU7HhnEIWyzYwBpTE4EJbuGkQircD4jJMwKCwDWfKLRr0v5o3wjmMiu3PHKpXjj+oVLpqsnCYu72GPUVw4CMFDRdRI8J+SLYJLabKCZoQzQ9RE8u5ZBcTal0rgx9CGCpybMsjbWGAbqkCx5HKOswRMqrCDS7qtWOVSb+4gE462MBfds5FPdHhsRxKX1sVkNXKacV/Zr5rTgoIXIR45RXszw0wK5R1H+MijXEfDXvScqXC0KUvsngpn0IHn/XdImoOR3j+jlRvSHtpFBTmlRIgWJ9LzulDxDVspHX2Bui2i0N39s477mx008npmoC9dhhBO72FHIMX1DJ4w7YfzdgwlH6UgYvscvvno2Ga4Pr2M7OfWWjATO1znFN+Hh4xl52A2J+AoZ939MCVH+p+Fi6vmOQcSkhwospHppX2U4hc/tKahqag1oavbeaE+N5QPLd8VlwDbSMHD/aR+d0aEtRdqQDN9sc6ajBm8cP9Lx2mcLjif3s5FJ4BksmWfkPsDXcu7mHEJyJa+qNdmO56s+cBoTQ1ke6kwelNKYSDwd4nADH00C5nswQH9hQAvGjkDHQUMiDPvwg425sgs97WSfg+1uiMqu/8Xf/qOo1MIDr7/UsuvTcSv2ftNHjOsMt2oRXAjJoFXNWuFiZR6qtZq/inK2K4Yi3/T9M/eywqtxTFFj0xEZM4yJe59dMB2+OteOmVBUmFTucy3epSjS5yueRpw4TA3Tu8NunvS2SqWaZVPXhaRvKGorc0xCsEpUmh83gqTHOvgt8OUZzJZMNzcMgiBUocizbQvPJmKWazTlO2ElfH3u+9WjozjVrI/EL+5S+Gn7CYIDJALe3H63Isy3qCy1BIbBdu6un40RMViZHYNgRcJfodal2KROjI342NxlaiKb54UW0MH9tWn0n6OUCCED8K+q9b7wjMOYh7nnD5i6d6ytkPRW6GpBqzDQUOD0+4NHlWBmiDIgPdTuwhemOJ/cfLJacKem9Scp5w1hGg=="
-		case 2380768206:
-			return "jeejzudTTVzO2CT7tzPu+kgnh8++tra98Vf6dkIEiM+573uOpyYZCPakHfx1Ixh4vx4w0Bre/mtbmRPkHuy9LRLQuh4pnCag4piFmVuhl/VCt0GsurHNwz8vXJZEepG4tJFAa11S3+KnetnbmxVd/B7it29N+XijiGHl/M64K61g4ok35kGHdQGs0RDZOfJJUHKRFBUkj97HrzswP7ct6vJYgvNBiMOd7D3NI+Spq+ACl09kDbIqOnb0IU0IE7nVJA0MrmqR4XZYM7NOrG7Wxqw3KJMuDxCzHbcgV0ebry5TUruxQhqXakiTtgejf8N2CWV5VyOMfJBTCgZbxjjR19JJGy4/oZZZTfX/t+Xb5egU/9Ynloyq5nxOHf2xqLNxTPXbgPsPVO/ihvD/XQh6NKZv97imHXfho4hmTh5lO3mWm6VJNdfO7s8MyBRZcdItbXlby0Uy4JG723IJEv1ga2BNS8sLK/q6Sx5uJUu/yYQA5/y9TOeyn2DtuOTA4a/K+JRmr1MkQgHprOEEw8iDHDNSprb5oBPYZzP2KNAB0Rf/d0nIH877GHPToRvXz26jLRMqQYkFTR6ahS7bMAn4cLJg70+qQo1RHAUu3nxxlXJ0rg/0xDXy2lvCu5q5lU5tGF9C4MnvgjspH1NHqNNPSgZnDJpcQ+z/gOrEAdho91qtuO2kypM40XW5XqJepbYE0vqWVr0sy/Pp8mIoBtbMdIVPX8KXNIOhWPDoSWkIfKzMpbCyOVjY3dF7pnNqoLb9kCwmsNMs9cDItHQZGHJNxa4Ki3TO82yNaA42M6Z3Lb65h6c9cS86F7xJEk5iHUM78vn/Su1/3p/3/g5PWw6WY4zoiFGRHJx6tadwRNQDSq2ppLT6F/oiGYwEaI2t45Lq/7EnEAVGlArLGKmiU8EiZIF1saGExlf0OcpB2FUxB9o3oC3BK/6e6wB4O3mYzENrZOTVB0pbznHs9bc7d00yBszPQ9T0Vw+e/JAyPQpigH8l19xgfu/xVWAsG0wMl31h+dKR2H2Y7WdzQ9k/HGMl46uzzL/UezmDSTO//ib6NhROCsSeZF/trrjRCK+He/UK4B0Nh4nRw8VlrcesNCYt8XcBl4hCQhHvpMUl3yCspAsFgbpySQIkdGDluRhAaJdU7eAi702kAPq8yPmW/ZgyMjvVWKkILjvYH7E4kWSm5fcpGKWKEXyhBPE83LQiDqqi3msXdY583aouBuN+xzxRSR9MQgIenTufFdeqnOF58Mg3R7FJdoM9TL2FA9S1FnvPzcDutxJiuTe/T4sk87YYbM4qSNDF/baHXUsJ8kBODQLd6oPux++5QWcKP0jXWdcE+8WFio+kQb6E576F/wHqFid4kT89hb9JPMpu5TRF9NnzjryLVI5bNTIcS9Tmz65DqgEzj9AswpJuDHhV7BSZNg7AB/lksfIgZ2flXRzryy9vW0pmzUHPqPfjg6iXTtpljzLs9T79tJyy/kYzINe8V+66AtPbCDqPDGssLrvWq7eZjVACk1oQslXxv6K0AgJgpNOzFmAzClahTm0OW0NrCQgIHAYuIOODp5rQrzUg+iDX1/3p3R3ilS5BK0QPQxuTQxa+usBEe5Sz8SsfQYwNEGMKpUn1D/cn00MJNWjyQtOknrWggp+Yo0TdvcWu56UVuzHTO/s58Ts/UgQx7/KzcchANcGjiapIY+SKDIN0TxCFiAv52cWWmDRuQCaMpyg9UFQ6OYWUZZGD0FzSCIwuz0X8NsRD9inapd5sAOm6FaBIyHM+ewhrUxtSq3dpljO1lO4L6UBSiHgjRxrqOZ5zugA7ZM1j6fu8B2fArBuLbXof6OuWTb+yEwrKCb4kfGAJH5IRXSxc+ZDvlZhzC2iAQabMszh5sDckigRH0HFzV2KelkvwXfqVnBdgGDmk5tdyUs6M8wMj4rRSXJIMkvmo/SS8mTrS8vyRxzzf1ivIl44j0qaMi0QLmZiygG4Z9O6OCi8co2pxXhYhhI/qDT1C3tHMp8vgOk0TYIgksYsb7DYJ9453KxonbCr/GeA9Zb/TFv4KbU+y508ZGJhY/cnSVefdRJYqT74viUBgbrsTJQ/EivNySZ5FzvW3I6rUmS2h6GEtYp+7yEuFrGR2nfBYGVM4YYTNQo/nl6ADRJspFs1/SP35v2PyVlcvYJK0H8VU584eVEtZwBbtDMylHLu0LraXXI/5oTYoMK7mWqCbsRycDcFHtCPQzIuyryvhc7EeWcAAM4RtW8wK9RkEXj2HjZEMahWRLSlTMiLpKCwtBOqP3O5DaUhe1bqd+b6uN1Xlo/NbAR+Yh+dcN9n5DM2tw4I5zF5OAcPKp/lgJVUFEpCEEVWfnzLNAL5Es0/n7yTBhXRNQAv2OW/w/nps+1vWyr3WTjfhTNsAtGwkjKCgOHbA6FqBXjLinl6mIvhOZ96jRem9X6YNVKYzhXpLZ+A0a3R42YIjWoRg8/nUNqzkkrzXrKs7ZrKFCYWHG1NmXXeezhBraxYw/L5h3C7hAT5JfIXK1d5jyydpQOY5ArgMyJ+WnyRVtFuoG2Ht3tkwNlxn6wOlNgCkbts1R95RrT9cdXUNMZpKb6+nM+rlFxVsgev0jlmDYZAGXt9kgnkW9jArKvV795c3glwNpL25Tuxmw90p+Nt7JxpIGFgF9FnA71oCgtmSQ1Cvt4PhVwq4RT4T3XVCdBeyCsOMVVVoWELSlEzUkbHEQHYisHVBwmTqamDiVgMINSKOylv16iJeR4MfSuIsmj6jIOQ8wPw2WSzJdCY+Jw9tUAKeKtnsAupqTXCWqkSpHVitUL0bNrk7Io1W7uyl0CfVThFhRkKXRTGxu2yAfH4hf2s5+4POJwbj7vTNAmPTlygl5co9cM9ffnK/q8gG/X33QS/AetLgeJzePRms1uZKG7uKWHGkEvVFQPPpNsumHEPfqe1Hm8eAodMkeauhKkiotG0OoF5JZHJyj3tRA4BZDd6s+dFKnSeF3AF7pTDOilsMdpinWHy34OU1wKt1RHzUmdIpTJgnzxg4WxCs7UfkMTRczDsaB1489nixGqPCCLe2XsQ58IPodSTrixQehiyOkcNDN6kKR/BXPzL/eJV3GIybQBVeCxWSllpSfV852UW4KaqDyih+eri56zpp7ntXoojluQNX0Wy1jGKZMSllNZCNK1w0IZjmkgxkA75G0UWXzirYrv7mB4YgZEZnGGR4b+rZm+1oyHbMNB/YhxFWfVnmGv48NMliklhg/TLCrav2kTzm8zUSTjgwLgGu9CYucZuQUVxpJpnRUs239N22ILGdAkeUwf83rlawaxP+dkSoANgEVwMyk18QWQzHDut4XTgovXz9HyJoFRzBM6m/SwknL5eRBp7O9rUu0I5WAqzC577o8okLyke8Eej4mhS4DHjoCWGVuUXcPR9lrlpyZVV5tNqCBOMPWvINCJPnENxIpVfN5CIZXmrA4ESKfoKm445c2VRIiazz8HsypQqLIDNsn2fAwjZetunJFmIbn3HcBckxURfOkwACWzuavMbpMsZGLA0xIWHMZLpifbdQUgHg06e9W+xxhRkjcD4WCBvSQV0CKHYSDtfnVI1aAuPz+DvfFdudX+LEncy1kkFsNcLSPlhuQZd48riipTfXGcJ7aiGC0c5aQBfNCUtXJjeO9SxWwEiSOs+/3euzK96Q2uFKqJUimoMv31QvhmVmxCtXuIwry3cmt3dCJ56UD3wHe268iT3vA/lghkJpia+TmQfPfB/qPXUfwyyfLBJE4ypRfb7pu1Vt7pqtSFQJ0E9k0ZwwXmTYJxStuKtBA5ur2HaGclJRxEKFjtKbgkDSwW/tcVBzo610o57JkwCv0i0whlqlJBNm403X8u6oQYEFw3HvicHZ/yOPRPWE0QBZVqJbsMPXVcroVX4LTZ6LaDwaKqhtwuqAOBva/AXQfveuLHKogu+efD9s33wMo/XM+xM99rgFgMsbZXYc7GOpUBoR0ol9gRiEW+Oth2R+uLgmbNsQIY5Ji60URIF63Yka3TPE30M+37yjlhhlzUhm0W8MwhO/mCG/cYd03PQZki0uN6IuxlQTAamwl3Rau/T6jkSfpzsvBD00IvPnv60z1OYKQhN/lI4eu2raTXTq6KQYn7ENmm4AjE7i8ixisp9ZSC+EsPDuL9LgymErtF4zbS7XZRZKhMlYcReqABX79bOxESINMhGPWnm14zbpQdo5uERHRz02msWAXVJghjGRyCDy7v5m7kEUsGxxe1oqx5fqlvOc0DV6qVdzNfX0OYX95TeKT9M4bJFcF8RQT2I9FkDDrda3MSj5sBog6ZAzJ5D4/CDfUiUc4EHoXlHhpn3MfUtAT9lkX4iMTihqJlOTUrXwp2mqwtyvlm3OI8jWoXYRjGy6U2v999kkbPWxx8OHpNvVS1rWB0GD+fpf0fotkQoFc95JB/ST5n+nRVdK4M15om65qyM3QK5eHx06YbDUafDevamwDGMqODZxCP4wYFTEUKtx/9bBTKl4XP1nHzS6nsyjIJSWqgJQeDUkSADJ9SHvLgFFd2nNaQfHTTtJhlwFQgozmHTyF7pDY8etWrriy0I7h139peOUPJP+ADqObEvEbE/JxnN6AMAl4IOgkRS822BLLA0G27sKUXGtP68Cs6rg5iPDH5fziTgtO0I2jLIen5tlSHc+f++4L2AUJAEHaEeZn7ARON3uxWkHBKCH7tz3wJgeVV3rH0rF2pHqtFB2llDKzdEgLtqA90pmgzMsqPtdlMsGHoqQ9YwN4sRpaHwPbrYC92aD3tIIBn9dhu7i3h9JMq5LQQDxtScXmqLotPLB7Qa6hPTAMMDRGrHaHK62WtdwaL37HxWNKqgGodTXVmhxlvWQuGm6EYAZf5XvoKgCFEOqiaK67fnUH+RBeh82DscmQS+74zLopeODWndn/g/2hglLKYZYJF9P6dTJ5+JMuWds00VXK1hGFquHdEwCdWcXkJZaVh8gQeKMciFDTHhK0fQq9ysVNxFH8+pRtKqaGv0stjN8BFnZ9FYNSFZFsKDUjiuTzVJBla8AzeFImqMN7bTIMj1n1uQg2al7gbatIltdQzhHOFTdJN/1LogCMOIZK+UpHR28rWR7oUL90Sdk8sqvLE3jFDfEx5P3GGW+1IaDqT5sURS/kBUefHYvOqb6cYvMXhGN9CT+pUNkyB2CJXAwLL3D3ewzYJHUWO7EbASC84Dk+yBtXf901Ar9kKXdCnR0XgA60aXP4zOg1jOtGh8PyZnoe2H2dXdp9gCvkfl2iezVYPlsjSWxBN56Mzg85vqaDHOKQ2+WkIMu6YyE7KeCkeZrCnGFZjhpxuIDkAiBMO1Es/UXaeRZI4/WnKSWNIUeXYhadlUrGSTm9BaC32Pqg7ZEcX/1kXU2Qd7qQsH/W838s84F+O3q8hv7xe89Q68yad06ldhvB85TRWkiTEvLjCWttfVI/VurzzFkKUYuA/W3sw=="
-		case 1471805814:
-			return "V7n5doogyFHcG6KBM9GAoOgiad6ty7voD0evJX2+NB82FsOVwUR3GXsCv58t8K/iCbZ8IuQhh4XGn6RBIrVSr8rJO1ivBZwNzyZifMdY04rVoL5AlrPKer/sxNlrcRuTQKIOESKff8Sk5CiXoprK6J3TJOaFjUXefTPykTsuR5joERxpkUF8nL514xdnMgbLmzDJU68yq7pqEf7Q/5OtIsrhtAwm71vjq9dO+/tro80YHPoFW2BVihp/MDuSTN6NNdPoaacM9H6kYOj97bUXqL58sqahqQW4t944yJL+L6FiTDd6t1KI+rz5nP2yI+sQ7ITLJMSzvH084cHrNAa6yZRKiUPmAWL/I3RC3XxX190WcasjjbP9vnzmjJUrE+nYaJhrz4E/T398wCVidhEBmzbkN7nwkkjuQYX9aTVvB7bjb9cP22Eu1bbWsYyayMdgoqljdKmFzfm7en5oHAlAoyjkSyNtmn8fuvupewZGtUwODFReNF18VJkvEZ2kZEwRkh8uD5hJ07Y8AA2mKNzlFjNTSRgwRl3QtSF0rqR6uzGiBHAt0rF3+MjiBF0wCjDbWOeRDqyCN0ocfCHC1YleawshLbmipiTvJOIDeEOcKZhDxl7x9AoaRKbs0T+kBye6T9IIeBxbngY1fPWzK74T9oFnIps+15/7vP4XDVPLlRVX56IVnvMV6T3jKSaXufwOlRhsM4vfkhjntWvqeRiVUGXrpp6P2lxFiHI1DKC62rNDjFYd43xUcaYjRIq1eFrhfYgpKWEHYO3XFFQvf+1z3xJN8h4wr8QYftOJtdP7s9jh4bRiiWwPToiSnS1GVVZhTcD20V2w7cxAM9K9f9t0jJNJvUilsSJ07aYxaFlUc967PDyWAVm2w7gZ7tHrl0tHRT47fSUWZN9ON7kMMX0px0CSpGWl8k3Tak/0870bPt8uiT9wXUDASorRIwY7gQ81dSZN80jgLeyThWiAe61ZFBPNf57taAeYt/DDS7+H4r0skdu10UnfahBq8FCklTT0M0DaECJRBLKIQ6opLql+PzrvcYxb2P3xLHMcw7EdhAXtqlKAMIqUKs7jXiL1AJjz0DBlAcZOFoeizso8jZ5X37+H7v18i0cti3jMjBiSgjXGLPNyJ5ZzrLEO1rwM4R+w/wop8nU+1D5nwkhjivPv/mFH707GMaARngeFK0HJzQBSEd0MjIhQM3wKB4pqx5VcDml8e1ymya3Lsjd9/oRwlqi3h6UHod7s2QKPU0rdp6U9XJVYxcmGOci7UGMcMCVuAYyr/eM+KJhgi5Lhv/rws+3OgQCbYc/tiINF8Rq9tbbbNxHMh78gxxKXYPsS5pdhz+gKN0JGikrK3fuKJnFH9xo2c3SHxKKHEydhpomI5ExRf6v0GfhGWPLQ26yoRwFa9eTxINUAHdP8wizv//vtXdkM2CEbAwc3h6muzovBQathWtcFvQ9G3Pfj4jsF21JZEV4nWzIPJtAtYhkqZcA+TMP8LDkI1/qeZH9a6jx6auiqWlC102dJyqXSkXfSgPqwUPwiOder2TjvcR1YyToQ9oqMZDTk+KcP7Nsf1MY2l2HhnmKsyZepuEoNp4/jVhp18qlkjTDljLdwmUkhYOUt/CUfFgFUUHhiJMPQmsZalJ2Yak2tUW4JBqxnfLNwpO7yWMPq9n9VjxDAP1xt3Mtn9Lcd+K1vb84SkS5GvGmZYWjf1JuqzfOGcjTVUiUG/HTL2kSnYWvovKtHTsHt8jXufduiEDvk1qCTu2TcHyuluhEIo5Vo/NlPCXOgV2YyjuKgEZ5Z8Y9psZ6rPnG7eie3A58+ZSpQJgzkZ8ywOQhkwyg/+8LtjQFeYdhhPsyJ2UasMQEpRvjKx6k6GpUEzMHgbGWx0ICeCiFkif3VsP1gzTTJDTjhIhM2UBr+x79tvl3j1Rk8WNcHI527dREpRhK9yRuA9feQcWAzQXODDZL0JkaE89lXRJXQfn1K18za1+XYw6vpLbjLPqh7rCitbKy0TAdMsMy/kfNZjht5vdjJqPuRrhoLn9O8GHz/ZTx1OTN3GvxvP6Kqv4qAUeYfDUjMVyvYLvTUZHB19hd8D/CL1OIrI6FPAKa2dS2nr7ts9eWzRYb3EDkl2u7C+AyA1jsJwkPdlt1msagZBK0IF93mGREuOffAPDebJDA0kaN2CRpWArGBHUbeGjkzHinRuF5iKPk8SNX+k706aaI1bZilPqOdrq3tgPf5rAUcI6OZ/FMC/Uglj33tvLi3CqsAIlxQnNlhChImSxiRLmENv3WvogLJzpLzvgC5U/GO198CHTlc8DOwfrHZgdKN4U0gzJFZcNhl48uwGyqNptnvgkzJMIX+C7CJU3319tJgUziJtRkj0ziQmT/8v5/Knn5dL3gHQ+N8xX724lnCJ3Xo1UjpjU1Uc+TJo3AhJt/VBUdbxprFx3kWvlk+sLsh4lD8aNc7X+d5BIy78scCFFY74B4/F2d7y3gRlqRKRLxEyllBRdz5ajDrlP0QnHxG0EDMhETboEZFT5vW9Wcb74rMT24QCP3z4zx7sH6RwyGyQMHuDqqmhKHhBfzambYEN6uYyoUXU/pcZnq71pTBYLGLMWT+mCwTsWW87ZFisjWtMl2Z6N2Xjr9IPB6A5OhMqUsc/oLJ/nRORHqtzkA25Ldrq2MgUHvmwhbFy6b6U20i9NJ0xlJ5b+kc3eDiggzRMnH5DSQXyQhEbbbic0P5BIUWxLqIJo0yIKHXBiZSbYC3LqtEHt6b54h/Udx4oOrFndFbCqCrreDzhxbX+0EgrsvNGoMfOIBT3keoA2/B+VAk84ah+wv19yq6TFBJBMpip5fSMhlbxDTuQZkOiQ0YFnvw0u1lAUTzEpiXRtS/gYO93enva6ODyLj5KlBQJtA0CeLO8srNAomzzLVOfaaLPK+OYyqAmh+7Y1gpWSUH/b+v010a055t6gQNX0lBFQxX3Qyk51L2VAhw2BKrlkwEOXgPLG+tjFRwlaY6vTCytxHNBfO7jvRM8X04bjwXvMJm1dpafp38atWDW3t7xb5qX77mpNR+Pi25KISpQ01We32LHkTdbUZEWKAn1NQ0B2aVc2iPjjtz0Rc6X8UUXqsOKfbh2W/oDxS3QMXFOXA0Yw8z3UmsvnyIjML57nB3QBr+NHiebrzDKGDMc9yfq/dVF1qe2YoThhVazYL+T6V0D/L138pSBoVevpMPK++5iB0iyOdG5IduGenVYKUVy14R4xk5K1CijNOSHN0U1e3oDIRsKDqgQCn6ySrQZjBUgX8EYzRddkn9+ZlWQMEi6t/vNL79QuBsBtcSu3w6LVIDY+lpfgVpx19k32wVRViERGnoY07Het82/Af5rEZuaISNzCohKR/zFDQiikqbGs62GT2+be9xi87GZXhNHM0JgJcS+5S4XIlwPwt1Rr9i1Doexhd6NXtcVge/Q8SVS7RuMIdNP8yRyVJedtVhkZ4oOeJT9bcqWKSpdIKShvnvcHA7jcqHzYx3fv4rG1MpPsSWSJ/nyJ5roLBOxJwlzoJmcMFLtsbzejaddB7Xv49QCItfchYAs2W9RrJ2InIlrRmlgF61nK+yogN64clOi+VvR33APjnQUubpCAPaalA6l8ETgLTyODudkfGTHb2W1MhHouWYZIeB9JS/xiqSPlcdh/20tDFMHMwgdmA4o/F1otMYvwErXWEEUiSekX7eVTS/PdW124lAzNT+Zz15CIuyCIVzr826ljdEMJcbL6cxoPLXIUjtSWDWEpmLUUB2lf1WuYhrog5Em4Fq2bpuocMLzu9QEHloPUTa2tcESJxfwHb2VQTtcKc8fRVMbNZyzOdBwHjSJKyNcqodanHwMfaHfzKQlx94sNESJcf9Kd1ysixav52qoJ0kHYsf6gAY/+LO3wO5kUmxacxgOfCQXkfqQsq1DqfOUFrjQbZ3pmbnFu4iLSPVoI96Y1CJgjyQB/chv5vp5wh5t3A+wpSoyFjVdRiQE49erInfpjIvktIZC5kC08cZVX8544Riwh4R1tKyjLfmac7bYj1ky6yQdQ4xyIj12bgrJBnen/ZC3ZFaMojEoYMP61mMmoBP5X+oo1QRnvFoA3YAx5fxa9TK/IDLKsy7VfFU2010WcD+nSuFhvjROtwOHfL1hRAi/OQbg05+E9fDLYFWnGXN5pBbXK7isrz2QL2eVOhKSoW3pUDgbi9fk8tRp7iS44J7uOdWC7yGn2ntbcT1I6+SL3r/Mqh1rSP+vMM9JTmNlbnSXzNuOI7cCC6yfmwzOtE6T3Gzts/Q0pLokexrYmSbyY1m6U5ZoE1ie2sQ04pSNCN/tGky1MPN6N4NxfDxiN/0OAdKPKD2m6RJQroYQ9D8Fd91z4BBGlUWNPiHVzMVXncwj6Lx+6e/d3Tyj4jOqGXung4QV3MYy5JLzvA/05WzkRi0aQpAO+f7CLKw699+TSYJyNy5xYTGKfp33l/TPqT7nTs3r4RMZdoQg42KbcuP5cAo4eFQz6Az/775y5Xo4kTx7qZHsr1mXUx76ZfnVgzPE1CFf5yGah5Kd9NfMQXR0Rgyj/sCSezuBqp8XXv5tMgNv5SCHy8sC9By3guUFol4X4wuj9PxUbGCnLXjjbbAa7zxYqwyogBK4Ya+4G1YBV99D2T0FD8MnC/j4ADfEWuJacz+bn9N/baKYo6XbZcL1XyTP5DWKQxmJbkkYWIxF/UY9ZzF12nROcxejCD4oTI0+hvynfnjZ+Aj7BC4Gev55cuOov0Z8zD2jWMTyrLqD7+toBweJNWQu4yu8nAJku3TyK7vRvFc844s+c3iqq3yNb1Agzz9Pv0P1df8dhZicufz5zuHPH1XOUNXWMpPWn13cltkRgBfZTy5W7Uj83nMAmp8gnleVNNLpaTf04JQpHkviiFmw9OGRasPnvAu9w9Xm5Zs7nr6Fi0JLiOA+Et8WpV0Y1BcDcUeK3A4nBMkcLw0pbrw052y4uvf7BpbI3oQghSBfLQ2EBvdkfY22gwWo8UamOVJS0tR2g1mRtRVFW8/Qm+PnwYIiUxkkwiJxAXYks2RAlxPvkUs98QHXINraoQtQzN06tnaOKLjKJmgnGw8NoEPKhOqrwHSzjJ/cQ6LckyzQrLopxqP2v3t37CwbgY5nHZZLhjqy5E1SyD2Cq9vnHXWi8VBOcFBnVht2E94AUWmytsbI7lhHWmxehkFAJXPnE7lkqNbuv6VWzazKIrXYGvM/gq4+6H//FcneVhwSUl4KSOD8UCJTBJtLvrPF+V59vnmRNTZZS7G5ZSTtIcwSTwFZ4z6nPw+JbiGk3C9ZGr21E3I81GiElF0c1380rc7w2tymEFOG0ueKsD7DZs/vhEJCqN0xsJU9f3in0jeFRU10NVNi+pAr1XYwqYPX25zkOyTClFjjcJVhE5uIP/nEGn4BHKMuB3g7f4u9xU3GGF/51T2ewRmA4OiSIq3Ej8AGNk4jvsFQC1rI9Irdw=="
-		case 3666869118:
-			return "2o/7fmZfUtc3lb5MVekZYPZd696BuvsilBBP7En37BPao3W9zXj7pMVFRxodntr1UCuZMu4wNVUEAmc36TcWW5RQTmanztmdOwQfXv9IHPY7bCEfXCx4D9Pdb1bn4G1kDVhiqdwBwCoVETauglwRaMqD2iCIZUNcHNMLvGwqzYJ9iyWcSn6zRAwdnIJ1YbTmVXhSB/vs4cTLF2Pn/rQ54KFnTEjZdlpJDUXscyMqMlq4pgOSuhEcYN825vo9ioGGxlk4L5NLEAtbpiLmMT56T6izXWV0sxnp5jFhzC0Sx7vRjFhFxMeuzwzbFfihXI5bfAvE289CKEY4mBU6GuDBUG4PcuB4ZxmrL5huIGe2JrIkP3MjiEAk9GKl9IfnlaXD+JelkkzpGpMEDkG3kzp0dsXcgAr6wxo4lsG35st86MXqcvov/H6isy3iu1R5JBHJPxlNS11BM5os/zvBwvYDkFnDNjNjS4gZqt6xnytvt0IJnejwPtsVOfO7793dX7dwQLah605/h2JXgBkLvp8DoE/Btd9wFZfrmOgh2KsZkDY41lsHM7kf4fPfFtjwUQwZCKtJ+mbwmJum/3uFqXRncqQzvQWvuwY1wiaY2oQc2L0024W9ApxU3fOMlms5c3JCfzcoDSnGTi/IwzgCsIrWHSsZRZMO7MmmyXYxbegjwU8GHu/6gx34/iQXcEDkvGiGuXYscPnNuuBfaaEkVGZKGLqkksCeUJN+NV2nlkSJhlBYqLsZM/RayCeL8RuFnzkAnjqcV1JYlmwbZ6A8RMWZ3WNtB4GRz9Oaxifr3nU4Dg4/NR9bLMTrRjQGyqSn6AOEvdD5zNWgUzymbfNAa81KusHKDO5SX3SM2MeNhc536W3LVcY8lF3iuumz7SZrMSaHAHgWuY1Bsyb9m6eFgEwU1J25R0Nt2yOh3NzkftqQ2fblao5jWtkIsHEdczDKLzkXtnnv5nScCHMsCds2LTYYVt2LUzu3VtrVBZHJpKyxKAyGkTT4rIwewXE1XkpdE8D+vre0d616bonLtvsHIR6Rf3KCsq5h9mvxmgtjmXmtPHnnbNhNQUahst+piXCjqphEW0GeDJVMjO/bOuvGPlg3yUJSmMQJRh5qP9pMLRt1vjZVteUlehKXLu13QoZpTAv/3/J0f/X7qtAi034oEs195MSs8rbZt5XYbOF8bOKRsoqVUlJHdtzBm/5PNQ/JxH5COwKhxfys1z9daviZ4ZX5egTFZoN5pNBR7myc3PO7Bs3PZfnUEYc6LoFfFwBTrh+VI6UbRIMIQtrEwi3BK1V8WVfOhzznlcYcxHpg5TGcUjKGK67emHKGJaqJ5YbVu5Kd5AT11Fq4aMnTsqOMxG5vbbv1FZtwcoGaeNQfqaDinn9VLv/lLtFEXQEUtPVM/xmU17Ct+I1DLA9+vpdN9m8cLzjTADcmEh4d+Wpnvwuw9qH4S8m1M5kM7ZCWZlXiyBWyIwmiu3VTE5Qv0P93eS6EIQjtqok3K8P0rMZe/S2nvPhtFsJHBdSsRLd3xPJg4JlJD2vUnES5dp0DqZoxJYrXLAukqByY/8gayEVcfv+1JGQrKpoBvoatt+bb93dDF52KfzF8T9avAGglMIizwg/z8vAoFYafZIRnsj2nwmt81IgRSDaI3/Mj1YEcK6fVoq64lEGdSYnxH9qrhaoSJ/RL2p2rfipownOP3Ov2mqnhoi/1IU76Hj7eqeTvHHbtsFhsIojQSan7w8g0kiH/xA5R/Te9Z5QlBvDW+2oOX2WP1PwzWcOQUDK18jUHKJlLTIOTlHqoVofy4FGa4a6O6No+edBDIm5sz6e/gKSDXJUFS6zBX/KALQpFMaoPsvlUlAuFNCRvA78ocsrnxKE8XxD43VenXd2h4QMgotj6xJfTpZ4bKr3U56PYlQgz9y9HcAqvjN34Nwcv9bYjs1PaONYGxbrgQhrF19pGZ857Hs9QpHVVIEdYI2bsaNIdpLenYJwTTTBZZJ0pIxLmutD3dPFRDnNQJxdC2duSkBZ5XGTQLxTzim2hen6ErU+ubEoF2UJiVc5FLBKBDIVTF4+9f2Reem5hgUC7sY13G9EbPpZmqIdx1qPVqix1ZAgWi2WaNSF+CCez3NN2CgnEJPv+5D+1Zd24gl/tCfP7q31csxyBqWOBnsgMzlDB0H83m7Elsn4kAkQhQ+L/+vu+ReKIgtx2OxRvS349BFhZ6BbT1EQHKEdmTOV5FXy+4a9VuWoq1fxHJnYOG6jFS9ZQNPteZtQNSwVkjQZ9da6uVxXmrVIIquQuSha0XvxQAX3fUpvuHMIGTTT0MT4L2ChlcmpVmPT8Cy2/gERsxpRyG4RUFd5qy6jxJXLN5+tieFUEqdrea5z4inTo6hi09oAtMsZZrm8sPNuTqmsLpi6CXzE3ccXGUzaeiXLhqDH/RsrBs2xpMV/s7+SqUlrFaBfN977qbUZmBBJo5LlgwBYgtREOYjDRGCP+OGTaQPB/9lfIPOtS1CYODDAKavv9fkLlPgvaCfWtoyJoxGrZnv2hMwnRKH0BHDuUVPkp/Yr27ipv49ERmKu8Ue1HVBXJaIwyNgrUhAyImAwPzS9VJFyE05OC/3mL+9xr4p9+BTkBG0oBNkfMVKJvJh6CZ5b1LI8aDJWYGtLkFKz6zYRrq5xmigAENNwLjtTRF5tPVrr1TOfkCiJnCcBvMKrjon1S2eFrQxPmolTB38DezaCUWmO67C0lLPi9BhJ+hndmuiCuKw7/qZCKMZAuM6JwojIxrCuE5e7wL1jordTH4ob0XOuPn07lRLSv8FyLkhpyYkfQsNCHibfthPOiMIHheH18GDxZ95mIAjCNisS9aIH6zYCkCAMl2bcTbwJcadY5kJMOBsVVK8qSvr9IVb01yTgm1T3g/lQIMEDdUjQ+1TQHerIcCnmbUnvvKfq/JFGwwVVX7RikKgdIr1cscPRSXa7mfERr0s7lYLTkY98cAuZxWEx3/E9n2yVxs92reK9J/jL3n7qHRLt35eQgji4u0l/xjoa6Bfg5mQfPva1LgG+SbY19x77fBq83jYH2qdAewEfX+LuliNyVRWK33e24hzu4MCvAca5L9xr1ai4aOSJIKZ3R8s73wN/ywzcY19jmb7/vdEsrgSkfS1kNnw6mYLvyjs1BzYvSLo04EZ8hPAIhBLKzLTBEXbpToTmO9YrBc/L2nF8Lg2i+Hy/PJVv/X6MthrEKFRvDkWDLnBblnaHuI0EmXfxB26LsctvkMj90hsNsvPfcKrEP1FNBYMKfPkY/4nyM9fKEw0b0sVcvydjuzd74tddnH0OuzCIakaHr3v9vwxBZhh8xduS15HXs35+RpPS5N0nsy3KT0Ay1kWxUT4WBUXXyPOhvHk9aXJPKRKxIEQtIZhSG8x2aOeZt5rPwMJDL2j5b4vOnZ6obM0P6PExd/BakairjIlgq2SSsm3ZnUVuzUcziXFxq4gO+ZHACAFETCq5GyYzfwfHLoO2XcIGke8Fixtfh3h8p0INnI+zSvQj+YurPVdisCi7gmDpc0QIC92JHk5ykRJNPAgqjizJGZJdMP1+WkvI5l5UrM2bGhAezSyoOVOuZGJiVeBF5JMnwuIG+IjgfWF94nfrkY1N0HN/0OAj4x7WAVjEw8+8dXPeQWhb82zZI1npkDIWiLOzusjwLGZk4fgk0MGA0VxjPZEEYazohRK2hPfLwcKB7PaQ5Dww03FXynariDxvAbJsf2UB0u82aTPriMiXAlLhYLWHPil5tSVc086gLufCzL3SQM2Zyl8wvJEy6Lnm5OpdrTSgnjJTSRF8MWUNX0qezttgRzE21CJoVqGj/D8oItwNUPTllzCAWTxeTdNwC7kQG2Ur3AZTtpeIA+vIXaFzCZpWba6hey7W7zKOFZTueJlhtDm4k+JifGhBKI6gQMBDyIbm516kRhwE24LusJLZA5tmHs/meVvvP1a6amzmb4/h3QKh20aU0obrzQ6VfXWy6CzqaAl62hr5BLUsYzB6qvCAFzRAOeN/dFdnaR3bClj6XCkRUx5yNOf4ljcL3JAiE5dodu4yxFbv3dPwv0mMNuxENw5OarzpfLma0hQcHkDgI0Bx5mewxuc22aJRDvLTb44d1mMwJavrFEBwcwB5n4Q3nwTb545lY1Hx+nRkvLa/w/cmrm8kZYGF0LK3kKjCkLFr3ghIH/zfZ1i7Mb9PooB/7Ca4dr9+/zFXAEDShLSXJMulaGTAtg+FjWN87o6/+zxvplnU+51wQ01sZO6CHx90yI0rhNbLC2ubS7ZQQvpoM1I0c1yuRqLiVRFESj13AMsbnPJkkXQKTc7vg5YxqbTCAphkbziCBAuZ+ycEZrh0yIFnjPQm/038ndhX5NEXB2If1IWkwA7hbAxDhXNYXYFY5w19J1dEFE0mlY9d5SCdfVdUztAYW5Nl4DNn3nDhMos6T84D6JJwgtd3PFRuQxPAOzxhylG4/qwSjakToPBPBEtIAZVmmAvAghy84G3e4F63mYw/ZcuzuahXhcTFsGuq0Au07KjLfCrHbMtbVejrfSAfpMMkaWc1wdrQDXtsCiPsAJ/fny/VCYUhZcjB4n0mkooJsqDWr8NLit/rSQt97sJpU5y+WLTcovuReLg7U66oSm0H/HTQTjDWwJHTnDxvMs0Wx72AWLrI6UDJ0zdGTCz7uRZJNtzHN8X+Zxj9FeJ15sPzIYmD1+BcHxmqpKNQ8ic4sRBlg1jj9F5IGvFCRI/XHgNh8JnXyFqXcv0dV+nx5BEtFdyFTzLNKgah3mmYOyelQfScXmb1ED5MhE7s+Kwfne1/V2qsLdqxumc2lt3e1rlOHwxUBfARBXw1YplxBuzWQ6j3jg9AIRf+F4I0iUo27D8V+JgmG5Oi6+dR17W2iSVgyEtf4Mq2C5TcRwhv/mMMMK7qqkddbNou771kMTD16b3tSz5wFdwrabKKilU4/rga0aVQy7kTivxvK6VirB9v9p7Ia+u76kRLbyuNGRKlZYusBh1EZfgElRVl/Cn/m5wVqmZE+TRrkkYnWHcwlcRpZIIa6+n5SqXkx2/wIAflrcSIiHa4gCOpbt8Tz6cwZd+3x06Li1zqxTwPqCxngUOUX77ED07ISw8WTW9eERZ/jSEEauCy6VeXb39VUm4qbO5wA1roK7Mjcpsk6Cx7ojOsDx7esA12KevRw/et4ie9AkYWs0pP5gmG/7r0GKQtN4AhAginS8wcuIkW/n/OdQoPJsQIMlbCkHQbVKn0HCjhWadnxJ2TjgcuXUcriyMnV6EmV0Yu9DXeQREyC+TECAexzN5/nJ09qlQDMzYFaNCFKNTidg3c2z36pdctV+N23umam25+QBiOb7Y190vl2gDaYg8W9crg3jqnznrpbrMKAqxsz25SgG5+k8/h27lxOsKmRxOpAea2xxBUq2s1+w6fsRKyr0wnmI+M8uZcqp/ElQE35DLI9dMTFAWYRR92GH+dzZg=="
-		case 2057675565:
-			return "eqWjLR+RbyQfhu5iMgBnY9Cm1Jsy3BqCj3F9t2EPen28YmZI67D0Nr7rG4TAJja22umpUOw5jutlkkEjkK2vN/DLbQGSgeW5um1bL4KN004i8aticZmiv+Ot6sF752pegFxJUXe6BAoiqTNEnIAsHRwC8Y4OP1Cpgop90Cn0RyF/YIQhBe4WBcYuMMAvcd+oq+nXpf4Bx2U9p1gXZvDxbk0VltvfVO3vSoJ4IREKhWpA25+XxbRUddSUrLppctl41hjZwxwfTKwtt5gINFu90jH+lQBm3B43d0lhSaQYKaI3UhLhWKSMHxQr7FzU+lhaHDkHWH5xOPHmAgoN2etU+3BAwKJciAdL649jA2Kiz3gXg7V3HF/zFijwJxKRkv7pNKZvph6L5WPc+WC5KgIlf7+eZ/pFfy+yTNxAnle1SQEESL7ycy72LKgSFSxvY+eq7NwN9RM9Oq/22b94xClQSuhqXY8z/rDrrgXL5Sq1ab5/5GZIpvejLXt0QtFdFDYkaY1W6fnyLSD1rzhRvT339MA0VqHOpsUA6c5WeQq3fJ5WOUiDA3tNxws4gmq6dOiwHQdcGR+dBjtGL4Wzf6rdb+5aBeNhMuiEd2WZVhQR1idqYp9w2mA7yqx1QIOP+x6hKHiOJ8fSMHZZVn6MDC/+qxHp4hUW43I0zT/WusjvIprhPWTrTPnHl9kHa6j25IR/XcX8OxvcCZQ0CaG0bTZ+KAX14pfntw1wHUZxe7D9cHMyddSzwtxMH+TvVKshjdxwBCT+VXxu86TsvNZ8syyj2Pd431/OGgsWLICejkDbQsG/yuKBrqGMqEwfj9O8/3cxLGwYTal/MW8B9oxby7AjcSyxfsGOHg+lNlmi3HMd0Vah/vVfzeIEA2ot1lZmzhs4KiJadNlkdwvMgoKHZ8mbh/fay8A+24a8dgfKR8Tm/fuklIjoh4HCXiqeips+Zu0GO1t1cTzwv2NIViJ2Kv2C/KcK1KUBSnSh6MohtHnomBE20bnisSZXmnXgnWJJRFjPyUSqGxZ/wyT1jIaZApXWgx0KIjd8PK3FnYptuGbEdK0lMALCSV+zf4D3FkN8lKi82n+/P61GFR8fqWal9WSc6Gs7myfg9c5djy+hf6liZVN8fQwJOnrbmKnPxp/RLAj2nIcOUSU5ZWIpU3WP81MxEFXXqlmXIz9dCur9ag/fpAqi73hidizuRjFnoRR86X6kVuCbjl7L2+SRA+8dEYLz6lbXXv7suJEURTtjo4QRlg0jknmVhNEpZ1w1aXfbNrtZpsTqGRtbX1yLcudHdXGQFDcqitwRKREwvHyMe5D4Wkpvn8sbiLwMEiYSP7Ef0j5SOuTHfWEeRuduURgfg8vA9e9wlVrJq295qW3frIhAN+XbEUbCNdNkEbTcovV5GQhGklfDJtxLJbZJrEbGarZ9THhWYXJRwW3jHsjRXIMJo3PnXJ+5yNv0SEYRqO/Yq4+G4MZhazLBXWl3i573yny6i9hvmUOPAAhqc/ZFvajDfZFKHJHJM512QQzP3FmRrPcBauQ8gHa7Wc8FtLVi+WsLUxs/U6DI3mPpiSgbfKJo+OIylcpgAugPTbGyBL1F9QZn7Icty4Xg49v6iA2TT1/AKrgTzfvnf9lqf62iCK86HsY7Mzw/KK4BOPh5oPY96ogunEIXoFcGoO7SFBGqT71DUiSxawzPO8Mze/pGkm1HkLvTqkrl/5cK5D21qHygpFgHAaB1tmW95g9tA0+uCcLx/eAJwUl21QY5XA1q/CRcQrvho5KOJ9hu0KqvA+LRlnghQRT747oIePB+uTq32VpKmOhI37eB7u2ugk/koJpLWPMZ503fVfVAfd4cshL05eZS7rzHZxBoW8Utxmowypyts57JRwaLBgi6fKQk52iiKBpznM+nXSKW7GMZF8bfdB2LPRuJ16eodcm2u4l04+2dL5ddHwhZG1c/qaeAu9UqzRGBt8og8h0YLQdBSwjqOd0XKNN9KDlwB1HF7C0SOt+B2nC84ALo2JeNSn8hfTPhx3ItAOguTj7YK2o6ltmfW4Kd539nGSJHfR4dcA+QFWvE6BoprWNIss/idw3mKV9Wc5FS61Afcnr5fXct5tliHvL4ceZ3UjdhZ/cUUWtlOj7rLNa5ROryCdYOoJeQOXXfQuefJLp9O1K7XAUId7euAVU44f34GEbpQsuLWgHq3VMnoKPUSCjUJM23V2VqKlwovzVYc2NQQt3Z+R5ZOJ6REomGp47ITTZb/EozMjVPUZw8XgQqsYGOYYtZoadCRxeh3l4sbk4HyURgI+XZn/ztEV2IfuUkbsQwXjUse3ZFad2TV9aAVRWtcinFuWfconv3t7PEBDPkD7Zv10eDJ/SbSv4sQdaQFA6zeC2lTD4IS8DiXGO51O+Nvs+mFJs6uMCr8qdoOxsZEeaniN29G9cY1ZPCYQtjYwfSm2lBOxyIaxjIZyQguZ74Yp7zb9V5yVWClwuOYNHyDoapCoXo+wzn0hiUw8K+RQsmllaEV1RfJKzd8ZMniv+3hwg/OsGQZ8K84KqR/LHa/zleypmgL9W+mzr9MDOySoGKKPgKyoNPgV62/K1527yhWvPS+zqHAWabQqpPmSd1U3onYTi5QNEP5RNQSMEDevWxeoWvYSKsWENXNXcx6Qv6Somv+EENF/h0ir/lHF+sz77yi9ol0ffbMc3E7d7FGS6g8PT+++IWQ6nRI8n40ovEvg2zIX+O7y7BpUkOfZGKoe15aIn89oPEnxGRaTlwZsVyp9wFpMxyffl4irMNdGKs5MRtsMjb2W3cHxfeN157mRkYzckfKxBqBJ3jkfbefbdSWX4x9pzD0NwURrdGhW7c0YPt6DGX05fBm4vVfly4Ua/pZ1mrIxEgojw7wDn78MChKwXCdhuBuvG4jevDbTDPPOB6bGeLwoIQwGQfOSW3QVgYO4PZAn02QluXjIJrsOtbLoHT53HoDD+pL7gTbdH1D31RMEj0wzIIzFh9EQ9wmUwfb/pdZ1sNI8ZNjFqgHaiUxnTrj70OTpz8Sfa1DuHptGt3xqPV6hUC0vD4jpJIBs1KVDrHw1CpcR0spFl8CBaR6wQZSqPe3n1tT8ovCl/m1CMtrloxEbHxOpbftfa6eBkCv5Tlo+9N9ejIfEW9hxeP0D3vTD/TRHqNPQW/8Frv2g5mAr0Egu2QvyV1pwTtDJos7Ml0ZD4DuCEdujWJ3b4d6i7Y0exYbjjkj13ngr4Viw1KvxDXJnaLIlGoKAvREmpa2slKc4/+uP41ysmQjanbR8RB8Tg/F4I8asPggx+xl9DuX8p2sCncCIiZ6wiFimWqDDUoJvHF6mkZTrAw8TuQlsoBXho2UazUKCrjrMv2UEP8UI/Va4Y+iGYNezMCmNepVE0DFjfnUOVrNoLE8zpVbWuv+5FBJcjJzQWBInSaf9orz+Wt/5eDXtBwDn7HQkrlPgKnA5nXRw4wWzQ64mEXVpBGq4a0O2+pTMfKE6URGTzMA2PZg/s9GWXfGbl59DoDHIfFmSoh6Mjn/KxjKa7sS31i7NCihDKVSHEKB5kqYsGwXKKNomnXdCFnFxeu+NTwMh3Fu6bgSv/J2R0AwmEP/VFEgr/BUZIjRBbt2pclJfPWIq3IdjOBN/yM46o7LW0zsF3f5wWISXBksO4JomXofshj6WCtwDLxVZc3LbxM2ngWQ8Jn7wKHjDkHltVsuD2hVEgcqox1lLnIH2A1KudgGWurwfR5PliS95FYxSYdh4LcUsg1oLS7wmnye9aD1dZdffIX2LYooYymDjM/K5sz9nY4gRKMPVO4ZWoW5tBsoza1wC9rvLvCCxGaDIwN1TLNS+GMkzDkz0fJ5wpjQh12THNgyjAa7+miYUakqtNA/CqXCW2VTehVrtqvUdL8ODRWOuUwyhpW739rKWgSZEtKXtam6bfOuB37h4GoY65DcNjT+PqWKV9b5BqR8zd3bw+3QflYzL/c1vzOgBFidxLsNl7U75O9Cp1DvykQnVFTJyoSSsYHH3NQ5IxqPi8qh9+bhbc+W8t97q4e0N8dIhYvp2xWIMzhxdVXNatD2ORi3jYuG5d+Sa4CjG6gHbjhRpahAB41iiT4Y6e8gzZsFTxBl+aDoC4dshEJReOUGUWWeWJXBJ5QkppWzS8qtC0+eBla1fABeMiN8KPIisPGwH7ueZV5R9IOsShFwWkVFVp/nyxtLmPG14+pUIlCQFJEoKpwzLaePkeCxckhvqIs8lVjlqvFJqp9clu4grTKAVp9CAG++hwLeYYlDF0oX0OO1zvmY+ZKkhk9ShN4MJ+HtVo87FkJ6Y795bvks7QbZQnbL8wUk/76q/Lx4SDlXQTor0mLJT3PJL4vAXUTlJx3YQ+868IFq93cWjPgNIqvNP8c6qN13mUigGnnuO/Zheoc5WZzs8Or2XByLYXT5x3waffT6/2SoHu8VRySfGYrzyZjvm7vHQYIwhCt2Ji+pJqcijStahxyU2rEmTsFNIZHKufdLO+SfL2i0bo9FU3764xwxAkysZD76TEeEgsKMo1cnr7WsyJqS2XFSCzl/o1ONp1Hr4Oelp4gDrYh1kTBNbI66uwWsGt80flx22sLMjCo+j1lGRfqgySBADAa+xToEwPteS99xt9sWZPGhMYQQf6kfuAAPopp0jCkoZiwckNaOPHIGrm+nTWIHlnjw/mYJ26IcrOTY1Foa+eyMtQJkHjQaigY3hR1oKX5ztIwoMSuu43VXzXZ/rmhzW2VkE7CEA8/28seHF7nY6vNe3cqc7mtTy+27f2547tXoh2JNkbiE86q3JIxVzxJs6UoO949/Dh8akZK3zuwZfpdIeafU3myxu64Qpqf+rXDA2raiWUaJJxXOg+Cu/D4h/5/nYKqOkhYXOTyiUpGDFoIGj4tMhLnk++mUVEkrBWB9tG/JvSsZ6tz6hcx1OmCRdRuL51Tzkb4JqsQIWYiXD4P5ugWx9BLUoIEIwvTeQbFfZUL8wpJCh/fqbYNUNk5fEVji/inY6JMPkDBN1kStNsdRek3gPfvkZfLpvNumi5wDWHjkom4AHtpwvyDLDklgLmn53NrmGFXWpAAhSTU97kkItPyBgrn0p6t5evlw5JlWEFImZhUx3dgrOh1bzJPhTIt0ZmSUquleG2mifLPBk36uvb4loQbzzWeldBFXl3/sU1RUKbpKVZ8VQtj8h9poPftPBm2OxvSljARsnQZpTeSI0hm1u0wg2z+p4YX66rHSSwJyoAn3lueCuLaSVS5qaEd2YgLtbfRxe5NxV4vVO0cIzKPeaSuJBq111PG2QcHfG5JWjkUBoGftYUcyRDm4krNOiuWIIFnn1KJ0E9yWs6HGscPFMsnZ5V94OCQFAEPjGw1z3g6GP2zVmjnD6tFRiNooZYSIm93yn6PpFIWexgHPL6E2g10bK/9VMQry73pHBUN72fFI68bDfYJVIiZlDHxoSVyvvRfg4gWtZ98a9/tfQ=="
-		case 3766413383:
-			return "4H7oR+ghlBzyFHxo0X170yVYTj+hUDOC4Fevb+8dOPf3J5qC5cdwtxEFS5DYiJc4/cjp9WU5QH9lU8x3WUov03Qn5KjVeHYyDdqi+kxwcYagxtomME0fFAedfvUzpVOvzxf5gnj8PRlmwER5XqWvcQqzYHIlDvWy4v62YZOf38sATj1HxQ17nKaLbnVmLnTOm/ynSTPpvOWiEJeoz3EM1j9Bp6oz3bzE2hHjl3zrsb5UB6D//di6klFUbqgQr/nlNEQeO4/+5MH2G2b5Qkm84Va34zeCcfnUKs38SigYoxhDtk4KRneMXzMC9ONINWakiDPPv7pueDoA9s+mUrtzkxUGYrZs9ZtFyXS9EphZyqBz4N/g5S4wL6LahH7KBxfe/L5LQe2D+mV0dURsrMIPTbsmcxx0FPgt+dmdGojRf2xs4sMTENBFjLTjArl3Icul/XZAisJQRohNBsl6aAF8ojslv9EZhIMnJ5JvfYVp4dbLLW2qae9kVH19CFR0q7oA8HhuoK/pnugu0Ibk40ncVFBsfp6gSiH/8qlJ6kfgbcE30sFi3cGzU13bsKwnCutgVRBCnDHvu7Ht36pBhc3kx6wWQUBaiIQQK9mTs6qmATB99sQ1UY/oa0H9m65mDsvKVQUDg0TKSdd3Fp06QgO3dsNCHjC3tVzje7shzHdVKzp4Ww/HLiOl3pceYFamY+uUTpXFpZzSW840zXfqTQRwT5/VMjibl1BrLmKIkiYqLgHwhg88fEy9MzZcRwqPmoRLRAqp+KuriAAZ6gLFXLbu8ms4sMCIH6ujKDDYd5vaBoSF8bOsJGVUE45ZTAY7rs/60R2dYAxwVy3P/XDvtWkNYl7uqi29luUm+QyzXOOiAeuxJH1w5jaRluy/dPxXqTmfm3E92WbLDkL+RPOTdpGvbTWUDd8mDrHRrrmIxR14y5lYJIG9FXWs0LiRWmBzy7P8UYQ2FKkbzCDMV/KwkhBfKDEab5rt8MYPE2jjxLfcq2XEiZtlkk+27rkHgSXz5hjnQmK7951FyxZ35hRi5wa9nG6jbjryaZ+EuRy1CaRb4xeQ0rhGmNmAERVZrLnsZfL5qxFOC1ATRsjc/+MDYbUcvqTyX+o9l70j2uibLwkdV88luW31ebLqdhbocofckgaLcWlo5XgUUQvHwPSVBik/HLOvHjly8jrE6bG9oaqGme5O/0qcJDI/y9HiIQfsd4k/JweZ4QuA1JHvMai+4TnvnPoUUkKah+YF65ppblb3yQHoGjWJQhgDxdVKa4eSoZ3SAjP8Zt9QSYkZlIJvT/X22C5pwc74KHSZIytvOJGTmZ6Bw3NmDxcvzW1Pl7qgCKAqpaKMefj4F8tFMwD6Zwj1n1mfcidvJZ/U3QeBGLLaFrXxhNjdN7X3Tp2ljY/cSXxGPUyDFbtcIlyygUIrb3sp7K/IayVeqBIIo45swQpBgBljv0lGCcIx5JOTSEvCRtM79RDPyhmGBt0OFiZ5kXEq4+PDxqTwauY4wMAIzxbQ3dxFhfEujvcknYbNvbam4Ia0YJIjGPqJD4l9ENXHnFkXEurrcX+ZsTLYSydpW3FWLpA4plZWTKRsLmBhKmt/3CEOmc+B/pTeosqMTxPh0CxdTPZdYr67ycHrhIhx0Fb7syHSVrSrTRE1cdUPUnHB3+ija6JRNmGp6P0GZXGgVhhzHpZN4aTq704tZFKwxd2EayWp3sSgMnS7zjg5HET5GdQr/jN2YPmQyR1YMAJxnYUCXbmhCu5N79j1G6JQF8NQc866Ak+711loOut5KTIqe4+tP8CjKGSuVcGfL+5CqJJWrOXUqNHUU+sX3D3aLRZ5wRAlRwy8Ii53PBPtJRsGOPBTy7kh+dLu38qjGhKPWb+BLIREsYKR5ymLOnSPg8fAol5G/j+k6L7vKccyzYIVJLUFcVciKFx3an4/mI9tgVm+/DPJySe+WROekGCZasJYZ6OMZ3V/1mObh3DSkTeWgY89xW19KNUwdW9HEejiCD8l1wzYFgW1BaBAPSf4ud0uvOgz79BMAQpoiHFLg/45wxEKPa3pQlEotx1R/40z9GzsMSOTP5i9Pke7VwpR6GVdejp1BcbNoej5PhuDd5DRzgaiBvuESQq64QAlihSBmcXsbICv2u8jVOcTsxTp8nQSDhQVN2DDC+SZGMVhComdjUiTvbflszESt9gw/J+PKBdzeUXvaZbAtPqYk2py5QMAPsNBZi8Km/1A1pyIBBWPen8pPCjDmLc/oOpqb/iZGAZbfxp8Fv+20LtPwzaHezmEKHoBXyI226ea3mrZDKKnQtLxQB9SQlx6B0rN34MyaXb3mvIjlPCVXxdqjqAquXZyfTJ74202dWGRBpl2Bt8Xynl0VAds0nznxkN+LTbZ0R9rWngZAoJJLYduYUYHSaK2+Mt7+NY1I1ryYuQELSXtedmQ4xqEae5TT/yZxKzCSJGokqWTgDMNX/7O3tdA1Swr1FwpBEz+U3Y5Y+zm/lc+JyIb+sSiiShr14+1Xox9GonZGUspEgKHfs1KDYIgMobAoOrBk4aDaeV8nbdMW5+85t6aVcxYZ7vZ8Lih50PtOfaZPSoYUg0bR/SoyQgK2JAVKapmzzwEg/15jQvHDA8IIN8zKx3PMKdYVUbxd3o+ijbEIRlipsR8Bra9u9yRQlv1lrgJCELBokcmBGREPX6RbdZ2rIhwr++CtdeRfFfEnOLmtXT+bowevsPSMfpp4y5BfMbUj7cRa16IjE7i6b/wXr/Zghq9WLqK7zEk6w+sTAC6oxZblhqKCIcolxnDMUfP0BM6wh2T4Oh0t+Psa8Ylu6mupNCSWS5/9j6xWTUOjSupMupNTfdoRsjOvbWzEU/WMmvJeuYdpbZBtBDJtAg9zJvMSrVfqucvZpEOxlgsmL3jpPPouIKyOrNgGOUKRtcRnIXu1KWBqNK2bdGmhUp6ARJKBuYsuRNQR1pkvePojGkvXeMC8wKtyTIdfKsJXY04k+NBo/Cm0c1saprv5u3I+3OPukXOsxpQoU9YqpN+zo/pLkutiDv16KU8r8hUKs6b1VH+GPzrUnDzdG7dOAYhVQr3AnKDnYbxSW2kwEL1dfB7RFBZcnR1SySj8Ha4imXHt870ozyUFab4jopUNfiVnVoXTCyDy4NkAKnxDEZL/keKv7QZgpJe/Y5XtBWVodzUdh3laq+HWJXk3TkywGGTZylYPdgRt5NsKYUl4UuzxsIrYNV0ERm2xygayvUg2mkQLf5kbhxiv4urE4zn+vMidBxLUFv/I8L83HlDGC6tPdNWMNeNgx1cqKDyzMHyiWOXXgJudYTpf0+k7A4ITqyjx7oYgTdAFBFT0ygE5ONRNX+xpaq6/ARkR8f1K9ZEBtOji7VRnm0oj2ZMBzGsiPVyS07uGhIAgWsM+BsIWWQpyk5BTAOcCpAKz4rOXlYMIIdi+A+yTQit4hKxeJv90ME7wXl9WPmIlPUkkGV06SJ61VtrmGKWxPhbKz4M3hm5Nea9fM2fAm0jQM7Y594cvb891QuzRkfxsaI2b7jymYJyfaAav8dyvADnoARlTtFvTOO26mY3IHUCB8cSd52mIO15wedhaSEEF5oU4oHHNdUMDh27Vzr4NFgKa8h2Z0Tb/6sDnY2g0DLyheBUJteChvxFIkzU9vwp4kSI8OIj5eLtxsAm/gptdubPQ2Qp360SFE+5LoOG0MkZ1o+rO8SpjuCEPnTPB/cyWj2xiCFlYPJ+MgxkttiEFujc87qnJDOIV98F15Sv2+Yy2z2nowqXDhVPEA0MADvKGfrKiiAPyNbWFvJConteavO8M9kgBIXgJRLAbmRoDtnjIB++A4la7fJSU4Pn01e6tlWzckN+WFKdCBG/7bObEC5layQgMeRNFyNkbsjezJPW+fXwqSebMM0+MY1cAcNwsBUEUuhNqxq9Gq5Kd/pW13zvagKd/FRJQ9PJeaW2ZkrpZqjmv4CrHsU1y0hdNjXexzOlLeLA2bgcsyeqDb7q5UD2FW5dIhjnjvkwPdYsGbKrEDbMDpTE3xN01M3ItuvZdfFwjGt+Iya3lM0iddHo31oIaPHtHrvl5Lex0PI1wuI1g7Vrkia6Z6iBVRzU/F4YRMEEww+TgEkMXMlMbJ95f2G0Sa0kYJqm5t/JnLCD+tNmSagc1SicWJ2UFpe5NDrXeQWLMpdnfvImU6dQeUVrZeOSISqDc4EWjXaVftZ6QBBhm5ZYLbD43gtnp/jvMtxmT+L+s2uZ8sbvMHRpJMt/Yqxt2ow4rtiJWMKrOOb5IxYZEg+zWzqfqew9Jn6S9Plqq7s6HyhWWosqfP6cQYPQbXgMFDXgpfe06t0k8i/GxoAiyZIFWmIbCYAaBL6Q1vQMZW7RHA4BY1ZTHen51sIqiCLVu10hRm4iLDwlYJrsS1fWDDyhGVMpo9ZTVjvWoteM6V7oYOihwjHei2KHKudOcK0BawWjW54wUN4spzpJ2MX4T5Z34ctTmcLvzu8All4y4sBkbxB28h26RG2FrFkbQXbIWU2Vm8LNj/HwNC1cYyHEd8pD5bZbxyuP9GFg2OGaViqNjb2QkS3MIgw5zqwvgerfP+hBjkB+CTUzVkYkygD69hhvacH1h/F/j+h0uieAs/oqUSVj/+4kjjQKZXACYaPM6bigHQ+gZWDtXb5HwuZmgPWLYOiCn9wlNEUzLbgCSxocbc0Xuwhd4Mw4c7aKy9IINuRAx+YyAmfpoUcbxKqcyMY25lCM0xS9IyT54VNuVtcbUC+amEf9/lsu6clWRPISuxc0T+Rf4mU7dIAJRAsn6tSJGY81+q7dNXisvFIh36LTzD088B0RH/RNlngRO8F6N0+fQwG+LSNsMM22rRDMZOHWimP9OnEBsQI2sEWDEeZ+vfU04LTNeM0LZlR3tary54fbV1Q5qYyeyurEpL8Prk/jlyOhGf9glnPhy4ZCPPEjeIETl8+mnlDmM0Rpnfajxq+KX8NdtzPEDIoKvVk3TGpWPuXjqw+JYVwp4jk9nnEhBz3vjFgrHMeE8tZx1/HEMYHkbGiBMGGwsCew+t1xQRK0tAREVEfgXpFP4FnARA6blKmk6kG33AICtGnd/YpOAdFlfRbHI4B9oSR8Vmr2Nc5ymjE9pBGKozDoABKa63Pw7QnoRuxbZ/IzNyV4I0CVGIJAJ7/HQjIYhZoUBCukBAJe/d6RuT7Zo2FuXQGIRYxGYQMdOphCEizxkjuMNl1yPaD56c2KZN7nVCQWlo2Z7lnhsZ3Pc1ErsBcplb9SI2PEA9vDD3yHzviios7vANUyL7qgU08zvEMn3Ikw9XKrs1asnCqUsxllwXUWT5BriJfs/Zp0KOvHIHqV+i+VtgUlUscpMcq4bIJTovSlkMJqRYVuG2/P2h/AbNGtLrGnD7SaM1/oUbBr+LFPk1oC108LXz7tkq9WoF8Y8psVECmDmA70mZZmpyClpb4CwoY0SpnR0wbZJaPLzi8YYQ=="
-		case 149405464:
-			return "COe/GPqbNvoF2nwuzAmE3zWyPDcRKqbWd9WJZXlFvJsUmOSFvfMz8uYEuAXY7fxbYLRVDFaKgEyGQCNi7XeOmn4ykj/ANKps08FwoFwyBGORyI79YSCj+f6BXfi8swlcsG/Q6OmHPVJXy2YsBFzrtFDk05jabbK0/zJMkcdk945CRJulWD8q7fIxjy0xG49sco8UB8TiSsp/HrpFIOjd9Xdngi9v2SDIlJ3ll0ByfFkWWmvDjeZBe6t5fOkW4uh2ciE3yphhxOqFKFkVxmPLqje+cchKab/qA4uhsnlJNTuJv7gEOlQznrkkg+squXh5UuKa6EGcj1Z6noeM0AgYyP3QSBQK7qu011NeRB1fSh5g9O8oyJCKWjP7bWgaQIgZMB/e0SJA1Ze34+S+GKgj1R0blMtATSOT1suUj2241kFS4ADXyj6BNsmq4I6UlfFlAY4J0t8+dQhxOjUTTVRJUijKZZq90PzbcTS4dnNgvmWyNmHz/kbqoB2aC/LOL+XVsYUqK2gaPLVu3vmA+Bs2vXL1fgs5d2X29ljAwjFyoS/M8HB6OaTj9DbuDVTc7T4wIdzwdo4Fkw2gzegpp0tLJ5+M9SviOCgkGI3KgNFcXFBbljDFwlK9NEqkZkiuhkKtMCd+VMBn5hVimNIbjrDU7r4k4otcNT6EsruPXyHCTYb7UZQ9D7A+nbrDwYGRLZX623mnu1JS4GDHyiTcCR8nFs+tQ2GN6Ll3i43OJssqT0390/q/YPuqW6T6QmlFnlAuDGQEm1MTDpeK3EhVZ5ej0qyyCELnc5UXDJjzUl37TueRgHB/9QnFdrfPPMno7LapERLDXgy2XvuTVHGRCyQRp5ZuCxaU6i10yBh2fcTrmbM/OPjNORWNukFKQffqV6Nn5KsTqYLtt/WcDb6mLqCrYddK8+9rmdaRxlsNn9xcO0RkjNhFz5z04EGRTPc/hDPUxHJR3YZm8KORpJJPdZ6k4WGJSJ5ToLZ01pliOFaq05hewRyJ1ndbj3mUWvZdRGEEHAl6qwQ/bERsMhe+xJCkCcuEm7klZJo3msUFvTgrWjiQa3q25Bf+dXaaBL8gg3+y6L3YrYj4oG02bFtR73Kk4ed8m9DiaqRW8x6PtCHhl+jQAJItNvJNeHDUd6M7u/+xaHW9A6UmW3E1ai05F6XQbd+sJoX/pUkb1tHRYDa9UU2sbw8V1wmcsnpyUivXruzKFKJIFvX67JiHEpfzLigTobKCsMQzJgQ+zOEncfMuc2AxSjr7SPBCrs9oFgUF1m7cYwLpYJ8rbENOQy+ZX9FP54nMAbGaEgJDrnpt7bXjV4imSzjWsGg1zWCylbVeAd1wBWzBbVpLa5LyWy5sBPw8UjoHCvrYv43Zip+i8zgw9wxN6PikoeCUtNjBsL+/y9ARyZin0zhL8gN+qXC9mvF2niqBKEhOmSlCqdil2XsuDTT4iE1I2mvoglDJcY1cMFe5aWNNqugRgFqC17+dhVzWUhQmQxrFURQ/W+fcp98/uj2NjBrjpi3JIixmJ5HKPA5bTs19q7ypYmoCZvFDMvAKiPnwMsxEkP9Y2Ds0eI+xA715TJzfuoVpI+iUaBRgruAQUX77Bt8tSahvBj8Jor8HQR4laWVcBGMHObwa9fDBihLMoUTPy2wGKUc/t+RoIBjaNW8T0rSNKUCJOMtsi6oW94z/kADv9U0tVReAYfxW29Y5halHba1v/pUbUL1+E8vGv3TZCb/hQzhO4dq58qBcRsps2RsqycyUA66wSep92QuHaaUMMs4EmTu4cYCtY+wn6iMO0aUitl+GPBtQd9sInYosZ2TieUWJJaukU3g6uFEi7o6KOCm9DN6eaKS3soHUovWW3Y80KLX9ZBbyWRJ+lzbDQ8aqASeEb3XD8rdvivzWv0PgKy84thdIYE3rdIOYYm6dlNzD3dgV3En6ANvn/NqNs75ekT6eInZYBWqaWds3yfYxcJuwblqYh3QZG7+O9SYsWzOd2rwYcZ5R00J6tpELBAcOp7ZAcKnyTM/XAc+A+uMc/NFcKrhv+IufeUwGUqZum+7fLgEEpGOPhMaqrTbZy62z0gj9U9725fx0lanCF9gqbdK6/lY0lEOvHs047Pu8oUJtT8X/RkW2oCytc8KOXLNIjvYI9uzZmrsIiIipCImO+WIE0YI098zu6yn2mFQtl1iQByPBlfraKFTNsX8xNVbhLevYUhP0rrwhE+U82EsQyZB4ynnwMiwWt3RHU4Rl68kdkNCxsru2hwzUBtELW1hLZ8h2iV43tVNEzFzTmGJQdRIPmbd4wEE4mdl5B739z3Upr5Yx8iAhOddilvIipM2GYYxPOk0KDei/4mUK27997vH1GRB7ejUDPelXE3RIiEkP6u2a1QzgzfPbS2Ww0EvWkOmEIyEVTj3zeOOP8oPuqYT9gqctz21gCF1Q2SjpUvq5tMqIA7tjyK961wv+v+i82UVWtM3S76T2mpNB1T9X/Mbn1inyPEHP1lSXDsf7goncab/X8QoCTp8Ya5o8zOECIZ26Hw+pa4lmseG+hFQQiZ8nNI5A1iTLJoRK0EBkwivjLVc1s++8x0i0XVnYd96Vy7SQFdXQBOggqp4nStYE76Qa+9rs24QVK8KqxUZ0VpCoYixNwYG11LFKpX46oZm5nnlUbxGoDSkXNtPiN5JHk0w4zNEhKOBgx4viSuMaMYL2lvSMxCQTUELWnXnaEdHt9B53DQGA6uYa3J3V7Hf/kNjSm8/Yvlx7P5qmbuy5SUuobDWgl3gJl28gS2VX43KXcAsHi9BQV/a2hsrAgl4foT1gaMW8J6PBdBCIGerVeDUIBzZy5+vf6LiWOA1MNlQOOG4uRrt2LOWlSY8GQVtPP0HloGMcGqdSQFxnw/AiSorsLvB2CS6t33K65FSiMtinAgeZjvYdBKCeOVTmjHvdwQSoVEi6slil1QtlDFOXdi28Z457izPALgy/ZbjdAia0Tjou0r7NXhkUZLtsEnKgIR3aZB1zfqtquvWLRHKEDXj+lx7k4sfIZDhCXPv0VEj9AgH+nLWFSL6xcAt5nqto++8xa1+BZJGwXpaY4RVuTltJYQXFYI9WSX6n5KThP0+7ED/aFVS6Mpb6UuWUKTyHsuzJZuxZJdW6SKBWzvUclqPG+I4z7yRabRD5wz5qLuR+gJ74xC6fxDUrGRmkglD/O9UVYNlpDKkuhXHpvrDuCBAsFQf3vbkZH/3DJ1lmu9Job/1nGIXJWyZIBqzx6TP2d90uQuTnF32Jze7Tq4ZYxyvvfzpsk/STSNbhgJZtSirZu2b2r6PZdSkrl6U2bLYaB/LuPzL5w5nKwzv2jyVmt2rl6X1IIvM8Mb23Fl8tetzdOiaq2qrPPUBOK+clxilFTvIqwWzTvgdxFGNLd1PcN5kDyZCFrg60XrvmPjgGMBI4H7zmR6MOsC8EcaSr/9hzo/d7tuN9budxX2xDCMEDGzxeN3rKK0frSkgu4g9wVDtG9t6cVsJuENQ06TvLmrLMEyZQp2C3hx7eJ96mHFLDevVshElpIOlk/fDLxB7liHie1+nlMAGK30nqDqIsXhmBA94k3/tG99ErotTCwPFgEJstqhucgF9/nLg/XdhF+z8FbMJin7qQoplukR5LzLBHDFLtRg9pET/6x/wf71ETcKYcb7gFbgjWVtMII7JfbGaS5PbVcSyJxeTdGQWURdIdgsR5b8GqELmYniwZXSx73GHhUxBQY2mzCdSxzeLaQJnvXTZWqpWnf3Cc5frsuWJm7/M8SX2mUSK7DvYdRLbg3NG5bkBolkkcXgWRj4HZ7hRJjF+2mVi5YTVF4huJSzvrGnQ9pYen6cFbx5X6q6JJyVs1RoSiVbJi0VrhRp688jBftGBHBue0J9y/0Fkj60y/onDe0/x/lFVKibBjmlXOm2WT5KRUCeIhIooKBKA6SPkzXrnXSz/T2MisMyGbV+tb3pXt4Y6BbROu5O+bNTVLCsvbvyf9ofqYI8RupkEIZ7T04PatbOgl8p3rox9qQXVQdhnS9Nor3AlHpXzpslr91dqof1vEH/IvwpqLt4qlnc4OH2nvdoriLzZppDnwIuu7eKP90bUvRXc1VgYLGqtmJkUBGyqqCOrIdu92gO/usQM37PJdg9QBh0+6ktQxQuIr88CI5ecDg6ojKBanoAl3sFlFib7YmxOq9dP1wgmDw+x/8tBKf3TpVbeQSYJBdpZEhhSEQzSTn744RpejwJV0vGObjbVAD/xtdF/aJRVI9jwSXMD3sT1tomQ9exknfsptjva3EgScyb5FT7y3qoFRpbO28OpzT1bIs81gVWBFmRr9ZpxtYepImPIznSdcfjD9fi8oICYAZMNnLaesBgKYY8Uz5h504SE//ZZWlDIfMNw1/LXayPPyzaHTGT2ezl0yl53HYNH2SKPj5DR6BIg7QDHu5IENy2xLSTm1bWkXrflME64MNXIaCI8z4/M+7tvGs9dhPBRygOefXPGW7I90IVKcSe4KWWtUoh0EinfdzotXWBlqOogrYoneQiQhLOvyScrOXB9iWhWCxvR3NAYiRdBL3nNkdLLtprtCngLaEyKQBgHJUb90xk2rKzo10iea0t/4fl7lQZd6IpsJfzo0TohtcbxOUsQ2mhI70IkpkkUBCjIkUKH8pbMkeGiT6Omy15tglb3m8RoMQr18QC3sr0kE7KmBjxX66j2Xw7MVdjH5YDwwHUZWjbOeQSDhiObMy4BwGlOke8hmfcrIEUoQRIMDxpa94tw5Z+QGskrHFs4UuBwzb/MpD1BpzB1Oweqh8M/A+h4K5QecrDyiy4kaftZ/MSATT2cuRPxyc5XF0hDUL14eJ/I+9JlcuW+h3ElZ0e4Ab3n7x6NhX87GS5Oj07qhOSWjR3FFSnhjIU+l8cAn4cEA6bPS9QjyjJ+mdeIwpl3CFX+BlZ0w3TBjRK5a9+XZGwGj3xvRWWRPSQfgypUhsssRK27ymXPXf53+mMo13nb9FcMLRuZSvw4iAb7FcxjyaKo5P2H76gcQdnAZ23RoJPvofrP4bQJK/ZPAJ8h9wGgO0AJzTmfxMB5q/g1vpsw0skjAR6feFUpjVNpk0NU3zlGJyKQbhjR/eXP0cQZEtd2t7/PAwDVT4DGlxmAUO7edlwZL5CDKMBl3iFm8AdjFJSQT/QxiclGxAyGYBbel54dUBhnbAOFG8xmXteItWaJjGH9wlVQ4KkNmM9voh5brdpqvntTeTGs70HXb5kmDXpkHy51z0Jz9GJyYBB9kQsh/vJkJV+H7Yb7vdAwBXQgyDEOwmEVIY/SkFV0Kag4xlC4h+9LInzz8eNKPQGInzhWhtr8VzHfYUKB57CtWI4L5md1OsOIbkPlzCTqDYp2FlpOG0rCYCrygjLRiDEaUtkltfp2DksH7rZLu+M3FnZ+9tI/lYjJHXnytyiqTzBKzqZDIUDRQjupbQozFkNruG5IEANt/THl712Q/kA=="
-		case 3152975373:
-			return "u+6WDUzFXyCG60ylFNqvZwvJqFCSTd9RdEG9ZbgiLFecv/wx0eRI2mm1kKYgASSxZxHC51MI+gJtSkL5h89/ozrAHHBA2PPWOweL3sKJvuO2t9Bx5mqvSH6VWMELERQakEllGq9fMNG3ZxcXVaAtd/trGpzKCn9+cqu5TwV3inFOpAJgSe1+hEci4DYoVwrtOwV8WX3AimN5bbismPO9/jZRFd8i++l4imOnQ2CO6+LP8UJHGO93DfL5tytU//1y2SAO4DULfMmZQUsCcCmHT+9cAD8x2NSFR/BgWIQ2IUxECkynZGiTkqHs18qVO2D4O+DxKges7sGB9k6y3y83iQoWkaFJpa9fBpUyNjTQ4Lf+7kD/nmSOI7HZJ8bdN82V8jSsPLlYXex2cw8cyci9FVj/ePop6d36cE2lK1Dv0jGdEVnGImXWQjlpCoEC7dHxfYoz3f8e4q4cKoI9U7iuPe2abui97J0gaUozSAodHEpu5UtLziHGrNy+SVyqmlwvwyR6E/lSiEhyqt1EX7ZS8VpVi+P5Jc48wpppVl1JkM5s+ozDQOO7rxHcTZCYqiKFofo0c0Likj4giOiwwR2QWSCvVRZkeGGEg3xfUIEhjRlpF9wUVPxq+txaXQeyzgRfN8r2ALxuPJ6Tlh1msGV0XB9uSLIvWldFt37KB4t7dU3hZHCjwSS5h8Q3OutHF3eyaSckGzGT2BlQq9BGOYxPld99RsEFxsSh/deZvGPTqR1fRlvVNvIn0fpSwwd/8lo1Zxj+CacPk+kNsJvPVPR5i8uBNSspMcNQSsYN2ixz6CzfjycBJfVn0rHh9anNQviJnbhT2VjIYlH2N+tuTDza1fDj1uADJIYnIds2jCxOF1SxL3X+pnJzLkYURgs4UHV/EyfS6fHpu+tCxj7JKCBEI+mDL2tKiLLMwV8PaaF4SKdWwngZz2Q7I0wS3ZJZh7bhhK5ThSjwvhfxTEvKnpwwj4QGInl2plN3fZTUJbzxeTCRHT8cZh1o5zFzCby1tJ/5WX5u3VW7SVKvUcLcrc20rji2vQ2WYBrrHRVCqI6v4QxzpRfN02UcjjbV283bmNMdkNpD7la8YElFH3md9noEEjtW9iroe/rs7M/EcsoVmXQRhuZpTc+G1oeIF9F9V6xcvmsRhwPz5/4USMhMe2A//pMzvDaGtfjwHfzC5FMr1uVTpCTyJvKvF/5qcjWIDGE/fEf4mL8o8wuf9NtBoqwzdfziuJ+jj1hrNO6UL19gtKqltL7ekZwhrWCYePLQNt4V72X7aeizHXVD2wnmcYP0uLp/f/C0Y/959OEU+dSqLFBKcVisdnIip8WuZiDN3G1eqMj+r17iVO8lZWYFtym8Ttu3g2l3Em0/ho/8DFC9DgcT0AVoJ8W098S5kLKY9r6VRZ5I7F6MAdneKkobN1DnAgHezxuV605zrps+B7nWVzL9Kg68PaVhnJie1c9R4BVfD4BbTsEm2vO4S/JTNAfhjmIztcVs8SUgWfdiXHpZgGeTjf7oQ2pcq0FSJALBV5xTl6ZvbLI+Z+aQ0dWMcB7jshD+rv+Pf5VgtKf/aSjmRFpRW2jf6qsuTqCtK51yQN31PxuFdXVxbxXxg32sE9D41vZuW6CJfQZKby8XhitTmZ1/HwcQp7O17kf8Wxmwy56JPTnkjO70ZErIKPLlBSd3BVZvfns+FGBVzsd1QoANvIA6mEwHgulrMp6zLr8kVOO5YPYXlIspEYWcTCvXvV6UmuBZDWbs3eUa+gwGM0a7ptLE3mk/q7gykh/2ddYnA8Vb9cxltPig/ng9+mA03GXVySspm8htsdvmDWxrcU1WPFSl/nsYuVjEqaARn2eeuQUd+mkqeOhutxxrdLzTW9Wv+wyY0AuPkkXHyWWoTkSR11cWUESSsYcxRWf4BdX1FHl2jOrNZAWDrhRyZDLB0Zeqnb4DLMjwTyhGt5q+l1zBXZsp9+hdiLViY6le9/s1ABEo9vpXr85ctkqrS8KwVzJFtJz4qkSsSz0tLyMdRnlTxcjgCARo4hFQJkfBKKJMSVEdK+AsU3HUTnU1iWP9jx+xnfTLaFIJp9bxFJiQ0ZzUVE6vm8gQXffXDh2Twx5CnMk7H2lr3LgFEzgZ+g2uUANK6iGZtHEG93FrpXXA8qiccv9bRyvMrui64pdmTudPEFUL1VY+fsjvuTo9h6OuOyB2W2FlRmj22oXdEcl6KUFW/3X41rSVW87kt3XsEbodbNcpPlW7K/c6IHX7mwEvsOisFphL3jE3fBck3MOBH9fdPuZBKoWOXIHV0jsYM84hVkT9TFQdmabaOh5M+qW5K8KlUAxsaZ2QB0cnUiKEHgvSQvbSosZirmEUJqSB9KjcBYpEpDJSWuu2PD0hEoAjybVoqpCOdmDpYvkhYxxRvmdi+x4XpDAWZr781UQ7mNNx6sUYy4FlXbe5JCnHzsbLb6V+Ek5dQIBdxJds0/uYhj+xL3MtWVIhsnPvu5lYz/Hgq6SmdUDJPVXhtKD89IOUZdD7x/6bffR5/eaZadlwpfVdVGQDTVnmFrLx7/ejFMHI3OedMrJTtiyArJSIJRFK7E+IexxqZSFk3FfCUh0tETUcjxSeqoV+gmoB58hq6kcHBFoleyqCKV49df00Jw6M8cjgN2K/PeJHLyyvpydM98pTA/HbF/GNpgrOS4JemOqcGa9TAgZou1c7V+6rbaLyM+H7OCdDxoa2zfefEJBFalPIsvezibe6jKgZ0c/ZqD3VXjp9kKMy+I5SOGLssutXFJ1p2d1ZnFsKXm9oDrLAFU+T87pBHXccQNtAsrYqm8FSzVUpBXlyYac20vaCpSjX+ULSUJmc+9Yj48pC9/J6mXOQB8VXhVLhfaoi0oJcx8Vg/1x4Y3oiKEa4dTtwrWk2rwwrDHN8G/maJ0qCqiVXoyV6BhTor+RECjaJUhcXtHLIbTLRnTicO67YH4+bblNPaSFg4T7bzHxlAHFL1scMBZcNiwo2XQrOp9uxx6wrYi43plUBVp8rDeoefUyWU4slPUTZLGKqv2cH2vec7tJptS3AcDk9z4MJHt/Jx7C0CigMHamb7Q6FKfup6Oqka+r0uZ2nq8M1jELk5pvYiaumYgedL1KLAtcynFL6nghYGXW/Vi9xc+DGpwVlysyjupiZ4F4z0KEMZpSYNeta+CK3qrmKscese+jtGtIa8+J5LquI48qUUH54BFGfu98/lETcx1OKv2jvQ3E8Ua1dLPIdE5rjUmXq9N03XMmIRUf49zgZcj9mwujcwYOtvldSSk6v8Qb8qXuESgunoIWCrdWGMJA/H60R/+tV+iiycXmO+bsRy5dXClXcLdZi1YPHIx8MuORSuQo3XH+oNk5fa2H54zxY0IFGeS8X+lyJo9L9DMlTDIWpXZnc7IgKhA/nPZ8/dsqGJsYLbm2dI4by2HL/SIhBu/yUde7ioyc1tiis43WkgijWzcMZjSbNqf7YnnTvRbT3oJul50SU7MbylSficGFfTmbTm8MxbjsPnAj3kj9gz79ELdjXDMVZMEi7gh6/j9BCtyAZ+B5L+BBsDJaYb01tqtThzjT7cUftUIbAL3cMze+ezQpuUwEWaml4N3usYUr0khzg4y6lj4rv4M703qIQrTzFMIs+2e7Ec+41xtRdu3WUGbiWWnWxNr/nMqtKt8uRfuY1je/OsMCZKK9CKttzWnBgkX2PdMvSSOs8BK+f7Xt867D4MNTZ0dXj7kwTPGh+WB6saquyX2RtGjv/hZYA+gVl1VAi9BD99wHRgEt4oClfeaBEkff1bHTu3t8NnC5CcfCOu4Ftkwx9PIvmu7TIOhtD9I2igMwEF6u5jym1mBTKml8yxkMHECEO2M6QOySfTtFXb3N+qyY4XItahosOF9vlPnjzyzbepQSUUfKhmIcnyC1ljTu8b27fnzw5HHVEV51aqgeAFXYhzbgSga68KNky36mY6kOobS2e5tbxyyX8wlJBuGEVTdvV2EhyvvGIzAI7rHitYJt8efLOLyVlXWJSePvLrJsUNTGvcyZVLWX8tfKnI119IJI7FvL6urizDGEfm7/HsEDTtH93BvUVUPJ1h5Zll8uKen6fYcbHC3PLmXAYeg5hV++5G9EJFuFCyp3vMSAsjl6e9Q6BnDEQAKAqutxYJ8zuHh7SQPIjkENiJG06plbp1ePjD4N/ya6D49azT5fE+KVOk5tVcEqRO6Osq7U0MUTPJd4s4DChQ6vo6DjO8Nxb8Wzp2tjZukBDQfx/TQVegCoZ03ia57SaGIv7KRJEduHL81Abxhapoey7KXY8Hb/zWDZcvZGhNFxgS0fVG+DSQjFtYqWywUXOtYYCw4GVhNePNFHy3LudRPPgymapYyTdlojixSnwM+ahnEMUOMW6HOYop/7O19Egdeh7n4KjPl8EDnQGR6QvGB2137UUZsPr+UvAA+N7Ix+1o2eJV1Xk5+PqxkCIjwlGSO1BR9srY/pSOABphCh0zHHS5Wbi/gFZgEGH3XRwkpJmgqC+83LQTNB8fKWPipRygWJ9usk8NmKZC13dpHqGflvfG4z3wnQk4MSbYHTt5R5jpxFWqWAW9BLyAM2lnjphbyvcEfoyGXSGCGkdn8j4C7SwLONxL4yfuTSIcMa2jgpFSY8ePx1urtZGXZKSggqenoNmMgXqH79S/J8eitCFkFpYTORI8uqGDQxrCbilHFnDtUdXKNJ2YDmMRJMk7m+aClzLwqZMhvBptJcoE5Sc4NYxWhxGu4pXzjyCTrop818EmZMM/NEARLIcVJ+ciPaldn5v5BhQqwe1Jy/2wLhJHN+S0W5Mtsg7mA83EaHLIp2uAKGBRsg5OOPeSltq+BaBqA+L3QJS3QrX8bjR6EvgCmYowcOOB0rBXnM80Tgua9Ie7PwcyZK4h1iALqaQ3tEYuErqQJgmM5nc0mgOif9v7lsEegdmVrYIix/ZYI1aN6HzfkFYcH37GW0m8pYqjr7d+7PlVvEFLeDUDQ8X9uiW+OtnL+Uzgy+qL9hXSg11VgFWIevK+qaA0NZ36dmzi3IoFK51tDwkIChIQpY6JS36vkhJVw8MaCkVCNySRxQ25Q4ZYMj2zkLyVViHiUfmDIfuUMs19DOc/kqBtns+3o8SWZB9kQrVa4m9YB5Rd46sSemK9GNgbQj6TSMaNxHkWrTgflJKXF9k1Ac7mOhz++LljKl7hyffgdscQQESKWM6YlCK+0biD7S9KtAt4fcPfPB4ZAHmDNz15RvIgbv/Z4A6LkIX0elLMVBWQcWU/F2gLpme71J5aBEhJvM93727cskVxIiIFjrkwW/1pSnPIrN/j6lBgtNuiKMxpbCcFkOIyRDkffpnVBAjSyx1jKzUVNfl/gP91Jbv/j6OoDYDX6HhOqU39x5dtCY2owOmC2uF75ghKPfPYSOYrNCUoXBiF7iqUsdvsCJXP6bdGNHA929yv7YNSHV5zaPtTQUgB7CJmn7ORQ=="
-		case 3755819980:
-			return "391DzMQXxWjfNsj4uFOyWX8Qu9JtgyUZTCOfUD+Cawdjh4xACadp0g9HvqP99wrLI5/hGcAYcw3XbAPfIG9cUoPSnmA80ztpWryiv8FhzQAwFiLXxkOdloT8QR+OblzJXmM2w/QDpr5hIE2bu8ImZptoNoikUQi4w7RfFJZBH+JzquiejZFbE8vZBa4yer9vCzN/ARPV3TUa8zzIipO9/Ip9Vj5kxW9PU3xonxFezWGBvNGwzbldf4uArTzmMXhOMZJtOEYR9wPnSzvLzmpLBMOkHlUaEDYuP/DkQAbw8zWcNwT6jpNbTwG9c4nVrHM8lpxJf3eT04YqSIyTndRAFGwxu4DvGQm1QGU79kymAoU+bgq9qLWNQMtWN1nhaqPdiQHEQTkmhU4xtgbVa3UqCl8sWb3l5CHvAsUaGEkXOOkthKbtVUMZKFzTXXmyBg10wtXJBC66u9GFYayNR/2d4rSiTqaC0zwp3wphvafk3qR/hixdCg35a0OCh95H1V/NThl0XUc5otRiapEMuBYNkMZ9R54h24HGpkWtCtSpLb8sfur5nGGSrEb7lR2EqGfPkZjclySS5aJL0LvI/8GxpV8atwfM0tWy7AtqPVsiz7yEiTRASk7IXxf0VnHBOuyUEXyme16SU8zVQpxzjnksi6akVXNoJfthPteYni1mlmziG2M9HESLM5e9ujCjKAAvhieTnVgc7aTzhIM3JWdhXoUhyLbQHs7LPxN+Dvgk4ReQD77z2i4SD93AA0TRWKeV5gPWJuNd5cVsnhg0/8A4DUamuK8V8TaEh26Eq3QBM6FxI+z4YNSMGDZyXMUwFmmjMOyXws9BproEP5mNA0H0gBvpUwTbkBwP0F0gk+fleD2vbhZZJMK4E3Y5nn2eckpqqZS4AznYlI+g4PPNAn5i2Rdn/aCo7sJtZrKQ4wW2OZGtEY9sd4MCj2ynU9CMRwFETDHJt3zfIa0T+ylhjSYaqYtoZRM3TAOvlCIhV65ZYsma3+HNeUvj8KB2Fypivp/UusNUoJuQisE057hUJxSVKGez1g3eALtZZINSZI1JwYgCQYYI5RntWIAN4vR0UavOwPEfPXZ2lXUqJ2W7CCN4EOBel6dcFFPCV8ub+wmLKPUE28iVv+Sd/pL5AEZsL96+FIW5gQXud8d9Uc832UK8nUncotfkcfXWtupl/slrbatPlNjWvfPMF/63zK2JiQNfhiYcz5t5tGG6l4wkU4EvSU6M7dgvgufbsX7LhnIru/1V8YBHtGPUHgLO9Een1q4W3+Jbavb5CRY5JUxlZysKdm2eqAl9+mv0empcO3q1c66lJg9qxf7WQbCNq9jK5csE4BXGmXA3hpMdLjVM64tDO2ProtLawrjw8my2GanhsHjUezWl6eetnBvBlaYlDBx1Yt6WNNnNGmAkO/wyd5Ta8lVU722Tn4Pcx3pRA6XRZ4BYwTK3I7aSjBEjzOxdOPFFgJC84gjs5dC+pyEWovUVdHWf3N+YMRehUdYH+4ykyr/nA7NquJ7WVWV30mdaTGMSka7OULkFRZW3Jx/nR5QqWzF36XO4NgZFYrqC6oo8xDFTg3wAZMS1d26bVK4J9DffcKmRLA0bEUNgd6Sku8hmiP/j+cQ0KhRe+La2BR+mn9DhtmtYYSGdAPJDN47bHXw5ayJ2f4LX47glTmZhOl5GnrTl5znBeygYt37bbktWqe4ZSPDBcrCqCL/nGh2hRox/bgohwTN2aeqiNulQZLDYhfP3rGaTF+Qqe33pNSvyp0fpMlfs8DYlB3Lq+OMqPdLR3o6IhOzsL2fo8SJWFNU2GvpQYYU/Qdoc9IyHxEMnzDu4EMNdx6F9/oS/+JYQhWD6oweBBeAE2LOAwSx/Kf31RPOsG4diNMB6WwHZfxmbh95P56F1SwnukJV9iZ7L/XZg70tsmSwPD4Fap7lcsXw0psbdCqh7q5kSlXsdUDt7Jh9+HwdVor5BZWtwWLuViHGvGkAJtPAktO3yriHatbEYZmgBs/mw65Jtyg3ylIrvPwjnNEbSrYXENAslVlWduEMbIBjVUjKzSvdOyQd+Rsb762ZD4gWzhMKKL5p5S+zpG+LvjB+EsHTNfqi4X2cE1axSGZbUtHWhHVhIgdbVCLT9ybXy51yPpi1UHT+DVGdvxAWBvtU1F6tEMTP3BTvgRRX/EGGKciDOixaqoSvAP+To64TLWcAQZvWVq3QJc72miL4ol/Pq86t5hfs8NrO7vQ+DKYxJUFqIQWBKd4FnmpQVyy6r701ntjg+97SaKmAWmaiv8X2OkqZTdz6R0jLsEPY/s8nRSSsL73cdZ4Pr/aTgw6AqjJNIrLvo0MLsCAH9c6PpJ8zkkUDvX0Gdpwl1FcUUfytWzKzFRp7ikZLLRzho6pbzICLIX0m48r54D3QfGq4T23l+7K5NbwnLwE1N23byQs6FcZ5GWWrf1U+Xy4qra9++gdUqvVK5KaB2kLDRc/k44KhQEWMNZuU+YfittqEQ0+sEQ/edRE17gJm2ewSgj4uUbnHG0q5VTKEdf2i7JDJRmZ5RiPLnJ+42oRGcmPKU5xiuV9bgWGRpYlUDGKv82wT9T30lU5FN/ViD3VsS+QaIcNwJYQRqrOlhyODK3CWcBCVPRR4SMspyIoGWkVMp1++zO2n1FBk1suAdJBwHOyG8bOoyTt9tSRSXlrSb505Jk9FkneGz/NmwBTUIWuN9IcOY2Bpsq352wgh7CZ8ZAgmSzM8NvwoE/C8fd/FKO7f9l0e7GgU+94CoVk/flwWXe2uyquGnBuXirMT/Pw7UKdXigLov2uPu4+MnxBGKficvG71Hcxu9S/K1WrRqyj9SD7levKqY738Ss5kyQFO9fr6/xgMLMi4M6ng7TMdNK+wwD+Vos0LTYKpGCrvrb0iW2ByvV0E32WoD+8ILEdwf9vUbWjD1w3xYdDtYphimDMX+r56rboerPNyOYtJftdjUhOVLNVmti8REldYQ83KcPJz4ejhg2zszRynMqAqqbzG6QvnNnbBmxZkHlL4EBoRoGKWAmnILli3zz08B3XmAEZwGx0uZR72/lGLFdGhCmtpmywKe52/Rgh4lfv75VDiKH7JKD+RJKsVB2KIumC7FTGr9yS3bPakFAO7++E4Ozr9UhnEaayMlSgIh7Ud7E9lJw/y6UuZbiqqiflqCfOhA0aTSqtnuoWEVt6EmorTf/PjSQO34qOnt/B3n5nsl/OWKdNlBvIO//qFuyOSz9P10njvF469gEKMgqhzrYFqGQieHomk8D1lFIfrFOeIX0jp0m2QJG4HF2L6c5I2Qz3m9Xgz4D5w4WahfHCI4MAXfg+/PEsaRRqYwkfVK13F2yAdAX7FxNSJ9uQoMhuxh1uxa5V0Xc/+SslIbSZlF8jE6mn8YVJoNIRG/MLa2axR2a+/t5yGejb7UIZu9vsFcAsPVY8gTOvFVVzeXQyQ18RMFTrxuTqXfopJrDy1Yg2QHSjRSkjNj86uGEL3Sv1tsIVsykBc01sN2HuNiF4sL4I3uVP9hV/e+CEOq/Fs+vksZSoWx9wqKDBmykXu5nzlIyKbn6iDFjcA/XjBPriST0CbKiaQHhnrJ5Ylg2FkaRbYdmKMoUBA915r+CP+oCUO3HFGDmJypp+D23K8Y8ZJx7UKqoEJ/DnqdbHHgfMGMsNLyDGoDCqsTzaVPjWYXEx/ZEzYJSIcvxDizw0QsKpz/4g1xaVlwa9BtW5q69LvYiHFn5Xye2FOZiLc0WfvksL4Ydit0n3Gl9+nQ4MNO7Bv7xPvByRlKQ6IkZ0mkl6xJYpz4Olft55crYp+ERoO7EHo8x6Ci1NnkLOTgf1u1XC9QwGGFgoMG9c6esvU9bClMV0MpjxNiaVqZaB13H/WYjr1dgTuK+W5gd7M0Y4nZD5/proAl2Uoh6OGzkxaLgNwP1vNqYJ+02Zok/JxEFxl+BtthfteWNH2kYKnTn0azDbCe1oy4sx8M3yl4MfnLJZlTo4DLn7wf93MCo3CWkqnIMty6KOZw8lC/otPxAYIh8ZCT2Jfy9iXNMFOSbsr9nMSW9uGWfUNFEYzmGucDNlf75yZmX7vJbYEtnxNsVhW8uNEt+n7wrmt5spVRBu8QCJzvSE1QubVtaMmv06HOEWwBpyAlmQAYgxWfr2I7Vf8P+vSMg2dtYgYMkz/GjqORUIofeRXVhYBPSJy/aTThOn8TjSpDCvliCPZUIoa5QyQqPLJTIhY8Y+5C832T2V7UYzsdMdnSaYBYkRoeL7o+3ByTFnDIsFinaTImYxszyJif+n5pUnv1aL4vALfkFOu9Z3pj6Kgrc5bhPxz3rGNAVq6hDZ1HdNW1C9u7mgDZ2IT4E2lXfc5x7OweYcWbCN0hcKcHvTgS9ocekb5dHdAiWkCwV0wVNkLqBefVtCtK/9B+bl82QCOI9wU04bLPXVGAkql4wiEO9FhCKCD2RjaLxgLqN0Lnh4Nb15zCwMSYwDAUg83YF+zpo3AO92ID4pKXN6rvhv++hQvzGLCmptrf0IkICG0NlsNvxOYKPGKSjTl3B/YpNK5jo2Ek2ZLKuetotb70QNUxaAcvVzdo4v9ONp31Qp2DAUEcWxg12rN5serQf1andCUnr7ycbwI+yahkSqwEdYgac4Ko7AAlJW+w2AlDDf6GRF1+MU9G05OeSwaJN2DRjAsJOe2fSWQdXYled9Y242CNUqaxPaRDMnbvt5PYPOjXtC1fbCdgaIssyM4CnRbmztTAp4pOYFLZfBRnhqgyw88bGQOpofKGTBRIiW05qYgJ+hT7bhLmod1SPRxwS7MQ2Y+W7gMeiub9mV7J0xW71CQk33sdPVDbylMoYtp2WKMWz4pk/bAP7upMPNc6+AhYXBe6NjY2LCe0bWBZvbnmKlHpeCdAQTMKZFVg5zvraKcC5GtLgRXI5riqsUPG3NsAARIR417JXbGxCcE7c6ZocU3ONwwHqPYOT2G2t4z/o+0m0E67LCQbNJFkjtQvdxf5XTpoqp2xOByxxpCTdzxX/7zgJp2klJVpgiYqDaGCAVe3PolyKRzG9hw+sO0jhVNkm6dBrW34o2aHFVc3SUO+SbRIQUfufTibNP0a93S8dAp5JUIbVbyEXJ8Gp3gNxm/gBUjE0maoyKTYgHGe8/nFWAgz83VeMwg1DLexBj7xOxYFdGYduEoSzgDcc6grirBnG4Jk2XqOIzyUCyovp5F6i6LC/BU10yZM2hXCbv0srzwGsBbuPzr+wfPYgom39znAZgfG3LUo62pYUKnoMhllANWvM0T4TSbqZwfS9//7RFDPsFVLz1bgheaHJnjRs0Nma5ndUIYkZKnNdWZCn53Fs1YZKq/VhBooqGNrK04Ttq6wqSKGYW9XLS5gXyvGf6CZi9yirU/rCIHqyoIRkpXysLh3ghHlM0IcZxSXrtRl0e0183hwxZBdeoCbwFduGFNHKHyEjAUGYVaDm87aSbSZ1inkWkjRQw=="
-		case 783243975:
-			return "Lq9ax5wNGLENXrhmZPRxVfUA/5F0yjZ2mzKSJaThJ5aOHKzX50CCbo5IF+p5Zd8wIZ4wEqR7f/v4hKYcRK8tcmz9crG9ss3kKeet4FitJzNh61h0PQ2DhRsvmSZDAousHIcst1VqJLEGrPLnvOvV4jo7iXBHvZ0BQXEHUR9YmwXRHOcP+2BZrQ9ejcM0zTW6wPX1NJxYF68cZUoaWyQmi/+GpavU4nWweQ5Gl7cWKRSBC7Kxb44q3njQFhwx406g3g+P2tbA8RgMn5dP7s5D86qaE7cjdeMoHvTR0zr7FGC/15hKQk53jTfBSkh7LPjkhwAHmtJof4UXNnffOrxIC1e5y/p6pICXsz/LT3QQKQ57fFbfB53aoMsWMm0IknyIyyfvkcXy4g0Gg/AbfEvfdNEeGALqEQfxrip83zT5KxFRwpK9SLc1qxwuGTqPUH2Fw8kmu4dVB/oqlVYbkIMILSGKmBeTh/FhvaifnfsQOnopEQVbRbF5CkAS1sD6Dt25auJyb9qWL+tQZBaU17eGr8SRPRJj1jW56gSq535kI3BKNh4BLo03qsJKGxFvgt9sqDw5RwTzNxfAOKNHKU9ANT6ACb1zaq6fX5G6nRnT3hRIyhbRhRATJfWujEwxzgVY2htTvp5UtpA/ofpIBV8I2qKSVtLPZy9KUnya71Ibn8+PXUCPfPddJ0P2ELvx70Xa0UtoTkWDK2OEP8GSaXnOuMefuElXm0EWl89kba8W2VkmT9Zh5yJYrvp+guO0/xkUIN3MP+9s/PcN/ceUDXk3g5//rK/bJDtMmOPcESeiNM31nMm6W87icfLnMmTXSQvmv0kDd9T7scAxmTdswyliEs8e2h9QtWNcSa0PWKHeyIn9oMRkmzytn4UB1aqxsvJomZVlMuxBLOzEHQCTun5EEPEdLUb1wxHKMHWWbAs/VK72HiFgBAqFYkRMeRz5pRLiZpwEwO8WsMA+1rnJPl7yg5WPHKAW/SF8ivQ5LLrF973Mj3X7I2kzOuJjwU2OCoYnfFLp25D3cS6iGNCRTQyz4RjQy2T17PVQf8jLkPhFNuKdY+RUvPKurVhHavZej+p6NrIFRyjbKxyelavsfQfo+7aAmX+0HlgyS5C58Xae99QOwChGizWQAkDS50oOEmaKxWSswnfP5QN26MVi01Gdh39ru1hfKz8VnoVJDNzMCibLtcrXBNdr/iDbUHk/GbvxjTsd4NGSJfTpe2/y8vJkfPEIxiHc5v4QkcLLv/4+vHnotIhtWm4W+xCYEy9fQZ55f1ifjqyAPzku9TEFGn/aV9RmKFHlQK6zJM89TiK6NV7lOzUvm0OjX7JoC5H+os0Nn6b++0Bg0VboiBoa3M61KpJv8VATNw/zUif7iNIftgdBH0dTdtXls11bD8BD39hZvNTu3wvV1FmkAZvymXxHHjSh7ZsZ6jAkk8GqfEKayETcuWDszczRnLo9mJ4TFjBky87s1jYuchICYWn5iLLXGfSl4Ds193vVYuACO5hNmK4Qn/5Yg1VJZljiAYkk36QJgTDjgQR+cGwfHS+Q3VClFTjNJQPOS8T87mvvdM+WEj1QENt7BTY5MPUI1JYGtlwYNiZnM/T/eqpxksiJbJE2/7sotk2T5BQayeoE7vSdC8C4vXNGBpRy/9FS+uj8EkK9HBas4ZifBakh56nRybnCs3aGJ/rmasQKiidHMA8Y+HNhpPNzd+zSRehlKSLoNdE0d4aCbCWkcVY9g4Dln9CmN0xh5WH2sqZPjDGjPQ67ogrsqsgQ/MMbDC5w3C2tvL5O/ClOpFOT32cPHUi/T/hoMmKs+Yo/2R/LRyayiw41BCe63PwXjRGAWB+Sn5siAeUL22wlHEEze6mxKOnqD/VL/CdRSI8BXlGgM9aXBlFDlVj6TET5Z7gkxbgrZWEz/L58XMlxEDi8dk3xrH0loT5MPn2oQxQ8TFdOlIfYWU7Clj9ijR2vYcVpwjvIG3GRAQV5nkg3NjWkMiAIAp8Q+T0+gPRxafgucQ5JhDr0tKxVtEEClrqsbzd/Fhmg/pyfLaeLDmpDEOSNcgDLprfNqiH0pr8+cYPGIk1KmVH8qLR1S6EtOWOuVnlluj0HAwQf9W2n1usJoxVMOhzcXkdPk6bOxpdfr+2MBmmJGPUyiGOIHRnq/F2VTtzqi0tBEZ1akHE9uYH/uKZRTIcAfNUQpU/1GBaZDAA5x9mRf4JOoQ51IWVYb9tTI6OwOZ725l4HJm2HQrxjZF/m+axu1AqSq3/ZKo7V0glB3xr668/7ilFR5MwSm/+kkV/YNoXttIGvPwvF7jjh1JvsuWZUwkS68Dn/nLz9Tuo8yf80Z57qHkNIdyJY9eoyfmRFT6ImLDzMc2Vc4XsImtMduyCZDsV/abm74YIAZ58MvstxYC1hlkgp/PvxHd3eXY22z4+Hj0O/IWXsYiyV84jx6qDZrFxl9RCyGHLMOgavF09HIxMqdWh/7/gBguGz6fITD+53lVjCCXvgzwMN14znThxtoX9TDqbM2Ks9rtA4uWLvIEhohN5hQmKP4Jd7uIAlUolbJ2EPvIMX3RSPv6iGjaH133phUWPEUw9kwuCE7jkfyspaAO2MlRSsD/Q06DQ8jEmav4FDW0I7zCnDrT85uoIv+UT1NctmTm77lUqD2N06cDHV8PPXiCNpQlep0TLVtCHb6+DoSqp438/RFBsDU5D85nNWlAuNQrJK04ug0V1ktGZx9OtH39Spib2KWeskLt6Alg8B7Md+c6kBAOgSDzYUV1L9Q3EmAxRZFJ8TWALl5tj5m3iDBEsV55jU/4A0Yy65N66XObjaoC0OOw/XayCk47uzIQKwolxevVYnBxZQmCjriayTKpdQTC6DWptD+oO3lbEcWUihNzZHNpm8PuJe3UoMwFoiw1gA2l43kdvk7R9l3CfH8Y8sjaJ0Rf7subc8IkYq0mVCaLP2n+PsePRwkEdJXjIU/AS3HGcUTXWUcN6W+Lc9nssOYe60HgmwKqVLAYg4IcfPjkZfuiJ7l9eR/n337SurpUftVZAEqG7TgBWu8PA62A9Ag3CLJVl8hibtpM4lALzN8SSnk+mFMJ9H5/o49D5qbZEFeF3VqEgOHfpN5l52hBGPauSE8FOOeHGtrMs/K4it+ykDSKQKhV+ikc5CamFmtrKZGb5jAEdS+v5txw3NIYh89LCwWVzTHNL033JE8wYWvi8O1qi/oIsx0dsLumES6+hUz0cY/mD3wF/ozl4BjLUcVTeYgg+SNmrFHQb1I4eF0VR9bunNaFKj8EdELt9jIzyESEmZQVzD9y11QOf6z9CNgkmTqAlOqnFBF94YWfevVjNXziX0T5736z2W5vMcSvwq6PBL39eR9j5WwnLArSpJ7CJ+DUlW8Msr1ZiDhay1B3vE91lEBIfb2/bPZsgY5WAXy3nVvGyBCV+gBvkFB3FeW+Jv176GQnG5Jmpsn3DFL7WndouOJ7+9NcNe39ZTBBPpOsd34vcwKNPJpU/wr/m4oxpjwCgMQEAUvtI2N0KkUemc+Isk5yx3Mz31O56FbUv9gPG/6Zj29eeBTzBfSqs83JimJegXp8O/cbhNjfWh4kk46Sgp70gcBvoZld/eCgkZP8St41JsJmygyoNU8vsLflPC6VGc4Asw2ezCNGe1yXZlt1uSg4fE86fTwlOLnXrcKFwcdNjto6wHh2+BaUXcZFxmSAYkwWAtidFKg5cwX/lY4+g15DIgSr2fmVgS4lfmCyn9c4Wyw5gOrVFsR2PKlXSSFXppkUgRpqf146ix2Laap8j6oCQSOf2GCVtTkvabKGJZcfadGfWP8xPL+0PmBeK4zOth5BisUMxoo0KiYT5F8Rv2pFU/quU6xM1j5gBfxqJP9REQReA0hJNZmII1U9OFJrw5Ec3mOrgSWRbfXeyC87XQ8GM+yUfZU5SEzHO5PhGZ1uNXOLpWpKtht0qiMWSfI6HpR8fOVPv3lasbVXGgOJ0QfshGTxV/HXzS+afMmj1v5U+M9GnT8Z0hQdFi4iEnoZ6g0nK30/NuvIES9coi7ULo0jbXD1hKNCIXz6f8Gjx8lg3gNRzH05rgLNjbz1Vn+4p9EmoGdyNU/deBUZf+ln7f7KeU+dYNwFBLo3rYVikGV3z03Jg/vz/jXyepBTlgDhsKaKC2Kj3whXss5Ccs2e1W18Pp9YOyX6P6pIWrW10jZ13n2GOkiuD3r/2MF5zisYRon96U3G+pBTF1c2K+DEQu19a5C63yTxQmEdZe6b0bE4oRSeORoDiW9FtBE8OAdVONMgvrjBjthQtqtcelawf0Xou83ED4O4ZsEc599sFTrfPOx/70hscnz7HNYAFrcryh3EqRoYpmZW2RhQG2loQr4htLv4AvKvaoWESPbEkMpDr5lKGTA/ux8V4BbiyLMXVqqg5ZXsV18eY0ceqKRyEzKRx/kK+56yO04Wc/8inM+eq0VSRAx0o230i9P90c58f57m4i/Q3Q8fsmHoz6iA3xtHUdz3Xn1jkBV9ijCFa/AfGp8916B+vs0lhqhjkk4zt6EbpNtnTe/yXN7+lmAJKmy/F5J9ENebvUDyUOSVuKawNJWEGR/coNKxee9JogUY2t95iNxRCvsdaYeVsCFoSm51lAAQPYb4+VS1u8NtEd/Aq2kHHwZo3TF0kb3njPpLTtoqMNxfBVMBhmfg5khB5AmUdQy/T2Z3Qd6Bq6dDzLC/JjQKY7NnEIWX1CW+XvxrznewYLrspnnfQjTEPB8MnEuKHXYR8ovQiSsYJuNbA1yVqIBdWJ1RNwAgABknG9Sf+M5Uj5sPLCKoXVV5rt9DkQRqfn13ztDCnA/+KhIrp3DFQsbXHmh6GPhxAyq5RHwow7jt3V6aacbnEp4bLj0JQIB9upyzQWxB0JHU9TF5K5rk3tlo6DR0UpNYETUi1Rdoo4lw2dKXPygG0IPotlTAhX0nNU6nISTyCRIo4704n0+nBgX4+GvjgNIONs1xr6CGmldoAVAbWLUKcz2vksBCXwLjnPqYEMR33JsIzSzStaIk48Ak2Kja2xSOewi7TfisF73o45t+lkPRTgklwbADVSJbrbAVGTIs8QOeo0vQYyVcNULiho2pYlZfwylGWoBfUm+qAJEFG85vfZRkhki4fNvjOIPX9UFR7ymdtMFFgpow16LFX04nzlwXXNqFd5hnn0tEj3uZwPfUv0bIf+f2Yg+JSYp85iuhsmL3rCCrRu1XzPiwLbtoByDY24Yh6dzVa0BvEA35v8yatb/9X8sENgBvZFWwvSIHuRdL9vf+d9FvOJWqnDLE94m9gtzoOcgxXnKKR1JNXsV18Bo4m+jeD5QUCc8bRVQJYtFJjArjOgJfuan2ITuCOx7MItPZFVv7zHH9BH6opFym/4Xezm89q5ZSGmFH/FmGpb8tYOqN4GQvy5QUTUc/CeCay3HriO4OU+lphqNGfSnTuw+Sm7MrhzQLTstcl3BD+yBQ=="
-		case 193330639:
-			return "C4X9z8sW5BoNwFNPfOv40fRQQ07HLArZO8L039MXs8YvhxpscjrDxZiwFOVHo2k7bh5doVlGa9G3I2RlNJbEsJEdfzuoGws0y557fAqGBOk4PGotanyxIV3t9DbB8X8XQnlc5jP78cnanFXmx9gCX+AYuluhRQaB7a5C6i6/0H+YAXeNdBfmaRnYWunEOob8UbzqiT6abJA4mkxR/f9DhWYmqkQmleCVbZvv55IZlj+vLsqVVCUFDdNN0L6jjX4nr7zFQxlNol5MDMKVq2winuce8G81KQ04AYJynZErIjr+BHBDwqOyycI3zs0/iPXlsMry1XMN8Z9J/zEPRzf51BZetsL8AXCJi+MgsphwmxooP4zJublA6+fzseImu6Xcbn5v28Bz+Qu0/2pv/l7u2DgB+Kx2InWroosd0ER8hLvtHiHYtpBYGPGkGWvNOWGDsTSr34l/zSw4vZOLzyTWE4nHZKRw+TXgdrxXSt+63m0mg1sBoCcRCSnM1M8jv8Ld/Q8F++H9OCa411c4TU6xPn6OX4Xh8FP5V2xiCM5ow1bQdc7K+dNs+yAo4flnqeiE2t788FRrfj42EDnynxi4duxQZkX24PszlQiXZzsWaPaQkIZR2+8OhWYsWCl9IyKooaEgwCIUSLOKUevVfUbyB+fz+CXNsAQwIWL5iACofvSQTk9ZrZHarh1RhB0o/YHi+188jEeQNNtEzi7ppQ6FOI3ludRpBf4AljiSorEvlu6zlREAae1JBmqhC0WcOj/06JwL8Vl1LcyYYcj+Hn3CHj8CMgVzIhdfAFfjAVCwEREnkVNntO/r5gztlEbXxR/VjHIwiIpucW6sGdqdX7Iquv45RuxAUlul+Yzz1dlxNudI7jaF98HYqz+teJQeYd9luquWkFXNCCybPevA8NUw92h4DwtxXHdRJEnrnkBrxcwOFG1f37Lz94UN41xLbRF+82nXGz7gx6MxGedcu3lWdSN9F4ZDMlsZiKvh9UOdZdLoVn9rudXuPO/qrthPJEo5L07dBXbMKaGK8kNZ9lKg9Defnx+B7zmRihkFfOQd+qTcZT3vxZplSREKY/FJplJ9QvY/zL1Snp2b6GaZm/65FY48UjYeTU4Emy/H/6YCPFBaJt1ieSkNDBo8BPd+MY7imEBcR1kcefQ9+EEVpKgO0DOZJq92A6SK2IEQQaIeenjIJrMa26BPN+IH1+Ez2YU5SB5znRCzn+T5UfC0jQ386JnJbzFiubJ9FDNteUaXNolzcoAohX15ZWOUwGWrqZmbjbFK4XPjtiG+JuzPN3m7KotE/LPMCrlRKMuRKRDUFB0wMO241UeRX0vReUzpcZqCNfDv+bY1cdhmkzgveDv3uB1XSK0Iqm8BVNXt2vQeYs5LtVz79bVzESSVkaMkxdXDGV7ilUrF4k8F5q27p9srkP2makKW8dNCJPBDMK3gzFzTghACGSwQwwU593Do++bOK1hj/7ZZW8bYSKRW+5fWGYmW064FR8V7lro8eOp9RmxTgE9bS9LhEyL4LqmLaoO9UNDw0rUMeM80BkLriqJKft5DiUhKLJuIaFNcTC8r9mDs16SOtVg4iC8Rt4dVUp7FSGwUaGDo1KNveLFOoCEXtsFGhRIHO3oDONq5d2z4w40mjOiD13Y4/TYXbcHxIcPoU064nRUVW8y05sWCiXyh9rHaysbyid2/TFLQdH177kxYs84sDIS9oabFap3Tgmf+F6eoA+S7fmtnvoJ6aPiBbkdIkY990ZQ0h8NgQhwlxNFmyYJkz9X8TLpOFUAjPJegofR8dKqRew+C4rCz5TA0gyXmMNw+q5M6qv+U8T3QgCZnsK42fPtuR2ZijPN5lUkeayhyT6YsHSRzZ29iMcH821QVbg6KU4q1PH7MqavK661+w64lV4Kn6mJSW5TQmeojcy8+1DngAmPipRkBYkyIE1w/cTJukhTrx/pv8oYos3LMWvMaxyfBBci+8Ybq9GYuiCkfZbEPepwkjRtmcoRw+1ru722qqE7SKmRgWyM7f+pJHMzesmugVywGv8YB4DeXf5+KkwUPXDCzO3h9tMf/Ezh0a3l2Bs7GU0Jert+MmBUpbgn3OncgGYsgA4EP5ncSMR68j3vgQNaSItBALW9vciQuPifjZdpuO+4DnuCobkqW/gB/dZ6e47fR7ouRdDGCYvq2qmZOfZpXCtgeV9ojQs7Mnz/2nb1hsrgx497dGuVHcn1d9cn4UZy7dhTVGfziRPcHFr0pGIkxiRhdXx/tOTcntSdYmrioDmV0Z4teqzHu6KI/pQV0ggrnpOpDD6QwkeMrchiwewezAkxU/QQ1gbUkHI4+1/Wosly4B3ig04AMvb05dTDn2JG9hg22rWSUJTWHhdTu/BJlqx4IvVod6MvsNurlsq2QBe4numG4IO0yUDm5Qo04rHAOT55cW1DFnY8yLv9QBtZhntIC7kIs6T79SikhYP6aeQuAUzx6imu/5Q9Ni0KTMbVx1tjfA9H9wsa0ipgEvsGdggnVXNazqX0EpOjRd0yuxrUi4UPM43IAOdR7KzxTPpZtiLioGh+nJBOCzKHF/F3KVW7fIn8FZNs0rHeoRmDaxTdJ3CX9Oe2o4LJJY0MJaklw5KkKuMPKO7JCXC1NA2zdTWCQTSYkau5Be3xt6VVk4NZmL87l679RP8NcQezS5xYBUwWfs+ZqhMyyeLsDTxwncKxjOo8y6W4mmRa3rQGeohzfriZ8y6ud0xDAa/5DdSwKJtxBe70Op9VRjwcS+ROwU5l5g/yweWNO63zcw8eL0hb6p85w+HDFhDWwopV/pqp9s0wWy6tLW7lskPpESU3iML9CzjGOxS5jRE2gqFPqsS2xmKk6F/Y41GHeIXaPyd4nt/DyYTaFwv380LXGfWLcww15xugCXphDKIf++CAWl97LhCIZpo9wcbAoZHnTBjCpfEm+jvXnbCKHD6coEyMZfhCqChuzqH+95txrLtxQn5EJRUajnetAewr0cJEglI1irySPIqJ+xhF1eF+wYy/8ZAs2guC73DIczhSlxMRXmE3SBmc5UlOy+e7cTl1QCGH06JMt2UpXosmwDNNLuf1BHSo+Ambgt2GfYF0vYvXQjYCqJ8bcVFLcc1XYQejS4k2oT8ui5lgLTCU8XPGutY+B0KIoqLIetLXrkMsXMi6OEnxUNl7fP5by/wFNYZFpxdU1OFMsKdv4hzLskVw0fbki91HmvQdyn22+nPk7ZB00jhfwZWYIdyfLS4k/0PIQkcEabJh5o/GXkB1r8ItPcbMbEIBaNbj6JSGJ8Hy/SmvMGwU9ZFybj8ZRPYSB+3OfJPPLwncXRik6gZef8uzaU/aVM5KQvmRu6d+IeXJiydhjwk6oVWfOmz7X3uK9t+/Z5oqwitUTCKuml1StT1XFxXqMW1GEUYHX+cQoeoKkAjtAet1KRsrfsizOOMksV5O5S6yVIba981tB6n6nsnPJ3XAnb89YyH8qAUW1dsC2aITLktoDPEgCwmtEbuEQMZBhSLsOyD76w915wyzQS8p+5KVhavDIAI1gcLL/DZ/F+QV3GQLYAE7fKkiFkSXEVMF1J9oleiK5UP2T63TwQsdAXgygqiGaSb1/J1zQMUaHYMJDkL8b/CJbVVO0zh4q6D00pBwer8G3pmDhTH54br3Kl0dyc6Q9x0fAc+yRzNB2uPq8caHO1UJYJ1t3NIgDkXW3dracUM59rkXGLibA2bbKyQKg8pg99sKjslPMcn+pQ90cPrbetdhrZ3nIjjFQqjqjXVISJ+RLaJ6FDfgITjDQIcxgIno3IW7gE63lAR1HJHT4gI+BBQvT7mmP5bhI2M804UJm2yJYWFcYuTFMxPjW9GVFQGbrqnQn5Xnmyq0kbT2t8LaUEz3/hjL5kX6c5Zbaz/t0DLVrYgd/b7I7aAgLbRRbOLec5eeeFFQLgc2L5dehjrq7T0uvsmN2ws9xVmdy8TMRsoBM456UJzXq6xj1JWTckbowv8+mr5fm8FRTdNj7emRx8nsFj6jvEP5iZAHGu6p+eX30xpfiguOXnwZGn9iv6OGrLHvV0HfcaWWJXzM7se/tF843YucbKtlGEVfevjIx7cIIQpkx3FBW32jS0CMXQpFUC0ok7ltxA/l5ByUJmj6HlVCYvxgYQhNzddCU/ofR6pkgBJLRqmj7+XrN6HMye2D4km/4RiFyA9pzZg4KzU4fk7wtt/9MkQRcBGtRwM+sqztdb7kZMscaRdWxdXEzUzDvYsjqUxr3LKLYzSoxd9XbvOuWQPZymSOvdGuD/x0aLB3hjZcpc9sLgpA/dS0aYSUBnuTid/Nzvkh9w+Cbb/SVYXOOnLqW+wuVJJlbWd3sR/cspwt3zA9/FZTZJqINQ0qMUn4elkeAYSOpiHUTjDexjLGXP/RUSMRmEDMwssSirbQRnlp/4gVHU6KGEDBrAk3xGLis2D7Q+vk8XN8EEPo68BEYppa23KrxgXg/UfAQr8sKhGYMAR/Xdv6BWzgBK8+Q6enB/1HAXbh8XQnTStDi/j4nAlNQREEUOcGiEgoeNZwqxuQfVKarBb+1PQHdNYImICRqlyCKXKZ5a3ZZw3StuGPdSltr/RZOb4nZTcKiKRfoisY/qkVUcvt9ut7ZHh9z4xOe6gzbTxyXqmn0Nz+FJSfHjgptiQwzHW9v+UgjYrsmZz9kojhdtu561NIs6XCiDcDPP8Lj3Mo6gOmD0s83q3/oVgpbdJmO82841D7FjXjK+lvNoGPL35POywQFn/kxtrBvdH019dxdPekCCPIbXl0T2U7jZJZMOAnU374/nMhVT8gZwl6kTp4NMS8iBGIgLiVYy3pDowfZYzv8SDbgSBcTAH7ssab3RdcSpLxAuOHqc+/k8hW6jEt2NreTUMsnIhYvphKZidlevXLUoo/fsds9EdVNy13ChO+F6CrpGxxswmjtsXvginoP2rpuiPczVCIPOuR6DoBP46Rwy+1DgFin6ja9FMAM3cZOx2cjOiBcCzIDH/5yQ8AV1/+gLejtZns4U1OOJz0E3E65TdAjlCV5ZMpxD8T5tJMnPKsoob/qXz7LADcIwfUkyUWh6vzgqVvjOBzfwmpK19t9C90KmOmUy6A+Rq2MbthNIT/bZ5JQyqA8lJQ8Slj8nY2oIlkTLZx6rvvtdspHLfF6pcg1j8vry05oKSqgWpymvg4WdWXKmYc53U1Tgi1Jtle5NySyilYIKSZuVCpxbDurzAGDsO9nPUDUuNPRXh2lE3z8ecbwlPXqEiYfoSyXHQMJMNubDb76AaCQ6gyi1tGkf1XuSLUwjZkJ/+h49wXNcL9UupqoEt+7vYfgaHurTG/2XTDPaFW9PQYJdcPuu7oTSQT7q8vgcJSU5AYSXmkQSc3uQlk2f1qhTWg+7ZNIDP9viaV1TZYXDCE+2pxlG6RVRJCr+yFBTNMC77XStdil15hMhHPv7EBqafs24lfR7f4a1cTeia78GQ=="
-		case 1529706885:
-			return "Wy15hQTB9v7ZGYQ92jejSlOhgUioEYzDKeOFRWBNhD0nfznIjqzNIx7hEuDBX4t7lWVdPLyNEMLUE4OLrpJWzwxR+dksJxFPXdWb3SXUVRoimYssJd0b4uoah0PlxTIvlrYEWPplB+3gFEHSh0dZTHYi9GF5POuutJqK4u97OOTrrXTha2i6VeTRxVhXYm+yicKIGlIo8PCHtzvX7oTfISEWXYZJuiMlzgyxXjOECGpGA/ovo/nujm6A0eP2SSoIsV6NLImWslSNrHvYoadPNkQdZ7Ug69/LRyAo3suDgkMtOviwvufUJ1+6AwJ581PLGn9DEhH797/5ipo1WCR1/pMUx7mUCro1GgM5ZNPdE6MFEU1z3UXrhbSfQ5Tkdss6gl3hWRaQTd6hYx1FBuhFVmO7xGLfG11T9l8GtGvhMu0mFt2aRFNl0dWyHr7F1cXKD5SsN3DeGSyN5/NNRnQP3mZTUKq4FqXaIMWnImRBuqxt39cfs57iX0LcSdhG0pVPYC0iqIZKvXqBJCURic+74ymDSFqAy9E9RNgtxMv8ZW1PGlkRLbsOmBBDlpUrPrSwTeLley7sPQafxwj/VARL0fLQXclb+eH16uRGP+5CX1QHahx5HMklrQf0lrt8Flbqm4ox2qi/i3V60BhWxelFvEkYrXuT9qGzUxebcOuWH9tLBRDdkjo3Yzfk+Oyj3nKNId6pkZ+aG0hY/aJT3CX+MEKuXKBWvAFuHaf23MZlkfsxNod76GeS+zEljR1JKb+aljN9QyzstjhM24Os8V2HN6be329oaqczuA9Jvz6mVbi9MO8W8ehcRlmQVzXW9Fw1y2qzczaO5vko3eDVrxLKq5AY4sGLDfAq+VGL8NAbxKUelKWFWAnVM+xM2nwa6Ls2uUOYuG4W0TnhW3fs2m6wR8E7ld4JocHGWz4HeilSshMkp8WX9jLGNJxhOAiaSzmZ3IeA512dVyauW+hVgX0a+m2izuRwmEPOjSxxRp593ZMTuRshLlq/1kfjxvsbKHbZ1p8cd8QA/21gL/4xMcs/StqxSpADOd8mKAi1VwMZPXkpiME+WjystSgSSEYIjmWsAqKoFhiOQX3feYA1n0yUOMDs27TyVKDwSL8hk8ZshVW36hlXASFayd6j8PqYdET43D7EeFqdnwxLCex7RlLmZEbBAQWpFkMyVPYJ6gYttiu3+Rs9ucpz81ouXKNiGmgfwU7qmxB4aoe19aKisQ/ymALJmMgUPUyB6s51hGdJE6bl7XHLhu/GIcYdjPbGcVMAO1s6P2CqMo2MgG6htCoRAvIiMcabXG0hwRCJ3yGMK1onQdaKsvxIHfydDv1LQMKDzFBnkg+ltjHEQci0KnDBqNdIUeygnWgZs6GdAMxqtvt5Wbplb+UQ4M2eQc688RMlt1/toKNnqdF/kf0TuARt8VUxKbjxiw7SdfryWtNVbptyDcEGP8QAhUu2L/pOd89Uyceal6l3HkRlBWOFYl84Li++XJ7apq/qOwVQ1EbR2bxwVMIlKfPeQFpX1wGa3Nz9KiRhL5vMXFoECg6PjihnqCeFHUSxgA77zmYh3FKaWHVw7tcb524AmyT+6TN/8LjFEmbq5qyf8/0GarypC/Fg/IndhEJt7bqE81lsa+S1QSw5Kn75NDvHN+CCz8ftEa0JDjOJOUw4/SyTy1KofODc4mzq2+k/0et3PFB4LqbHpqaJKD+oHa9ZECPRKvgciwyh35wd1lhGYp2o+NfVvoDD3PySiPKsilJdBiZMFTmCbbwWEtX0C+VMuIJJfGkmelBGW/uTIKhZQ2ROjHZ+x7i6z3DYgoCAa+BSmRKCdXXbfeu7VvuzO6dKIEbafbm0Xz1jnwHcjm6OofIe5+6kLQDrxHCXC1d+MgDyz51we/n7E4cBjvTGENSWtdWIBAeNtiKtdGEJ9DTC/oenQsNlzUOTJqp/ONomg3WuZgwP3IMHn9eJzpWYFKHgQ5cPF56GLzHUXGGOMChmxUYjquHnznqXF8PQPgNytXfO4zu1krzhJByLNHMPGGLk+atzM1MhnXr1dNAVXxIDpVNWgkmBGMq7q5Ehm1HjPCRxgvgGX05Xx1TsHrOTRQ4mMSI0laDyelPsrcVrCxfCrYaO1uS53dnR1mpkWyl9ImssHgC9BYtgsFmFXClKmErt5snJREKcBPUdanPpTy5vzrmFQrVkw0cQmQQn7FaKEn6kesIJDDqwiz29PR3D9BlMEj/aesztIDGkdHHHXpZ9UVNTkqOhPU7MYJp0fCwZT2/qbXRJFVUeynv3j15OR7ZpuP+9xnAP0LhoZx8Ln1IlMejcfejR+PlzJLNVWWhDASmzJo+8kCFckkkfr4ExvaJ1JIsPN15rEJWEjNfzK3yi2Tt1QwEc3TZbmEY+MpyAbOV/cYRt0aJ0aErF+0KJDLto+csSj7gXWggSoufZQnBZXYlenfPM3lw+nqh7mCwfNzkN5XzRlV+wrhP++TWSiB5YsLEvQ+taaR/TbtGxZm5cw2x3IbPSgCo3eqUZFU098ShYXb/sv5S/e0rEDgQlxjaD9HYO9hTPYUfN4GbDeWFjHRZHvB/ejHsmlTC8N6lf/POfaX0k2KQRD4S+jvfOugtz4S+V3HuPlhMk2exFQzmWCzqYizJ+TkUC6zkYRqdWFy3uZFKZt/Rd0wsvZfaYTKLVbfsdl9nW0lROuAs5UZVSkhmewyUO4epAqbHFDDZ3RgyWhPGdlo3XYRHS1TSRTyRD4qZizAmN30NTX4PdQzILE/71u33sXNsO0Z3koigNZt+fRbsoXFXLi5VV7sVjS5UCtPIHDsr8PRKn6QMIzbdYFixjhqFelh8ZbEtLjJoYdDeUzTXGI7BcPgssgOFWF3J6ut8U4KTg4TtX6icU0eJkSandWUisFziTzlPqLlJryxMRb0OsXLIdEXSilLk1riHQwKIVDLe2FKUP8W2t6gizhyREymt1jFiF7/4uUGZhF375p5TXxEdC/d+pMltAe/ybpcDIgI47FTwxE1142uoOlwxdTsFeKWCTSrCa6pe67KjtS0f9VMMcZR/mcoYf0zNMA3zx9c+dmRZEELxNmFqbDlAYuevIKbFFkFNUeSlH1Sn08Naoxi7XsoA6Lf0TRLxrqcMXeuB90F9On1UEdRvc9tJA/PbM0ORQWb8s+aReuRj/2rOaMeEeswSddqaGAh2UVQqiCskenMMp7RwaiHIRq6nm3Sf7vzxOQ87CpBJkFZwLMHBXmnSHVS4inwgvvdijjmTujsgUGeM+Ex3GRCk/crEhnfRgR4VBBQ0Q15WsV53QN/nSUUf0Rfcx1GOZCSTII1BlyV67EUppzE9dyCJuCH9eH73c0ZYkSNRf7ByjRmsU/GzWG62XRx+kvUX0hgd3KJNBdCIMPgxwPtB9bdh1DjoV9fJ8PryUAd3rVGDMqvk3uoBdcGU2M+Yl44eiSu6HrvVnm2FT7e+TEAS7Liw6g3N5GkvkmFKpLFs8l+BqZAW+Sn6yISGJeHwRfkIpGrLsrah/GIZND1I+AZQSIdBc3vYUsAjP+LlxPNwpyOyl+3j8oXTaxMucJ8hF+0nfIzf/s134rJsFCpIL0fTVhuckhhkSipR814NbGOTnuROi5VHkIjRJ2Nuou1feDLYqeID3GoIO8neLVVpKT87OkA3RunSwgdNG6rmKsYodLqsX6I/CqzVqWCn8t11wC1R5/1TMQMem3uqTknB/lYR2mijdG8xmCXxdMZmpXLQEvJJwmQMQ3MiG+QmAQR6xlyULpZ9JLyDMv677cBtm+VftYWfJFlUkyhhJ9y55Fvx8jkgzD8ZfK1iMxDTYjQIPZUofeCpqU6/CATMxV73xuMby4u+oXcjhNf6vfSMBPTSEtPkjtAp/MHLVeE1jHz6ibaPW4Fqg//9e5ls4qznyHftgAN1qaLlVfKDs8yDtNMOeQ6bIv8NkOMA1W0fZzU+CtE4zqJ31+Zf1F6SKllEbgjxoblXlg9UnPeqRz28GEs6oPbdGVsTRnFmedqFfxOvgL+MDPITCp/dSHkVsMxxPtwE4VvC6qP8u4X+X5pC6nUhMefJG9keOmC9FnjzeaZfBCbTxzziITeYYSfe4WPcaJMfATysIlUOhQtZCKsJ+QrBbrfUKd+Lp518xQheWEmpwoP+ebZIFycOWqpp0ZWqpIfCMlJi7cS14ATmkZ3+kRDqnv2oVQKACijGUbS9zBvgd+CI/JZ6EXCuwagq3JnOltjpjIgU4u5FLeOPZrkzw43RM0M7pZHsjPAEFJ7xnkqwyzUuQcMZ/vPrLAzZSCiTT6TzbxcB4asERHJu39NEzE3JnH40eluxhXonK8POWW2P1Q17whrMlrGUaJk8e9Ahl/4jsEvtWN20Nm90bQSMmwAdCvmsxU2mTl0NTSEZQwMQy0ezYho7s70PWhxBSNI6n1ZNt94xW3JFiR/DuOOjPhT4/R2CiOfYQKD4AV0gYDQbsqUU7cwqfVmhgvGo1U5+j7Yna/+xYC9LXpddyk26ErEjKWEVHWDgtmSjarm6i5NcM3eKPLxDhsk+JMDajb/4/5r9hYCdyix2fyMFcPl0gViOGuvoqGd6kXu/rpSCzBO+yme/l8PkX5JLyaRftfnZqmcDLHKLfjdZogCxOMCJ8d4vVzkJKntj9AYM1af2cCXVpFCQvJqyIxHdEQEahbBIxmlvHj0dLtc+lJ4SsJFLcvfO0BiTa00p2BQQ5dnPih524oWCfG/IFPEXYD2IyvpOHkvXxmyoUaQknI78U55ZcFnpLi0OtarnJmsSkEiUMBih7CJEQdpBhq+x4xKSvdt99+mwMrNmMOODqaeDp0jBTXym/jlqDtcNrZkFLfKs/OQAJUaxA2gPZTZ+a7i59gn30iYrV/DfN18fcI8RRHkpCnVTJnDXlWk01/RTxdhgYfi67doaZIqMWwnYMG0inhrmXEOhVtguCphwcdEQHsAMz3FD8sMGaN8XbQ3X/XgsObjkA/x3By/FMj2SDMUH3s2/lYZua8CTHC8eRyTbfd4fPDXj/gxmYmSdzQW+4Cgu0R+Fg1DjJomuej7M80QQS2dr8ehSGUfV244FjL22BkKDhZM2+3vU5Pt+LOrRsI4fDKcsk9xxQhhfk7MB8Iy4b26ISti9EmaYasFL+Zgblg8ufq5lsp03JJ917F+ORwNoRZdPDfKVeAiK028KfEQIxT0Q8ategqwjZzfR8SHX7ev17M9gB4m37oOnYsONMl1HDU87wzxxS0Rb/yWhMs+0EaFHkbIeV1D3QwLRvbI2dVPvF0w7W1KGVaMN6PNIGfXF1+Ncz75xvRDeWncSSaAVHL7kTgPyHBJ8/Fp2nFlvQ6l/bXkw/I/zpkYt9VKGg9qd+UgTpDamT32cDuwl9+2LNMapzedlEbZ4NqXCacfHcWC7yJHJTRBMtepQH4wDgUY21cwg95DEz5lqB6U27M8ynQKhBw3UsNiP25dsjzQWWLPIhbQ=="
-		case 3601827651:
-			return "1q+HQ4dpr9RQpRJ4YdLEnSHYQkzTaffjviEE/5AvXKsI8gIabhjjAkIjSIoWgMkW9NBsX+g2/aRiejiMIx5YnBX4bOpXZsg3qLcqGjxl2IigX+NDxwh3nzaNzj7PW3s1BYBrxFkcSgPR62kPj15Mv4r6ZmU5ODn17Iqvt4t5FAfPf0CRSGfBSnIteSyV+E0vuV4WfwQrRop7IT4BSE3bDgd59pWfEvvxbXarDaOzJVrA38AUJ9k9LH1ZwC+45+//sBoZvu5O1Fi1MIMhUd+uDNJhm9TsR6Mp0tJ1fdIW7QaX9m/dOUvzyyjw71A3cM5mEw3Vha14sMqbVVdgFyaT8iiPEd9S3lCPkSnOVKb+h3EAYnhE3CysHoyknJ8j7I6LUgbjSqvqxVpJCYdvUEEzjhYIqdQS9IV4Bm7lUyhuT0xgLD68d4r7BMDo+h2oFjiwB1zVRNTpG2gFqlQmhHu3UOYqFZMcrm013TXQxZEedA2OPEim3rd3aCgQjrthZOuW3gtvo9HZdD+l/NIcYqNJtlvfj1kDlAdcd8KanAouurV8CAM7CRFky4wJbkk7grAleUVAHSSq6FVAgB01O9oyN/QgaRLOQrJwgRf6RAJfQvOaQ6GMq4WPpkAQATwbmaEj9tpQ2yXZx4pFx9osftzF17zz4EDyveae1eORXlmHNEvmLZD1Sj3UX+No54nQeTCTIKKoC85ia6H1Fwm+NWFXPuRqvvLofYaoRwkEP7datXHW1PCH9TwRLjcaO2Qo/7Iu4qu7ryjKAeZb9WSaShtAip8lUNHmpPIzM+++OhMxDCh+ahpmsTJPHoh272c38wXJP7e3fXC8a1rRtJXzS6s0uHBAMw8ZoHIQBXeL2msarzYgad7NXTqkHQt+nXeDDm0vOWGgiQRxku3tVwRCYhZkPqWbl+6IpG2J16GiEGDGWqSoQIjyKxflrf6gjNTBFqVDk6phHrnLs+teccY/9dGjp6jQUyJOPeFnYS1V5VwptBamz7svbE77uFdEX5hwJX03o5pw78MQSdbQJyw7zhE6ukJLceofOd5uvWmgf27NLwEj3/9wdZwMSm7JUe69ewwJr+rbNLRcT+Txp19mw54jFFlJOLi4B+LRpgiLvEs82l+mBj8nkZ8Nl96pGdsFwtYJ0XdG2pIdd/zzVnZRmsQEG/z+YClFJuxqxPf9wfZYr1wLYt9Njj58sk3NH+LqQyxOgk9YeTNM9yyaOjLtWjRf+Cv9l8UTiR9YZ0wv/TwnSlVHVLn0JrDUYNQtXoaJgdTgzQ9AUBx6SlhKWUopISAPo7zIuuFz621Bz3vOa6LymrX2gBdDP3fqEg6w9QGVbuf8zeYCpRagUed+jc4Ey+CycPldzYp6d4eN8yCwZ6X2wVwyH2UQgR9zXO8M59KAJ0igJX04BxT6zDs3r2kS3whiEbAn91TIcnjHmZ7jXslcZQXkrKil1qWR5Bhdt9QiA+nvOAM3YLw3XMGsqrPuOGYMzhm1MIU264EfzihpN/QnPQGssY++QKnVTfp7+5QyYKWH4O8bDL2SJtJRyZIALK5vSaTXR5LmGNap9vP9TP4cmcIWp8IycHZX+f2PD6MvBjquclY5vzsQiFBbNzuL+f6iuyOAn5XPti3u3hY2vFEUl7/sfGqf885DkUNo99KnLWmbOvGORNFkZ8IGngLC4VF2/UnkGq7OKKw0ZTXwb+F76PKPA9bPhcdzoF6bZZfgC0jNhQrzGYiXnG3zlCdZ9I7UIUtHfXRFOCpFG9ZfJIA6CymWek67SoIipFBwsdcA2wUqccZBzWaqDSR2ZZnhOjNc+PrQbvdGpJCt3VIVOF1bEINQsogWZWBApquva+bHYirESeAFKhTLjYhXpVWtexO7OrRfCOz7XRk6WJzxf7OXVNujrjZ/lTDv8agorN9KX0JaK8A4psKLKkODCvDnq0QKlwE7msS/QrtcesUUIFq2gR1DwffBP+DcLwPge9D/9b4U7ySl+rEj5hOpK0WdamF4+6kKzSxQtNFUPZ5zs3aVUIoF51yNyTm9PwIqRUerLRSYaPAsf3wJuRbfrEIjQkbGDcbx29KSV+5wWJ/9bjTBs9K15r7kK0g3xFmAaiPf1BkHbgU3SPbS46QlyMHEN+TQCuuKdybWJhvFtPCeGxA+KZhqsqQe5P09kX9ysClR+gl/6BaWeTApT3UnVMN9I+FuJ7rb0Sp4FpAWmpu1p9oRJA70zg1rRsvIr7o03zJ0mxbNsCIAyb8MTN1uBgK3VgT0v6DTrv7zc5uHS8EyAAnPbg0pKzwKkWqK1Wi66CwH7kARVhdxWEQMtS8AanvrfW6pL5hrptG6w1KX5UNphVql1SrHtaYgJgJmU5xWDQu1yrI3+4jJZCpo1AhQdc9l0VCNH5/mhmPcrpH6JI0Jm1m3XOfmV9zvm+5bTw56NEByQRNo8DsV25B8GoOsl/BcjFSg0Fb28miabN/EuXECiz88JvBTNvccDJniLe7SALvTgbhNcXfON7uoMaueQP5Hd9hQ59W/GZUNNl7VVynMbPzZ1h9cKPxgFbCTPll6A3zahPKiplLq1nmQEa1ka1L9YSvz31Sc8ZVfPKJhfVGzFqKlkN/NXLNsur9+uPO6T/mf6QUi8Bg87ZFW7roasv4CYvyuMFV4dme24w6ssHx6oPeCKiFesLHl0Fd+FqvegsRr/CBC2PFDaXqKPc7GDASVZfcThEGqeA2Hwa7HvtPqTXs2BFj6Hsp6/XEAhhPF9h481BUTZApVn7qIBaDoLdYXybL6rTXHwPfHNEO3dLI52ZxcyxP7gsh+ShRMvP2TP/Hqzdj6eFqIRpBC2+J5nDGICaL5iDYpjv/Vs+54eM2t4DQPBlW8ZV94dmKMhLsN+S/CZpwfbdWnRtU+JVHcUmXchCs96bFxbBmEEYnNyLheK1BsxfLST5ARDuYI0eXFPnGTefnPKWLhCSAAZ3qpArzeW8LCUAb5WHwkEUkuDK0Ux0MtlT2xsB6Sp4tp9K9nNUqwIoKJUsgt5XfeVVZ/aaKyIFjuw4cZVEsRblUZiUJDKRwMXHGph24o/pKFBcEmir6UyzJxLcF+65Prvk/KPJ0lbgBKoQ+nY0P2O6iUW28I1kNoXQdU2+e3s5BYnxAZK2cqvPvaE7qY829LuEn9v9P3KwcAn0UzwGuroJhtlAj75X0ZTuttVtGkAoPOkBKXVx+mTAMwCPcLDZ8ifh5HG2K/2ykKlrzu5pwbEtnkLiPhPKxhgVmkVE1pGvhwXrH4MBpTUtRru86+G8FnlLVrcfiIHV9gps8lIUP7eSAvlnEPJToiFqnEXDqYzTF+xo8nyVAfJ2Xz/6v0Er2iwR104prd1zt7CuxbYDpGDSCNhpfq45fCqbiVxIPeP/qeBVSiM0HdAN0q67fzodpH0AAIR6BMp2Fx1zD4y9i4lDrsj7oGLB99QgSYffomCGIGIPIo3GorHseyNlXZseSfc/pWOi5ACVSxAQaLY0rIZjMjYTS3GLEogQC77H5ECZ73yK9BMGprd3oVCJo+fWgZ9/64YMbFGNB3msBlC2KH9piWctieNzg9bKnFGYrVyusi9chFwj6jxDx9oH1+HJCuj6BHMeUHy8m8bHHl2j/5Y3eHsAG8DAeCr9Zfpbc/9RKUn7ppls/0j0R4DiWZ60t1mUW6MJW9Yj4ceqI2JOb8cMaYWNk/hf7r7D1hdWWY4lb621aY4vbKzueZtVf/MmXR+ORmE71Kr5lfqxDr+s9Au5FOxzen4fpk8cxVu+9HSHMqpjIx2cyknGVb8ajjBS6H0CSK4ceqO8KHKtEhZ2YCsxVCwSIp6Mbv8bWfEAcFX6SBGsyRfyU02d+fHnr9bNUdL/NFnkS8OaJGSVK1MEQykl8QwBoAL+7vhqxW+OiaFyLJacRIg+/VqF2OQTxoqfJTxed7ASky1BkL/KXTgBSkzjFy5CPpAebPzqcf81rRjwi/qWTDXoT3Ig/44HESlVqg2/AgQ4+9EoEfM9YehDjlx9tcsNlBKDNtficI0M5R+hNMSXKLmDf7Ssb5kIpTEqqbPVS+Pyq2SwHHsncoR5XwlddedxV8Q3g9oKF5HdH+QuG54QbCvxHXJXcE8YD7A7bI5jC+VvH6IC1LMcRqU759ujEe33Cj5A7Hvtbh5UUElSvvVGQAu2h77o4g5yLwj6jRxZqpew0oAaVMNw5Dv2Mg4Cw5zaOcCqX9t6wX24IX9ICywHx2lbllv2Q1z9QxrFXEtNu+C+wpuWCUkt74nXuxmV0l8/eTcHVRvUmHDMRn9NDrTytP4CKb9hSZkIh6rwcNZA+1oBVqv5zCCxyQSXWs1oW0bmq9WS0ySeH4BET8yBgmoSjGIbUn5+vPNBoiXFdTe9p7HrhiMzJruTpKF7rHkSzWC+vZMglyis/aUzSRqPblkoxZkg0MDibwAe3wWSFEe+4/3LzhbgT+4zs6ZUPa5M6t8YFEVkV/hth6rDRMfFEMNBeMmMBLNkFUx8SoQsaVFnYzy2HlGDnwnFy+FaPqW+4tkdbCT4v+lIvduxQJ5I/6qYOldNXR8AmnYJzTHeAfkH01mNk+JKKwdgZ/Qylw5km9H6VgH+oYN0YsZZjfQ1XehzUqknlPHQ3G4iNFZYvig8QIb0vHgoq1K8cWVT1rDRM89xVU7PLKw9VXeagYXH03fMtYlNVbtwteLtuVDoO7R6QIPr31h7EJ8GHLFjD6xCC9zGp6NmpiVICwo0clCGF8LVpH+GZpNogIZ0cMVD3r2Yz3rb+1FkKQw742ldplVUIpBTiTPQ2j6VDs0pAb9Uw7cu6DARcilwQM1YOtlYmx+kmzsY38xEjeuEUe0dlSJC/Vi2qzYQmbvx8KydA571zNdeoJX1+BiUQ0nHC4xLTHQ0BYbvuUn+JtJkLEfHUhX3BA5TmvfqRY9tAdpTFyRA5KaO9Hs5Rzp/V4z659bQX/vT3PyOxTLiXzTZAlrmGBcQ+/wvoz1VOdAPpWB73ro+KriGj0DDqBpuQvunIdApqgTs7lDdGhdGoO1+vPi7sUZ8Ilp/cfXfBA7Y26d2VlYEM6FJ0mVj76uMzUeARYpyswar7RXy0uxQRdK0apvhMi6HjvUYm6ruLUyUJHwpnDfA3ZqDYlg3bmCtNdzLVe24glrkpQiOFxMj5IuhmUIyK1iPMDZsl+p/K8lh25qOViWIzvayCXGAgXfmQcs0fRUz+70OOkMsWD8Tp7tg4flus8Xni5IpXNa7bFYpykbLzD0IKqlO42LjgSBcBbBFDCHXFsTZ1H/eFvVrZ19isA4GSDMV7G9auOT2QH+Lf2Wgbsj4apoY4jrW8DDODVlApvXmaQvTwSUqVZ6amoeJQAS/RbfIFcq8QXgLxJog9JtVQuYI2j870OhI5/sKWt1d/N/3xNY940DagTSDRA+rTofXKErfJOGXS+aNFGfQZJM3O66+mjNySu5DBaNhhNW5NCuILtH8O68uXYKv2GZw=="
-		case 2317419758:
-			return "iiEE7ii0RI+yFeO3XITyTC8J/Cn2IXgdQz9jTUamgga9/3c2InEzceJwxbrXi8fQJZJfx3R309MtEXaf/nnwuBp1f98xzwb19a08RX4FamTFy4sFVKg+FYbz9AnvMfZd1Qzv5ikXENtilx1hx8SSaV4Hq+rJRDdabi6IC1kyuLJwmRk/3UvjwxO1KZ8Wwk9wTt/vdeezU4rZmToKHl724StybZvK0vRj5k0nLlCcyIdXuapZrhSX3Ni4BgxWJfywDoWm4E/n2KY98iUcf7j8PLcvbSBjk+exPCSIM21Y7MeYqNwj9Xzzv3/eCmJrzx6ZkUSQ3XUcK7zfEE9mSas3pPfaHYOzUfA9MWlPVzC9zqEhPMkWBUXvYUEB+vAAI2k9KCYQpoI87SmoShry4jgMc3Gbzyt4BQmTKJcrkTnKa9kV+iIv30NJ6j7dKKwgRT3H4q7ids4xDdg4vomgvBcyeoHUfA3m1YN3EYyQR1V8PIJvV0Z5XKk4X6vIq/BjFJ3J5qu3St45DM873FVpGRchhrCf5+vexjw8MyXEmTOVnht/KXQ1/c8ZX/bkntQjRCmTmxzw7kKguoYF2JW/XNqXKQqS7/TcwpWJiUPQMdf4cM1Gg9yzF0198t2lgaUTOSEV9X1kJm16uIaLS7vM48dLygFGjk378fWz6uH26t06a33jQS/g13+y5Ojm9JeDvQNf6pzqi0FYAFPtN0K1X22hHlXLhTu/QZbbeooAHXzGC/m4JNxbEDNm6JnHvtZibxThxNtUPR7qv8hpculMNPDSnjMM3T8+6Tucz84KcDvWOUWuwd2EtxYFcfQDa676YDLvABH8sABJYD4pCnY7dWz+lhoHKmCRg964ngIihOzwqEr0PGoeJnuIwykIRNTog/ZUfyArmLO2muqd89BC4y7xQCnEYtYU65T7xPNa1ltIHbRRD1ZlkmcIxyrAY6Cgh41yWIb7G/CHLg9qe0r/7XvcDrYUBJBMhhHD1VKbQtppS++ezIndsqpGww/bsH0HBFshkqrFUEyEtBdR3peIhzY+NNqkR0sVISwh9bzwvvWZ5JVhGoWaxV3GbPbp9AKFEXc2sah+Mxsj7Op0ENGbjutvuhPsb9b0GUgWNXAZ2o2KLn6v8HXYC3DxvLRXkYEF5UcOoyJPh+1F1g90cxb+k0O6z5hKBxaXyyIybk0Cw/myjJ7K2aAUcQLgXdFJuIeTPzwLNdqOpHh47fVDhNJZCPz5CjxenLC13it09SALe0zUBj93QrRIyZbZEPRB+0TFeUAfCEstK5EcivPGJ6T1gx+wGTE1h6dloJbSZHjeLC+ObMpM7GMdkoYeO7188HUQ6PkjEzl5QmnPcbdCrSAfJQ1z8YGzTcVcg1we2Dj5ciIhWi3cUSu02yLTRexOzGeocedtplZVRSgVm0jDCWqVucgLGw6sTieLcNxmnpwuCMUrTd6HSUww2IZQbY28FEJBK3bMQPleVrr8Zwb37EYiJo2PIOQVd00WMiWdaidJArm/yXDf3HIab5kUiho5zJQK6WreHPLqT2LXxCuBL/dHwqkUKOaH6t1FX89gYSj+swyjw/q6LU5hPqOrBqfWdcGD5dZ0T10zqQmJ/QuUlu81Y9s06thduKBahhGvEruYpR+UX501smG77VMg3sy9pC4wiEm5iQas1oU5Cc6GliAmX0uHELQTg4AWkTCeB8EOd6LxzoB0oyGPDRd0DDCYfoIIlwlaYCBKfuD2MrvrptlTf1W+ZrBs3rRyruvy2inrM48kQC0R6Pq/lgc0tPCE7M50VcdPCmp7gM+BpjtdzU6DDll0v7/PLosPhz8/ajh2e927jGW9C149vDU456u/RBcbUFzgHMtMOs1djv0QsG3+5h5h2oy6PPvsci1nXFZnq31RUH5/bzYRfjOHLkAyUo5fhNMtGKXuzl6LiYFBneTQ31SvD6AafkCpQYxcwh92to/T/d4VilWKbE8gGl6UU/l2vBzKPw5lQUnnfIfGPCo6G0VB9LrtSRZ2amDEx/4IHPM+VUtRzkc4vnMGH70wA8s6ZtGsnFomDjucovVHZCGrHvNWtpdvaTKn/oNGu7+bsfFPDdLS+Dzrb/41B536ozqdxigvsm/6Q8IEJdaQcha9adqFU5kQukqrCoPXw/W8ok1Hz+h4TzCB1rrb+TCyRvZFIP7yniSLngxNsqZlpANqcP1Yd61uDxZUo5kTYl2MFP+ykPvshcnRnX9sT0D9598WDW5uYoOyF5ee6IXVaA6wePXRpjyOm1wXKBvtt06i26xUSXDkPJINa7+KYLjNSOwbNuYKUCVNsHzWQoBXTb18H70Au6vRgv0I9CHvGiwhx9kYC/Wjl9ibs+m5FmycxCKnQrPYNlAOAlncxwxksPI6lJB0FQjTZDnCaKy5zDIiHBQekTeCI20QaVl3Tf3jKn8Y92QxvJhFAHZkwrYzBVpSDgiACwD1M8tR4qlycHrqxKqfJaVSpT5pzMzkbLXQQbJ4jewvxZGoWnBdjfuZR9DcuWA+aaO+7N7uOdHfAnP0BQQ4AODyCSUcCveXppQ6JBHT02vc8r8L8pAxzZAClGKCaB3av+szmk9WdqTRXZlnSKHe0GtfmO0bS7+rZ0PwY5sCvkZLjdIm/sVJZKSH9wE0Mh7tRNTq7f/3vkvlWgOXnY585VQj1+i9TSmH5kuyNsDp6cMtGiQMfvrPGrWKmsb7Dzo4qSX+/tQkc7WWznhMvu003hBbBgJASfKHKhAWuqqlHCgzIzpc1PGj46eSMk5Z7+/tBSaJ/lMEf4zI4ngQS2J5hUODLZrCQCtawPqqbnAWQY0ejlK/v0fAwB1PCjm76v/whE5clCbXqKyRA+hyTRuI18qxHn5lojsTgd5s9GhKSUsP09lmL+h1u/dDAGS+6+RElJNvtTFL9nmjyLoFmLuQtwPets3pXfmjAwSTSJPUDPLgsFG5fXR6q7MAH93/G4SeWMfM7XYKkNNgEuil3+8oTHXhYjyZF3OTIFKUrBZ5WtG3YymZx0gIrQUBcmE6q49JHtJMtoDTdguJOf5QJJY3ZBXo0fEWrm2LzsUkNo04Ru+dQn3N1nQk9ypnllNsTIfC4z9kX+Hl86LYmQ9xei0H2sOo31I1iHrVqbtKccOwQ40+hSW7TdIU1Ui5bVNwVGNgn7xqm4Kihtso4+dbuXoPGwJg+3gfJr70QQ40etsUJ6lnlkYauOwU/DbF3J91O0n3s7bqBuWOouDikmm14uG8eXtEbc7LHrWM52bJkZFJEcGPS1iEUtF+ZwUA3k+KqGuYsaTFayli62FSCbLWZl1bngN0JH79GnsXCSVMY2l2MH+SxkCo+9QYQeU8rueZGbgWugs8xA9xTXGK92ol0iPCLtRL9ReFroDvVNsqWIlYSOrUj6dGeio1d2T7HkKRCj1SFyf1J/EO5b+tbHPkNWkOD4Zu9QpiheqKWg8YGf7z0/xpk36w6UWo5u/sJJL2rUp6xa2XJASpTvM9NPCsLhFQB/RxM5dB2cPrbC4CSGX98IfqrgwYCs7tpOV63Vvu+9N6aau6kEb1k6twULfcJ0MLVCRWyOsSb+dXaMnvlYPxScHHu+ASifPL1iwR9yfxcIgzjBPFwLzvUgww/yvKSb7TM9/Ax8C6/UAgYh5yYRtlks+p1MGu3SZSBRLXS7vilxxBf/GWKbzOO70J66syO0F2ayXgxxWIyLqbCJVrzKjlRcFrc1ArussLVUtF3lPuapY25F3LWYTi2Cm24dFG4XKpnBn4AfH9oE0v2uAHaYel/I5TPh34HQ8t5x9WUkv8yYc6onJagKjREKu96mmqzgcj1FhKxX9VwQGnr66QO+JpQzq/mVNh+wCzU/tzw+Z6/bCrPGQPhixEzH8jGPke4T3r5L3WzQzQMCm2T6mFrC8nP0EnVwcxZumus3abioqqHJ9DPJ/ofRtXtCT2ugrB/KHud+0bFaRfMXpkhKYoLkSd6Nv74IJp3IGNSISYwprfKH/4+EFrrixEfl3CcSRTQujfsgDarUV24AtPMKsV7dexexBnxGnCbnIn22Z9BPlBHq+xiKU1QjamS7xK4QKpmIXPcImyHsz0mU48my4Upf4nPrZbLaxKpq/URqLPlWwCTdaKo3XpuMAy7xRwZKWb8lQNI0NPLQk+TezdEonc3Vgfbq6DabcS/7KQ4Bcd4OQxTg7H8T5l7pjf3UusQdUpHpj1H1tNzIHUa16p/RSrmt0YsPwubcJlJPPy3SF6MjI/DZ+Z9b/KjAyMi4vgsBbJwKMba0mofX+FeBQOyCB9/6h9mEkHRHhkzE1B4JyV84ORBl1Fahp4YCZkoOxboZ0VDIN/2Z1dfZ810FMCPskpK6Wg5zMgAMJlUjNirX0sLB8H5jIpY6hbtOPqWUK2oeX4nm7fRGoHD0eWMeaH/6jgCSR688F9WDytkuu9wDlSnMs7l/HKPPLePsj3twQ69sGl2LVaz4xSOmpVdtyYivin3BtOfWU3MMAl8qkUizjO7EeGfpeOwTQhKrQUjOpZsEzQ+4XLQipoVZuOfr65mXFNZ0C3M73EapoTbGzXqQURkvxffgq7ENOivxPb2szYPGqoGBYdlzlDJM+akTpczhLvx5ukm0UeWfGBhKV0wXHXDdPUUkQqZqCcGlCitoBD8xwJ1Im3zCBQ+9Af0rmKE+5fRvknJ+Ko67E8hSYmxopjmEA2g3kM2zTqtflkXlO7j/F/+LqOKQTAWMHW1nXEqQREk1ILx3YhQWIUe/4/a+M9Qvh3OoY3jE1qxiLX9hXs4xzvCxZxO9XLuPx+/2qhAVW/UCuHIGt1E8LL1RpomCHR9XnhsKgTYb7mp7enI3DVBFpnSxHnz7am0Az1WT76ZEN6DaUuXJ2ButI+TG9E99rPERDUQh5q6E27Mf6upydFiWiaUWGAV132D9r3Zynsnn/0Hk4c1bH/NsPbgtu+PPbjT7Y7c+Xg5U1FWAv8niQIP3SKFFncbrlpZSg34+039MHSZLOS6tDsFqq/bjzhWgCsTYsTjyhOOgClqX4W7UhH4yfDm2SOa5ibkoQX44gllIAQDgV20F3wO51xgQcKuHpMmVNvxCdvPhsbeaQ3el/fFR0XR+3Aybi5iq997Jydb6MIJM0TSUiptiqhu65ijraP+OPOz7njTuYcHlkt4J29HS+jlYxnBND1GYArd6ccQF+HqweOHBpcKFjSl8KxseoLjS8/CFkVTYP/XMLOOv/jLjTrsV8+NKHcXdcYc8SlIy3jS6nWGiTn5YZLX6jSTmyBJEbd2+CpSzKUom//X2GbIj7Kwb/op9ZziA/6bHV/tI2UgJ9AG8npXxeH1oTcH2P6HlkPJP/kNpTVVjXVfuSGiGu/K6b8qRiOjAd8WxYBLSvK8dPBsGSFGchI6mahDB7LiQ/tiEDTr+YEQCABglE7wJjYBAMtfz5GIICXzGS3SopVuknDKJq+7Hh9CESE5LEV27t3ry4k7Q=="
-		}
-		logger.Error().Uint32("version", v).Msg("no key found")
-		panic("unknown version")
-	}(data)
+// NewKey wraps raw key bytes, as found in a KeyStore or from KeyFromSeed
+func NewKey(bytes []byte) *Key {
+	return &Key{_bytes: bytes}
+}
 
-	key, err := base64.StdEncoding.DecodeString(b64)
-	if err != nil {
-		logger.Error().Err(err).Msg("base64 failed to decode static string")
-		panic("Failed to decode string")
-	}
+// Bytes returns the raw key bytes
+func (k *Key) Bytes() []byte {
+	return k._bytes
+}
+
+// KeySource tells which key decrypted a GameCommand
+type KeySource byte
+
+const (
+	UnknownKey         KeySource = iota
+	SessionKey         KeySource = iota
+	DispatchKey        KeySource = iota
+	PreviousSessionKey KeySource = iota
+	ConfiguredKey      KeySource = iota
+	RecoveredKey       KeySource = iota
+)
 
-	logger.Info().Uint32("version", v).Msg("using preset decryption Key")
-	return &Key{
-		_bytes: key,
+func (ks KeySource) String() string {
+	switch ks {
+	case SessionKey:
+		return "SessionKey"
+	case DispatchKey:
+		return "DispatchKey"
+	case PreviousSessionKey:
+		return "PreviousSessionKey"
+	case ConfiguredKey:
+		return "ConfiguredKey"
+	case RecoveredKey:
+		return "RecoveredKey"
+	default:
+		return "Unknown"
 	}
 }
 
 func version(data []byte) uint32 {
-	return binary.BigEndian.Uint32(data[:4]) ^ 0x9D74C714
+	return binary.BigEndian.Uint32(data[:4]) ^ HEAD_MAGIC
 }
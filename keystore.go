@@ -0,0 +1,298 @@
+package reliquary
+
+import (
+	"bufio"
+	"bytes"
+	_ "embed"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/Fesaa/go-reliquary/internal/atomicfile"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+var (
+	KeyNotFound = errors.New("no key found for version")
+	// ErrKeyMismatch is returned when none of the known keys decrypts a command with valid magics
+	ErrKeyMismatch = errors.New("no key decrypted the command")
+	ReadOnlyKeys   = errors.New("key store is read only")
+	InvalidKey     = errors.New("invalid key")
+
+	//go:embed keys.json
+	embeddedKeysJson []byte
+)
+
+// KeyStore provides the dispatch keys used to decrypt commands before the session key is known
+// Key must return KeyNotFound (wrapped) when the version is unknown
+type KeyStore interface {
+	Key(version uint32) (*Key, error)
+	Store(version uint32, key *Key) error
+}
+
+// MemoryKeyStore keeps keys in memory only, it's where Sniffer.AddKey stores keys
+type MemoryKeyStore struct {
+	mu   sync.RWMutex
+	keys map[uint32]*Key
+}
+
+func NewMemoryKeyStore() *MemoryKeyStore {
+	return &MemoryKeyStore{keys: make(map[uint32]*Key)}
+}
+
+func (m *MemoryKeyStore) Key(version uint32) (*Key, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if key, ok := m.keys[version]; ok {
+		return key, nil
+	}
+	return nil, fmt.Errorf("%w: %d", KeyNotFound, version)
+}
+
+func (m *MemoryKeyStore) Store(version uint32, key *Key) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.keys[version]; ok {
+		logger.Debug().Uint32("version", version).Msg("Replacing key")
+	}
+	m.keys[version] = key
+	return nil
+}
+
+// EmbeddedKeyStore returns the preset keys shipped with this version of the library
+func EmbeddedKeyStore() KeyStore {
+	embeddedOnce.Do(func() {
+		keys, err := decodeJsonKeys(embeddedKeysJson)
+		if err != nil {
+			logger.Error().Err(err).Msg("failed to decode embedded keys")
+			panic("failed to decode embedded keys")
+		}
+		embeddedKeys = &readOnlyKeyStore{MemoryKeyStore{keys: keys}}
+	})
+	return embeddedKeys
+}
+
+var (
+	embeddedOnce sync.Once
+	embeddedKeys KeyStore
+)
+
+type readOnlyKeyStore struct {
+	MemoryKeyStore
+}
+
+func (*readOnlyKeyStore) Store(uint32, *Key) error {
+	return ReadOnlyKeys
+}
+
+// FileKeyStore reads keys from a single JSON or TOML file, mapping the version to the base64 encoded key
+//
+//	{"2648100823": "ndbP19HX..."}
+//
+//	# TOML, optionally inside a [keys] table
+//	2648100823 = "ndbP19HX..."
+//
+// The file is read on creation, Store rewrites the whole file
+type FileKeyStore struct {
+	MemoryKeyStore
+	path string
+}
+
+func NewFileKeyStore(path string) (*FileKeyStore, error) {
+	fks := &FileKeyStore{MemoryKeyStore: MemoryKeyStore{keys: make(map[uint32]*Key)}, path: path}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return fks, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if fks.isToml() {
+		fks.keys, err = decodeTomlKeys(data)
+	} else {
+		fks.keys, err = decodeJsonKeys(data)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not read keys from %s: %w", path, err)
+	}
+	return fks, nil
+}
+
+func (f *FileKeyStore) isToml() bool {
+	return strings.EqualFold(filepath.Ext(f.path), ".toml")
+}
+
+func (f *FileKeyStore) Store(version uint32, key *Key) error {
+	if err := f.MemoryKeyStore.Store(version, key); err != nil {
+		return err
+	}
+
+	f.mu.RLock()
+	var data []byte
+	if f.isToml() {
+		data = encodeTomlKeys(f.keys)
+	} else {
+		data = encodeJsonKeys(f.keys)
+	}
+	f.mu.RUnlock()
+
+	return atomicfile.Write(f.path, data)
+}
+
+// DirKeyStore reads keys from a directory with one `<version>.key` file per version, holding the base64 encoded key
+// Files are read on demand, so keys can be added while running
+type DirKeyStore struct {
+	dir string
+}
+
+func NewDirKeyStore(dir string) (*DirKeyStore, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &DirKeyStore{dir: dir}, nil
+}
+
+func (d *DirKeyStore) path(version uint32) string {
+	return filepath.Join(d.dir, strconv.FormatUint(uint64(version), 10)+".key")
+}
+
+func (d *DirKeyStore) Key(version uint32) (*Key, error) {
+	data, err := os.ReadFile(d.path(version))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("%w: %d", KeyNotFound, version)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := decodeKey(strings.TrimSpace(string(data)))
+	if err != nil {
+		return nil, fmt.Errorf("could not decode key %s: %w", d.path(version), err)
+	}
+	return key, nil
+}
+
+func (d *DirKeyStore) Store(version uint32, key *Key) error {
+	return atomicfile.Write(d.path(version), []byte(base64.StdEncoding.EncodeToString(key.Bytes())+"\n"))
+}
+
+// ChainKeyStore tries each store in order, and stores new keys in the first one that accepts them
+type ChainKeyStore []KeyStore
+
+func (c ChainKeyStore) Key(version uint32) (*Key, error) {
+	for _, ks := range c {
+		key, err := ks.Key(version)
+		if err == nil {
+			return key, nil
+		}
+		if !errors.Is(err, KeyNotFound) {
+			return nil, err
+		}
+	}
+	return nil, fmt.Errorf("%w: %d", KeyNotFound, version)
+}
+
+func (c ChainKeyStore) Store(version uint32, key *Key) error {
+	for _, ks := range c {
+		if err := ks.Store(version, key); err == nil || !errors.Is(err, ReadOnlyKeys) {
+			return err
+		}
+	}
+	return ReadOnlyKeys
+}
+
+func decodeJsonKeys(data []byte) (map[uint32]*Key, error) {
+	var raw map[string]string
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+
+	keys := make(map[uint32]*Key, len(raw))
+	for v, b64 := range raw {
+		if err := addEncodedKey(keys, v, b64); err != nil {
+			return nil, err
+		}
+	}
+	return keys, nil
+}
+
+func encodeJsonKeys(keys map[uint32]*Key) []byte {
+	raw := make(map[string]string, len(keys))
+	for v, key := range keys {
+		raw[strconv.FormatUint(uint64(v), 10)] = base64.StdEncoding.EncodeToString(key.Bytes())
+	}
+	data, _ := json.MarshalIndent(raw, "", "\t")
+	return append(data, '\n')
+}
+
+// decodeTomlKeys reads the subset of TOML written by encodeTomlKeys, `version = "base64"` pairs
+func decodeTomlKeys(data []byte) (map[uint32]*Key, error) {
+	keys := make(map[uint32]*Key)
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") || text == "[keys]" {
+			continue
+		}
+
+		v, b64, ok := strings.Cut(text, "=")
+		if !ok {
+			return nil, fmt.Errorf("line %d: expected version = \"key\"", line)
+		}
+		v = strings.Trim(strings.TrimSpace(v), `"`)
+		b64, err := strconv.Unquote(strings.TrimSpace(b64))
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		if err = addEncodedKey(keys, v, b64); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+	}
+	return keys, scanner.Err()
+}
+
+func encodeTomlKeys(keys map[uint32]*Key) []byte {
+	var buf bytes.Buffer
+	buf.WriteString("[keys]\n")
+	for _, v := range slices.Sorted(maps.Keys(keys)) {
+		fmt.Fprintf(&buf, "%d = %q\n", v, base64.StdEncoding.EncodeToString(keys[v].Bytes()))
+	}
+	return buf.Bytes()
+}
+
+func addEncodedKey(keys map[uint32]*Key, v string, b64 string) error {
+	parsed, err := strconv.ParseUint(v, 10, 32)
+	if err != nil {
+		return fmt.Errorf("invalid version %q: %w", v, err)
+	}
+	key, err := decodeKey(b64)
+	if err != nil {
+		return fmt.Errorf("invalid key for version %d: %w", parsed, err)
+	}
+	keys[uint32(parsed)] = key
+	return nil
+}
+
+// decodeKey decodes a base64 key, refusing any that isn't KEY_LEN bytes long
+func decodeKey(b64 string) (*Key, error) {
+	key, err := base64.StdEncoding.DecodeString(b64)
+	if err != nil {
+		return nil, err
+	}
+	if len(key) != KEY_LEN {
+		return nil, fmt.Errorf("%w: %d bytes, expected %d", InvalidKey, len(key), KEY_LEN)
+	}
+	return NewKey(key), nil
+}
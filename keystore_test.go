@@ -0,0 +1,64 @@
+package reliquary_test
+
+import (
+	"encoding/base64"
+	"errors"
+	"github.com/Fesaa/go-reliquary"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestKeyStoresRejectInvalidKeys(t *testing.T) {
+	valid := base64.StdEncoding.EncodeToString(make([]byte, reliquary.KEY_LEN))
+	long := base64.StdEncoding.EncodeToString(make([]byte, reliquary.KEY_LEN+1))
+
+	tests := []struct {
+		name string
+		file string
+		data string
+		err  error
+	}{
+		{"json", "keys.json", `{"1": "` + valid + `"}`, nil},
+		{"json empty key", "keys.json", `{"1": ""}`, reliquary.InvalidKey},
+		{"json short key", "keys.json", `{"1": "AAAA"}`, reliquary.InvalidKey},
+		{"toml", "keys.toml", "[keys]\n1 = \"" + valid + "\"\n", nil},
+		{"toml empty key", "keys.toml", "1 = \"\"\n", reliquary.InvalidKey},
+		{"toml long key", "keys.toml", "1 = \"" + long + "\"\n", reliquary.InvalidKey},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), tt.file)
+			if err := os.WriteFile(path, []byte(tt.data), 0o644); err != nil {
+				t.Fatal(err)
+			}
+
+			ks, err := reliquary.NewFileKeyStore(path)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("got error %v, want %v", err, tt.err)
+			}
+			if tt.err != nil {
+				return
+			}
+			if got, err := ks.Key(1); err != nil || len(got.Bytes()) != reliquary.KEY_LEN {
+				t.Fatalf("could not read the key back: %v", err)
+			}
+		})
+	}
+}
+
+func TestDirKeyStoreRejectsInvalidKeys(t *testing.T) {
+	dir := t.TempDir()
+	ks, err := reliquary.NewDirKeyStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = os.WriteFile(filepath.Join(dir, "1.key"), []byte("\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = ks.Key(1); !errors.Is(err, reliquary.InvalidKey) {
+		t.Fatalf("got error %v, want %v", err, reliquary.InvalidKey)
+	}
+}
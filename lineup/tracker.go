@@ -0,0 +1,342 @@
+// Package lineup tracks the player's teams from the commands a reliquary.Sniffer reads, and reports
+// every change with the team before and after it.
+//
+//	tracker := lineup.NewTracker(func(change lineup.Change) {
+//		if change.Active {
+//			fmt.Println("now playing with", change.After.Avatars())
+//		}
+//	})
+//	for packet := range capture.Packets {
+//		_ = tracker.Apply(packet.Commands...)
+//	}
+package lineup
+
+import (
+	"cmp"
+	"github.com/Fesaa/go-reliquary"
+	"github.com/Fesaa/go-reliquary/internal/dispatch"
+	"github.com/Fesaa/go-reliquary/pb"
+	"maps"
+	"slices"
+	"sync"
+)
+
+type Member struct {
+	Id   uint32
+	Slot uint32
+	// Type is the AvatarType, trial avatars aren't the player's own
+	Type uint32
+	Hp   uint32
+}
+
+type Lineup struct {
+	Index uint32
+	Name  string
+	// ExtraType is 0 for the saved teams, others are used by game modes like challenges and stay apart from them
+	ExtraType uint32
+	Virtual   bool
+	PlaneId   uint32
+	Leader    uint32
+	Mp        uint32
+	MaxMp     uint32
+	// Members are ordered by slot
+	Members []Member
+}
+
+// Avatars returns the ids of the members, in slot order
+func (l Lineup) Avatars() []uint32 {
+	ids := make([]uint32, 0, len(l.Members))
+	for _, member := range l.Members {
+		ids = append(ids, member.Id)
+	}
+	return ids
+}
+
+func (l Lineup) equal(other Lineup) bool {
+	return l.Index == other.Index && l.Name == other.Name && l.ExtraType == other.ExtraType &&
+		l.Virtual == other.Virtual && l.PlaneId == other.PlaneId && l.Leader == other.Leader &&
+		l.Mp == other.Mp && l.MaxMp == other.MaxMp && slices.Equal(l.Members, other.Members)
+}
+
+// Change is a lineup before and after a command, Before is empty for a lineup seen for the first time
+type Change struct {
+	Before Lineup
+	After  Lineup
+	// Active is set when After is the team in use
+	Active bool
+	// Switched is set when the team in use became another lineup, Before is the one used until now
+	Switched bool
+}
+
+type key struct {
+	extraType uint32
+	index     uint32
+}
+
+func keyOf(lineup Lineup) key {
+	return key{lineup.ExtraType, lineup.Index}
+}
+
+var handlers = dispatch.Handlers[*Tracker]{
+	reliquary.GetAllLineupDataScRsp:  dispatch.Do((*Tracker).applyAllLineups),
+	reliquary.GetCurLineupDataScRsp:  dispatch.Do((*Tracker).applyCurLineup),
+	reliquary.SyncLineupNotify:       dispatch.Do((*Tracker).applySync),
+	reliquary.JoinLineupScRsp:        dispatch.Do((*Tracker).applyJoin),
+	reliquary.QuitLineupScRsp:        dispatch.Do((*Tracker).applyQuit),
+	reliquary.SwapLineupScRsp:        dispatch.Do((*Tracker).applySwap),
+	reliquary.ReplaceLineupScRsp:     dispatch.Do((*Tracker).applyReplace),
+	reliquary.SwitchLineupIndexScRsp: dispatch.Do((*Tracker).applySwitchIndex),
+
+	// The responses only carry a retcode, the change is in the request
+	reliquary.JoinLineupCsReq:    dispatch.Remember(reliquary.JoinLineupCsReq, requests),
+	reliquary.QuitLineupCsReq:    dispatch.Remember(reliquary.QuitLineupCsReq, requests),
+	reliquary.SwapLineupCsReq:    dispatch.Remember(reliquary.SwapLineupCsReq, requests),
+	reliquary.ReplaceLineupCsReq: dispatch.Remember(reliquary.ReplaceLineupCsReq, requests),
+}
+
+// Tracker keeps the saved and active lineups, safe for concurrent use
+type Tracker struct {
+	// OnChange is called for every change
+	OnChange func(change Change)
+
+	mu        sync.RWMutex
+	lineups   map[key]Lineup
+	current   key
+	hasActive bool
+	requests  dispatch.Requests
+	// changes are collected while applying, and passed to OnChange once done
+	changes dispatch.Queue[Change]
+}
+
+func NewTracker(onChange func(change Change)) *Tracker {
+	return &Tracker{
+		OnChange: onChange,
+		lineups:  make(map[key]Lineup),
+		requests: make(dispatch.Requests),
+	}
+}
+
+// Apply updates the lineups
+func (t *Tracker) Apply(commands ...reliquary.GameCommand) error {
+	t.mu.Lock()
+	err := handlers.Apply(t, commands)
+	changes := t.changes.Take()
+	t.mu.Unlock()
+
+	dispatch.Notify(changes, t.OnChange)
+	return err
+}
+
+func (t *Tracker) applyAllLineups(rsp *pb.GetAllLineupDataScRsp) {
+	if rsp.GetRetcode() != 0 {
+		return
+	}
+	for _, info := range rsp.GetLineupList() {
+		t.put(newLineup(info))
+	}
+	t.activate(key{0, rsp.GetCurIndex()})
+}
+
+func (t *Tracker) applyCurLineup(rsp *pb.GetCurLineupDataScRsp) {
+	if rsp.GetRetcode() != 0 || rsp.GetLineup() == nil {
+		return
+	}
+	lineup := newLineup(rsp.GetLineup())
+	t.put(lineup)
+	t.activate(keyOf(lineup))
+}
+
+// applySync takes the lineup the server sent after a change. Game modes announce their lineup this way
+// when they start, so a lineup outside the saved teams becomes the active one. Leaving a mode syncs
+// the saved team the player returns to
+func (t *Tracker) applySync(notify *pb.SyncLineupNotify) {
+	if notify.GetLineup() == nil {
+		return
+	}
+	lineup := newLineup(notify.GetLineup())
+	t.put(lineup)
+	if lineup.ExtraType != 0 || t.hasActive && t.current.extraType != 0 {
+		t.activate(keyOf(lineup))
+	}
+}
+
+func (t *Tracker) applyJoin(rsp *pb.JoinLineupScRsp) {
+	req, ok := dispatch.Take[*pb.JoinLineupCsReq](t.requests, reliquary.JoinLineupCsReq)
+	if !ok || rsp.GetRetcode() != 0 {
+		return
+	}
+
+	t.edit(key{uint32(req.GetExtraLineupType()), req.GetIndex()}, func(lineup *Lineup) {
+		lineup.Members = slices.DeleteFunc(lineup.Members, func(member Member) bool {
+			return member.Slot == req.GetSlot() || member.Id == req.GetBaseAvatarId()
+		})
+		lineup.Members = append(lineup.Members, Member{
+			Id:   req.GetBaseAvatarId(),
+			Slot: req.GetSlot(),
+			Type: uint32(req.GetAvatarType()),
+		})
+	})
+}
+
+func (t *Tracker) applyQuit(rsp *pb.QuitLineupScRsp) {
+	req, ok := dispatch.Take[*pb.QuitLineupCsReq](t.requests, reliquary.QuitLineupCsReq)
+	if !ok || rsp.GetRetcode() != 0 {
+		return
+	}
+
+	t.edit(key{uint32(req.GetExtraLineupType()), req.GetIndex()}, func(lineup *Lineup) {
+		lineup.Members = slices.DeleteFunc(lineup.Members, func(member Member) bool {
+			return member.Id == req.GetBaseAvatarId()
+		})
+	})
+}
+
+func (t *Tracker) applySwap(rsp *pb.SwapLineupScRsp) {
+	req, ok := dispatch.Take[*pb.SwapLineupCsReq](t.requests, reliquary.SwapLineupCsReq)
+	if !ok || rsp.GetRetcode() != 0 {
+		return
+	}
+
+	t.edit(key{uint32(req.GetExtraLineupType()), req.GetIndex()}, func(lineup *Lineup) {
+		for i, member := range lineup.Members {
+			switch member.Slot {
+			case req.GetSrcSlot():
+				lineup.Members[i].Slot = req.GetDstSlot()
+			case req.GetDstSlot():
+				lineup.Members[i].Slot = req.GetSrcSlot()
+			}
+		}
+	})
+}
+
+func (t *Tracker) applyReplace(rsp *pb.ReplaceLineupScRsp) {
+	req, ok := dispatch.Take[*pb.ReplaceLineupCsReq](t.requests, reliquary.ReplaceLineupCsReq)
+	if !ok || rsp.GetRetcode() != 0 {
+		return
+	}
+
+	t.edit(key{uint32(req.GetExtraLineupType()), req.GetIndex()}, func(lineup *Lineup) {
+		lineup.Leader = req.GetLeaderSlot()
+		lineup.Members = lineup.Members[:0]
+		for _, slot := range req.GetLineupSlotList() {
+			lineup.Members = append(lineup.Members, Member{
+				Id:   slot.GetId(),
+				Slot: slot.GetSlot(),
+				Type: uint32(slot.GetAvatarType()),
+			})
+		}
+	})
+}
+
+func (t *Tracker) applySwitchIndex(rsp *pb.SwitchLineupIndexScRsp) {
+	if rsp.GetRetcode() == 0 {
+		t.activate(key{0, rsp.GetIndex()})
+	}
+}
+
+func newLineup(info *pb.LineupInfo) Lineup {
+	lineup := Lineup{
+		Index:     info.GetIndex(),
+		Name:      info.GetName(),
+		ExtraType: uint32(info.GetExtraLineupType()),
+		Virtual:   info.GetIsVirtual(),
+		PlaneId:   info.GetPlaneId(),
+		Leader:    info.GetLeaderSlot(),
+		Mp:        info.GetMp(),
+		MaxMp:     info.GetMaxMp(),
+		Members:   make([]Member, 0, len(info.GetAvatarList())),
+	}
+	for _, avatar := range info.GetAvatarList() {
+		lineup.Members = append(lineup.Members, Member{
+			Id:   avatar.GetId(),
+			Slot: avatar.GetSlot(),
+			Type: uint32(avatar.GetAvatarType()),
+			Hp:   avatar.GetHp(),
+		})
+	}
+	sortMembers(lineup.Members)
+	return lineup
+}
+
+func sortMembers(members []Member) {
+	slices.SortFunc(members, func(a, b Member) int {
+		return cmp.Compare(a.Slot, b.Slot)
+	})
+}
+
+// put stores a lineup, and records the change if it is one
+func (t *Tracker) put(lineup Lineup) {
+	k := keyOf(lineup)
+	before, ok := t.lineups[k]
+	t.lineups[k] = lineup
+	if ok && before.equal(lineup) {
+		return
+	}
+	t.changes.Push(Change{
+		Before: before,
+		After:  lineup,
+		Active: t.hasActive && t.current == k,
+	})
+}
+
+// edit changes a copy of a known lineup and puts it, unknown lineups are left alone
+func (t *Tracker) edit(k key, f func(lineup *Lineup)) {
+	lineup, ok := t.lineups[k]
+	if !ok {
+		return
+	}
+	lineup.Members = slices.Clone(lineup.Members)
+	f(&lineup)
+	sortMembers(lineup.Members)
+	t.put(lineup)
+}
+
+// activate makes a lineup the active one, and records the switch if it is one
+func (t *Tracker) activate(k key) {
+	if t.hasActive && t.current == k {
+		return
+	}
+	before := t.lineups[t.current]
+	if !t.hasActive {
+		before = Lineup{}
+	}
+	t.current, t.hasActive = k, true
+	t.changes.Push(Change{
+		Before:   before,
+		After:    t.lineups[k],
+		Active:   true,
+		Switched: true,
+	})
+}
+
+func requests(t *Tracker) dispatch.Requests {
+	return t.requests
+}
+
+// Active returns the team in use, false until it is known
+func (t *Tracker) Active() (Lineup, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	if !t.hasActive {
+		return Lineup{}, false
+	}
+	lineup, ok := t.lineups[t.current]
+	return lineup, ok
+}
+
+// Lineups returns the saved teams, ordered by index
+func (t *Tracker) Lineups() []Lineup {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	var lineups []Lineup
+	for _, k := range slices.SortedFunc(maps.Keys(t.lineups), func(a, b key) int {
+		return cmp.Compare(a.index, b.index)
+	}) {
+		if k.extraType == 0 {
+			lineups = append(lineups, t.lineups[k])
+		}
+	}
+	return lineups
+}
@@ -0,0 +1,180 @@
+package lineup_test
+
+import (
+	"fmt"
+	"github.com/Fesaa/go-reliquary"
+	"github.com/Fesaa/go-reliquary/lineup"
+	"github.com/Fesaa/go-reliquary/pb"
+	"github.com/Fesaa/go-reliquary/reliquarytest"
+	"slices"
+	"testing"
+)
+
+// info builds a saved lineup with the avatars in slots 0 and up
+func info(index uint32, avatars ...uint32) *pb.LineupInfo {
+	l := &pb.LineupInfo{Index: index}
+	for slot, id := range avatars {
+		l.AvatarList = append(l.AvatarList, &pb.LineupAvatar{Id: id, Slot: uint32(slot)})
+	}
+	return l
+}
+
+// describe writes a lineup as <extra type>.<index>[avatars], and none for the empty Before of new lineups
+func describe(l lineup.Lineup) string {
+	if l.Members == nil {
+		return "none"
+	}
+	return fmt.Sprintf("%d.%d%v", l.ExtraType, l.Index, l.Avatars())
+}
+
+// challenge builds the lineup of a game mode, ExtraLineupType is an enum in the game's protos
+func challenge(avatars ...uint32) *pb.LineupInfo {
+	l := info(0, avatars...)
+	l.ExtraLineupType = 5
+	return l
+}
+
+func describeChange(change lineup.Change) string {
+	s := describe(change.Before) + " -> " + describe(change.After)
+	if change.Active {
+		s += " active"
+	}
+	if change.Switched {
+		s += " switched"
+	}
+	return s
+}
+
+func apply(t *testing.T, tracker *lineup.Tracker, s *reliquarytest.Session) {
+	t.Helper()
+
+	if err := s.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if err := tracker.Apply(s.GameCommands()...); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestTrackerSeed(t *testing.T) {
+	var changes []string
+	tracker := lineup.NewTracker(func(change lineup.Change) {
+		changes = append(changes, describeChange(change))
+	})
+	session := reliquarytest.NewSession()
+	session.FromServer(reliquary.GetAllLineupDataScRsp, &pb.GetAllLineupDataScRsp{
+		LineupList: []*pb.LineupInfo{info(0, 1001, 1002), info(1, 1102)},
+		CurIndex:   1,
+	})
+	apply(t, tracker, session)
+
+	want := []string{
+		"none -> 0.0[1001 1002]",
+		"none -> 0.1[1102]",
+		"none -> 0.1[1102] active switched",
+	}
+	if !slices.Equal(changes, want) {
+		t.Errorf("changes %q, want %q", changes, want)
+	}
+	if active, ok := tracker.Active(); !ok || active.Index != 1 {
+		t.Errorf("active %+v", active)
+	}
+	if lineups := tracker.Lineups(); len(lineups) != 2 {
+		t.Errorf("%d saved lineups, want 2", len(lineups))
+	}
+}
+
+func TestTrackerChanges(t *testing.T) {
+	tests := []struct {
+		name    string
+		script  func(s *reliquarytest.Session)
+		changes []string
+		active  string
+	}{
+		{"join", func(s *reliquarytest.Session) {
+			s.FromClient(reliquary.JoinLineupCsReq, &pb.JoinLineupCsReq{Index: 0, Slot: 2, BaseAvatarId: 1102})
+			s.FromServer(reliquary.JoinLineupScRsp, &pb.JoinLineupScRsp{})
+		}, []string{"0.0[1001 1002] -> 0.0[1001 1002 1102] active"}, "0.0[1001 1002 1102]"},
+		{"join replaces slot", func(s *reliquarytest.Session) {
+			s.FromClient(reliquary.JoinLineupCsReq, &pb.JoinLineupCsReq{Index: 1, Slot: 0, BaseAvatarId: 1005})
+			s.FromServer(reliquary.JoinLineupScRsp, &pb.JoinLineupScRsp{})
+		}, []string{"0.1[1102] -> 0.1[1005]"}, "0.0[1001 1002]"},
+		{"failed join", func(s *reliquarytest.Session) {
+			s.FromClient(reliquary.JoinLineupCsReq, &pb.JoinLineupCsReq{Index: 0, Slot: 2, BaseAvatarId: 1102})
+			s.FromServer(reliquary.JoinLineupScRsp, &pb.JoinLineupScRsp{Retcode: 1})
+		}, nil, "0.0[1001 1002]"},
+		{"quit", func(s *reliquarytest.Session) {
+			s.FromClient(reliquary.QuitLineupCsReq, &pb.QuitLineupCsReq{Index: 0, BaseAvatarId: 1001})
+			s.FromServer(reliquary.QuitLineupScRsp, &pb.QuitLineupScRsp{})
+		}, []string{"0.0[1001 1002] -> 0.0[1002] active"}, "0.0[1002]"},
+		{"swap", func(s *reliquarytest.Session) {
+			s.FromClient(reliquary.SwapLineupCsReq, &pb.SwapLineupCsReq{Index: 0, SrcSlot: 0, DstSlot: 1})
+			s.FromServer(reliquary.SwapLineupScRsp, &pb.SwapLineupScRsp{})
+		}, []string{"0.0[1001 1002] -> 0.0[1002 1001] active"}, "0.0[1002 1001]"},
+		{"replace", func(s *reliquarytest.Session) {
+			s.FromClient(reliquary.ReplaceLineupCsReq, &pb.ReplaceLineupCsReq{Index: 1, LineupSlotList: []*pb.LineupSlotData{
+				{Slot: 1, Id: 1309}, {Slot: 0, Id: 1005},
+			}})
+			s.FromServer(reliquary.ReplaceLineupScRsp, &pb.ReplaceLineupScRsp{})
+		}, []string{"0.1[1102] -> 0.1[1005 1309]"}, "0.0[1001 1002]"},
+		{"unknown lineup", func(s *reliquarytest.Session) {
+			s.FromClient(reliquary.QuitLineupCsReq, &pb.QuitLineupCsReq{Index: 5, BaseAvatarId: 1001})
+			s.FromServer(reliquary.QuitLineupScRsp, &pb.QuitLineupScRsp{})
+		}, nil, "0.0[1001 1002]"},
+		{"switch index", func(s *reliquarytest.Session) {
+			s.FromServer(reliquary.SwitchLineupIndexScRsp, &pb.SwitchLineupIndexScRsp{Index: 1})
+			s.FromServer(reliquary.SwitchLineupIndexScRsp, &pb.SwitchLineupIndexScRsp{Index: 1})
+		}, []string{"0.0[1001 1002] -> 0.1[1102] active switched"}, "0.1[1102]"},
+		{"current lineup", func(s *reliquarytest.Session) {
+			s.FromServer(reliquary.GetCurLineupDataScRsp, &pb.GetCurLineupDataScRsp{Lineup: info(1, 1102, 1005)})
+		}, []string{
+			"0.1[1102] -> 0.1[1102 1005]",
+			"0.0[1001 1002] -> 0.1[1102 1005] active switched",
+		}, "0.1[1102 1005]"},
+		{"sync of another saved lineup", func(s *reliquarytest.Session) {
+			s.FromServer(reliquary.SyncLineupNotify, &pb.SyncLineupNotify{Lineup: info(1, 1102, 1005)})
+		}, []string{"0.1[1102] -> 0.1[1102 1005]"}, "0.0[1001 1002]"},
+		{"game mode", func(s *reliquarytest.Session) {
+			s.FromServer(reliquary.SyncLineupNotify, &pb.SyncLineupNotify{Lineup: challenge(1309)})
+			s.FromServer(reliquary.SyncLineupNotify, &pb.SyncLineupNotify{Lineup: challenge(1309, 1005)})
+		}, []string{
+			"none -> 5.0[1309]",
+			"0.0[1001 1002] -> 5.0[1309] active switched",
+			"5.0[1309] -> 5.0[1309 1005] active",
+		}, "5.0[1309 1005]"},
+		{"leaving game mode", func(s *reliquarytest.Session) {
+			s.FromServer(reliquary.SyncLineupNotify, &pb.SyncLineupNotify{Lineup: challenge(1309)})
+			s.FromServer(reliquary.SyncLineupNotify, &pb.SyncLineupNotify{Lineup: info(1, 1102)})
+		}, []string{
+			"none -> 5.0[1309]",
+			"0.0[1001 1002] -> 5.0[1309] active switched",
+			"5.0[1309] -> 0.1[1102] active switched",
+		}, "0.1[1102]"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tracker := lineup.NewTracker(nil)
+			session := reliquarytest.NewSession()
+			session.FromServer(reliquary.GetAllLineupDataScRsp, &pb.GetAllLineupDataScRsp{
+				LineupList: []*pb.LineupInfo{info(0, 1001, 1002), info(1, 1102)},
+			})
+			apply(t, tracker, session)
+
+			var changes []string
+			tracker.OnChange = func(change lineup.Change) {
+				changes = append(changes, describeChange(change))
+			}
+			script := reliquarytest.NewSession()
+			tt.script(script)
+			apply(t, tracker, script)
+
+			if !slices.Equal(changes, tt.changes) {
+				t.Errorf("changes %q, want %q", changes, tt.changes)
+			}
+			if active, _ := tracker.Active(); describe(active) != tt.active {
+				t.Errorf("active %s, want %s", describe(active), tt.active)
+			}
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package reliquary
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	"net"
+)
+
+const (
+	// LINKTYPE_LINUX_SLL2 is not known to gopacket, and truncated to its lower byte by layers.LinkType
+	LINKTYPE_LINUX_SLL2 = 276
+
+	linuxSLL2HeaderLen = 20
+)
+
+var LayerTypeLinuxSLL2 = gopacket.RegisterLayerType(2276, gopacket.LayerTypeMetadata{
+	Name:    "LinuxSLL2",
+	Decoder: gopacket.DecodeFunc(decodeLinuxSLL2),
+})
+
+// LinkDecoder returns the decoder to use for packets of the given link type, covering the link types
+// gopacket does not decode by itself (LINKTYPE_IPV4, LINKTYPE_IPV6 and LINKTYPE_LINUX_SLL2)
+//
+//	src := gopacket.NewPacketSource(handle, reliquary.LinkDecoder(handle.LinkType()))
+func LinkDecoder(linkType layers.LinkType) gopacket.Decoder {
+	switch linkType {
+	case layers.LinkTypeRaw, layers.LinkTypeIPv4, layers.LinkTypeIPv6:
+		return gopacket.DecodeFunc(decodeRawIP)
+	case layers.LinkType(LINKTYPE_LINUX_SLL2 & 0xFF):
+		return LayerTypeLinuxSLL2
+	default:
+		return linkType
+	}
+}
+
+func decodeRawIP(data []byte, p gopacket.PacketBuilder) error {
+	if len(data) == 0 {
+		return errors.New("empty IP packet")
+	}
+
+	switch data[0] >> 4 {
+	case 4:
+		return layers.LayerTypeIPv4.Decode(data, p)
+	case 6:
+		return layers.LayerTypeIPv6.Decode(data, p)
+	}
+	return fmt.Errorf("invalid IP packet version %d", data[0]>>4)
+}
+
+// LinuxSLL2 is the Linux "cooked" capture header v2, produced by `tcpdump -i any` on newer libpcap
+//
+//	## Bit Layout
+//	| Bit indices     |  Type |  Name |
+//	| - | - | - |
+//	|   0:2      |  `uint16`  |  Protocol type |
+//	|   2:4      |  `uint16`  |  Reserved |
+//	|   4:8      |  `uint32`  |  Interface index |
+//	|   8:10     |  `uint16`  |  ARPHRD type |
+//	|   10:11    |  `uint8`   |  Packet type |
+//	|   11:12    |  `uint8`   |  Address length |
+//	|   12:20    |  `[8]byte` |  Address |
+type LinuxSLL2 struct {
+	layers.BaseLayer
+	EthernetType   layers.EthernetType
+	InterfaceIndex uint32
+	AddrType       uint16
+	PacketType     layers.LinuxSLLPacketType
+	AddrLen        uint8
+	Addr           net.HardwareAddr
+}
+
+func (sll *LinuxSLL2) LayerType() gopacket.LayerType { return LayerTypeLinuxSLL2 }
+
+func (sll *LinuxSLL2) CanDecode() gopacket.LayerClass { return LayerTypeLinuxSLL2 }
+
+func (sll *LinuxSLL2) NextLayerType() gopacket.LayerType { return sll.EthernetType.LayerType() }
+
+func (sll *LinuxSLL2) LinkFlow() gopacket.Flow {
+	return gopacket.NewFlow(layers.EndpointMAC, sll.Addr, nil)
+}
+
+func (sll *LinuxSLL2) DecodeFromBytes(data []byte, df gopacket.DecodeFeedback) error {
+	if len(data) < linuxSLL2HeaderLen {
+		df.SetTruncated()
+		return errors.New("linux SLL2 packet too small")
+	}
+
+	sll.EthernetType = layers.EthernetType(binary.BigEndian.Uint16(data[0:2]))
+	sll.InterfaceIndex = binary.BigEndian.Uint32(data[4:8])
+	sll.AddrType = binary.BigEndian.Uint16(data[8:10])
+	sll.PacketType = layers.LinuxSLLPacketType(data[10])
+	sll.AddrLen = data[11]
+	sll.Addr = net.HardwareAddr(data[12 : 12+min(sll.AddrLen, 8)])
+	sll.BaseLayer = layers.BaseLayer{Contents: data[:linuxSLL2HeaderLen], Payload: data[linuxSLL2HeaderLen:]}
+	return nil
+}
+
+func decodeLinuxSLL2(data []byte, p gopacket.PacketBuilder) error {
+	sll := &LinuxSLL2{}
+	if err := sll.DecodeFromBytes(data, p); err != nil {
+		return err
+	}
+	p.AddLayer(sll)
+	p.SetLinkLayer(sll)
+	return p.NextDecoder(sll.EthernetType)
+}
@@ -0,0 +1,263 @@
+package reliquary
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	"net"
+	"sync"
+	"time"
+)
+
+const DEFAULT_SNAPLEN = 65536
+
+var (
+	NoInterfaceFound  = errors.New("no interface with game traffic found")
+	errCaptureTimeout = errors.New("capture read timed out")
+)
+
+type LiveOptions struct {
+	// Interface to capture on, when empty every interface that is up is probed,
+	// and the first one to see game traffic is used
+	Interface string
+	// IncludeLoopback also probes loopback interfaces
+	IncludeLoopback bool
+	// DetectTimeout limits how long interfaces are probed, 0 waits until the context is done
+	DetectTimeout time.Duration
+	// Ports and AutoDetect are applied to the Sniffer and the capture filter
+	Ports      []PortRange
+	AutoDetect bool
+	SnapLen    int
+	// Promiscuous is only useful when sniffing traffic of another device on the network
+	Promiscuous bool
+	// Sniffer is used to read packets, a new one is created if nil
+	Sniffer *Sniffer
+}
+
+// liveHandle is a backend specific capture, ReadPacketData returns errCaptureTimeout
+// periodically so the context can be checked
+type liveHandle interface {
+	gopacket.PacketDataSource
+	LinkType() layers.LinkType
+	Close()
+}
+
+type Capture struct {
+	Interface string
+	Sniffer   *Sniffer
+	// Packets is closed when the context is done, or the capture fails. Check Err afterward
+	Packets <-chan *CommandsPacket
+
+	handle liveHandle
+	err    error
+}
+
+// Err returns the error that stopped the capture, nil if the context was cancelled
+func (c *Capture) Err() error {
+	return c.err
+}
+
+// Interfaces lists the interfaces Live would probe
+func Interfaces(includeLoopback bool) ([]net.Interface, error) {
+	all, err := net.Interfaces()
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]net.Interface, 0, len(all))
+	for _, iface := range all {
+		if iface.Flags&net.FlagUp == 0 {
+			continue
+		}
+		if iface.Flags&net.FlagLoopback != 0 && !includeLoopback {
+			continue
+		}
+		out = append(out, iface)
+	}
+	return out, nil
+}
+
+// Live starts capturing game traffic. On Linux AF_PACKET is used, other platforms need the `pcap` build tag.
+// The returned Capture stops when ctx is done
+func Live(ctx context.Context, opts LiveOptions) (*Capture, error) {
+	sniffer := opts.Sniffer
+	if sniffer == nil {
+		sniffer = &Sniffer{}
+	}
+	if len(opts.Ports) > 0 {
+		sniffer.SetPorts(opts.Ports...)
+	}
+	if opts.AutoDetect {
+		sniffer.AutoDetectServer()
+	}
+	if opts.SnapLen <= 0 {
+		opts.SnapLen = DEFAULT_SNAPLEN
+	}
+
+	var candidates []net.Interface
+	if opts.Interface != "" {
+		iface, err := net.InterfaceByName(opts.Interface)
+		if err != nil {
+			return nil, err
+		}
+		candidates = []net.Interface{*iface}
+	} else {
+		var err error
+		if candidates, err = Interfaces(opts.IncludeLoopback); err != nil {
+			return nil, err
+		}
+	}
+
+	ranges := sniffer.matcher.portRanges()
+	if opts.AutoDetect {
+		ranges = nil
+	}
+
+	iface, handle, first, err := probe(ctx, candidates, ranges, opts)
+	if err != nil {
+		return nil, err
+	}
+	logger.Info().Str("interface", iface).Msg("capturing game traffic")
+
+	packets := make(chan *CommandsPacket)
+	capture := &Capture{
+		Interface: iface,
+		Sniffer:   sniffer,
+		Packets:   packets,
+		handle:    handle,
+	}
+	go capture.run(ctx, packets, first)
+	return capture, nil
+}
+
+func (c *Capture) run(ctx context.Context, packets chan<- *CommandsPacket, first gopacket.Packet) {
+	defer close(packets)
+	defer c.handle.Close()
+
+	decoder := LinkDecoder(c.handle.LinkType())
+	packet := first
+	for {
+		if packet != nil {
+			gamePacket, err := c.Sniffer.ReadPacket(packet)
+			if err != nil {
+				logger.Debug().Err(err).Msg("could not read packet")
+			} else if commands, ok := gamePacket.(*CommandsPacket); ok {
+				select {
+				case packets <- commands:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+
+		if ctx.Err() != nil {
+			return
+		}
+
+		var err error
+		if packet, err = readLivePacket(c.handle, decoder); err != nil {
+			if errors.Is(err, errCaptureTimeout) {
+				continue
+			}
+			c.err = err
+			return
+		}
+	}
+}
+
+// probe opens every candidate, and returns the first one that sees a packet the Sniffer can parse
+func probe(ctx context.Context, candidates []net.Interface, ranges []PortRange, opts LiveOptions) (string, liveHandle, gopacket.Packet, error) {
+	if len(candidates) == 0 {
+		return "", nil, nil, NoInterfaceFound
+	}
+
+	probeCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	if opts.DetectTimeout > 0 && len(candidates) > 1 {
+		probeCtx, cancel = context.WithTimeout(probeCtx, opts.DetectTimeout)
+		defer cancel()
+	}
+
+	type result struct {
+		iface  string
+		handle liveHandle
+		first  gopacket.Packet
+	}
+
+	var once sync.Once
+	var wg sync.WaitGroup
+	found := make(chan result, 1)
+	errs := make(chan error, len(candidates))
+
+	for _, iface := range candidates {
+		handle, err := openLive(iface, ranges, opts)
+		if err != nil {
+			logger.Debug().Err(err).Str("interface", iface.Name).Msg("could not open interface")
+			errs <- fmt.Errorf("%s: %w", iface.Name, err)
+			continue
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			decoder := LinkDecoder(handle.LinkType())
+			matcher := &serverMatcher{ranges: ranges, autoDetect: opts.AutoDetect}
+			for probeCtx.Err() == nil {
+				packet, err := readLivePacket(handle, decoder)
+				if errors.Is(err, errCaptureTimeout) {
+					continue
+				}
+				if err != nil {
+					errs <- fmt.Errorf("%s: %w", iface.Name, err)
+					break
+				}
+				// With auto-detect the direction is only known after a handshake was seen
+				if connPacket, err := parseConnectionPacket(packet, matcher); err != nil || connPacket.Direction == Unknown {
+					continue
+				}
+
+				won := false
+				once.Do(func() {
+					found <- result{iface: iface.Name, handle: handle, first: packet}
+					won = true
+				})
+				if won {
+					cancel()
+					return
+				}
+				break
+			}
+			handle.Close()
+		}()
+	}
+
+	wg.Wait()
+	select {
+	case r := <-found:
+		return r.iface, r.handle, r.first, nil
+	default:
+	}
+
+	close(errs)
+	joined := []error{NoInterfaceFound}
+	for err := range errs {
+		joined = append(joined, err)
+	}
+	if err := ctx.Err(); err != nil {
+		joined = append(joined, err)
+	}
+	return "", nil, nil, errors.Join(joined...)
+}
+
+func readLivePacket(handle liveHandle, decoder gopacket.Decoder) (gopacket.Packet, error) {
+	data, ci, err := handle.ReadPacketData()
+	if err != nil {
+		return nil, err
+	}
+
+	packet := gopacket.NewPacket(data, decoder, gopacket.Default)
+	packet.Metadata().CaptureInfo = ci
+	return packet, nil
+}
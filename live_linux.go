@@ -0,0 +1,131 @@
+//go:build linux && !pcap
+
+package reliquary
+
+import (
+	"errors"
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	"golang.org/x/sys/unix"
+	"net"
+	"sync/atomic"
+	"time"
+)
+
+const afPacketReadTimeout = 250 * time.Millisecond
+
+// afPacketHandle is a minimal AF_PACKET capture, so live capture on Linux works without libpcap
+type afPacketHandle struct {
+	fd       int
+	loopback bool
+	linkType layers.LinkType
+	snapLen  int
+	buf      []byte
+	closed   atomic.Bool
+}
+
+func openLive(iface net.Interface, ranges []PortRange, opts LiveOptions) (liveHandle, error) {
+	fd, err := unix.Socket(unix.AF_PACKET, unix.SOCK_RAW|unix.SOCK_CLOEXEC, int(htons(unix.ETH_P_ALL)))
+	if err != nil {
+		return nil, err
+	}
+
+	// Interfaces without a hardware address (tun, wireguard) deliver raw IP packets
+	linkType, linkHeaderLen := layers.LinkTypeEthernet, uint32(14)
+	if len(iface.HardwareAddr) == 0 && iface.Flags&net.FlagLoopback == 0 {
+		linkType, linkHeaderLen = layers.LinkTypeRaw, 0
+	}
+
+	filter, err := compilePortFilter(linkHeaderLen, ranges)
+	if err != nil {
+		_ = unix.Close(fd)
+		return nil, err
+	}
+	prog := make([]unix.SockFilter, len(filter))
+	for i, inst := range filter {
+		prog[i] = unix.SockFilter{Code: inst.Op, Jt: inst.Jt, Jf: inst.Jf, K: inst.K}
+	}
+	if err = unix.SetsockoptSockFprog(fd, unix.SOL_SOCKET, unix.SO_ATTACH_FILTER, &unix.SockFprog{
+		Len:    uint16(len(prog)),
+		Filter: &prog[0],
+	}); err != nil {
+		_ = unix.Close(fd)
+		return nil, err
+	}
+
+	if err = unix.Bind(fd, &unix.SockaddrLinklayer{Protocol: htons(unix.ETH_P_ALL), Ifindex: iface.Index}); err != nil {
+		_ = unix.Close(fd)
+		return nil, err
+	}
+
+	tv := unix.NsecToTimeval(afPacketReadTimeout.Nanoseconds())
+	if err = unix.SetsockoptTimeval(fd, unix.SOL_SOCKET, unix.SO_RCVTIMEO, &tv); err != nil {
+		_ = unix.Close(fd)
+		return nil, err
+	}
+
+	if opts.Promiscuous {
+		mreq := unix.PacketMreq{Ifindex: int32(iface.Index), Type: unix.PACKET_MR_PROMISC}
+		if err = unix.SetsockoptPacketMreq(fd, unix.SOL_PACKET, unix.PACKET_ADD_MEMBERSHIP, &mreq); err != nil {
+			_ = unix.Close(fd)
+			return nil, err
+		}
+	}
+
+	return &afPacketHandle{
+		fd:       fd,
+		loopback: iface.Flags&net.FlagLoopback != 0,
+		linkType: linkType,
+		snapLen:  opts.SnapLen,
+		buf:      make([]byte, opts.SnapLen),
+	}, nil
+}
+
+func (h *afPacketHandle) ReadPacketData() ([]byte, gopacket.CaptureInfo, error) {
+	if h.closed.Load() {
+		return nil, gopacket.CaptureInfo{}, unix.EBADF
+	}
+
+	var n int
+	for {
+		var from unix.Sockaddr
+		var err error
+		n, from, err = unix.Recvfrom(h.fd, h.buf, unix.MSG_TRUNC)
+		if err != nil {
+			if errors.Is(err, unix.EAGAIN) || errors.Is(err, unix.EINTR) {
+				return nil, gopacket.CaptureInfo{}, errCaptureTimeout
+			}
+			return nil, gopacket.CaptureInfo{}, err
+		}
+
+		// Loopback delivers every packet twice, once outgoing and once incoming. libpcap skips the outgoing copy as well
+		if ll, ok := from.(*unix.SockaddrLinklayer); ok && h.loopback && ll.Pkttype == unix.PACKET_OUTGOING {
+			continue
+		}
+		break
+	}
+
+	captured := min(n, len(h.buf))
+	data := make([]byte, captured)
+	copy(data, h.buf[:captured])
+	return data, gopacket.CaptureInfo{
+		Timestamp:     time.Now(),
+		CaptureLength: captured,
+		Length:        n,
+	}, nil
+}
+
+func (h *afPacketHandle) LinkType() layers.LinkType {
+	return h.linkType
+}
+
+func (h *afPacketHandle) Close() {
+	if h.closed.Swap(true) {
+		return
+	}
+	_ = unix.Close(h.fd)
+}
+
+func htons(v uint16) uint16 {
+	return v<<8 | v>>8
+}
@@ -0,0 +1,86 @@
+//go:build linux && !pcap
+
+package reliquary
+
+import (
+	"errors"
+	"fmt"
+	"github.com/google/gopacket/layers"
+	"net"
+	"os"
+	"testing"
+	"time"
+)
+
+// TestAfPacketLoopback checks every datagram on loopback is captured exactly once, the kernel hands AF_PACKET
+// sockets both the outgoing and the incoming copy. Needs root or CAP_NET_RAW
+func TestAfPacketLoopback(t *testing.T) {
+	var loopback *net.Interface
+	ifaces, err := Interfaces(true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, iface := range ifaces {
+		if iface.Flags&net.FlagLoopback != 0 {
+			loopback = &iface
+			break
+		}
+	}
+	if loopback == nil {
+		t.Skip("no loopback interface")
+	}
+
+	server, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer server.Close()
+	port := uint16(server.LocalAddr().(*net.UDPAddr).Port)
+
+	handle, err := openLive(*loopback, []PortRange{{Start: port, End: port}}, LiveOptions{SnapLen: DEFAULT_SNAPLEN})
+	if errors.Is(err, os.ErrPermission) {
+		t.Skip("capturing needs root or CAP_NET_RAW")
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer handle.Close()
+
+	client, err := net.DialUDP("udp", nil, server.LocalAddr().(*net.UDPAddr))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	const sent = 5
+	for i := range sent {
+		if _, err = fmt.Fprintf(client, "datagram %d", i); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	seen := make(map[string]int)
+	decoder := LinkDecoder(handle.LinkType())
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		packet, err := readLivePacket(handle, decoder)
+		if errors.Is(err, errCaptureTimeout) {
+			continue
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if udp, ok := packet.Layer(layers.LayerTypeUDP).(*layers.UDP); ok && uint16(udp.DstPort) == port {
+			seen[string(udp.Payload)]++
+		}
+	}
+
+	if len(seen) != sent {
+		t.Fatalf("captured %d of %d datagrams", len(seen), sent)
+	}
+	for payload, count := range seen {
+		if count != 1 {
+			t.Errorf("captured %q %d times", payload, count)
+		}
+	}
+}
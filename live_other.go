@@ -0,0 +1,12 @@
+//go:build !linux && !pcap
+
+package reliquary
+
+import (
+	"errors"
+	"net"
+)
+
+func openLive(iface net.Interface, ranges []PortRange, opts LiveOptions) (liveHandle, error) {
+	return nil, errors.New("live capture on this platform requires building with the pcap tag")
+}
@@ -0,0 +1,37 @@
+//go:build pcap
+
+package reliquary
+
+import (
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/pcap"
+	"net"
+	"time"
+)
+
+const pcapReadTimeout = 250 * time.Millisecond
+
+type pcapHandle struct {
+	*pcap.Handle
+}
+
+func openLive(iface net.Interface, ranges []PortRange, opts LiveOptions) (liveHandle, error) {
+	handle, err := pcap.OpenLive(iface.Name, int32(opts.SnapLen), opts.Promiscuous, pcapReadTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = handle.SetBPFFilter(PcapFilter(ranges...)); err != nil {
+		handle.Close()
+		return nil, err
+	}
+	return pcapHandle{handle}, nil
+}
+
+func (h pcapHandle) ReadPacketData() ([]byte, gopacket.CaptureInfo, error) {
+	data, ci, err := h.Handle.ReadPacketData()
+	if err == pcap.NextErrorTimeoutExpired {
+		return nil, ci, errCaptureTimeout
+	}
+	return data, ci, err
+}
@@ -1,7 +1,5 @@
 package reliquary
 
-import "encoding/binary"
-
 type GamePacket interface {
 	isGamePacket()
 	PacketType() PacketType
@@ -16,8 +14,3 @@ const (
 	// The CommandsPacket will return from the Sniffer at a later point
 	ContinuePacketType PacketType = iota
 )
-
-func packetCode(payload []byte) uint32 {
-	codeBytes := payload[:4]
-	return binary.BigEndian.Uint32(codeBytes)
-}
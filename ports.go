@@ -0,0 +1,137 @@
+package reliquary
+
+import (
+	"fmt"
+	"net/netip"
+	"strings"
+)
+
+// PortRange is an inclusive range of server ports, a single port has Start == End
+type PortRange struct {
+	Start uint16
+	End   uint16
+}
+
+func (pr PortRange) Contains(port uint16) bool {
+	return port >= pr.Start && port <= pr.End
+}
+
+func (pr PortRange) String() string {
+	if pr.Start == pr.End {
+		return fmt.Sprintf("%d", pr.Start)
+	}
+	return fmt.Sprintf("%d-%d", pr.Start, pr.End)
+}
+
+// portStrings lists every port in the ranges
+func portStrings(ranges []PortRange) []string {
+	var ports []string
+	for _, pr := range ranges {
+		for port := uint32(pr.Start); port <= uint32(pr.End); port++ {
+			ports = append(ports, fmt.Sprintf("%d", port))
+		}
+	}
+	return ports
+}
+
+// Endpoint is one side of a UDP conversation
+type Endpoint struct {
+	Addr netip.Addr
+	Port uint16
+}
+
+func (e Endpoint) String() string {
+	return netip.AddrPortFrom(e.Addr, e.Port).String()
+}
+
+// pcapFragments matches IP fragments after the first, they carry no UDP header to filter on ports with.
+// IPv6 fragments are all accepted, the UDP header is behind the fragment header
+const pcapFragments = "(ip proto 17 and ip[6:2] & 0x1fff != 0) or ip6[6] == 44"
+
+// PcapFilter returns a BPF filter matching UDP traffic on any of the given ranges, and any IP fragments
+// An empty list matches all UDP traffic
+func PcapFilter(ranges ...PortRange) string {
+	if len(ranges) == 0 {
+		return "udp or " + pcapFragments
+	}
+
+	parts := make([]string, 0, len(ranges))
+	for _, pr := range ranges {
+		if pr.Start == pr.End {
+			parts = append(parts, fmt.Sprintf("port %d", pr.Start))
+		} else {
+			parts = append(parts, fmt.Sprintf("portrange %d-%d", pr.Start, pr.End))
+		}
+	}
+
+	if len(parts) == 1 {
+		return "(udp " + parts[0] + ") or " + pcapFragments
+	}
+	return "(udp and (" + strings.Join(parts, " or ") + ")) or " + pcapFragments
+}
+
+// serverMatcher decides which side of a UDP packet is the game server
+type serverMatcher struct {
+	ranges     []PortRange
+	autoDetect bool
+	server     *Endpoint
+}
+
+func (m *serverMatcher) portRanges() []PortRange {
+	if len(m.ranges) == 0 {
+		return DEFAULT_PORTS
+	}
+	return m.ranges
+}
+
+func (m *serverMatcher) isServerPort(port uint16) bool {
+	for _, pr := range m.portRanges() {
+		if pr.Contains(port) {
+			return true
+		}
+	}
+	return false
+}
+
+// learn records dst as the server endpoint, called for each HandshakeRequested when auto-detecting
+func (m *serverMatcher) learn(dst Endpoint) {
+	if !m.autoDetect {
+		return
+	}
+	if m.server != nil && *m.server == dst {
+		return
+	}
+
+	logger.Info().Str("server", dst.String()).Msg("learned server endpoint from handshake")
+	m.server = &dst
+}
+
+func (m *serverMatcher) direction(src, dst Endpoint) Direction {
+	if m.autoDetect {
+		switch {
+		case m.server == nil:
+			logger.Debug().
+				Str("src", src.String()).
+				Str("dst", dst.String()).
+				Msg("no server endpoint learned yet")
+			return Unknown
+		case *m.server == dst:
+			return Received
+		case *m.server == src:
+			return Send
+		}
+	} else {
+		if m.isServerPort(dst.Port) {
+			return Received
+		}
+		if m.isServerPort(src.Port) {
+			return Send
+		}
+	}
+
+	logger.Warn().
+		Str("dst", dst.String()).
+		Str("src", src.String()).
+		Msg("packet found with unknown direction")
+	return Unknown
+}
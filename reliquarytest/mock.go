@@ -0,0 +1,526 @@
+package reliquarytest
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"github.com/Fesaa/go-reliquary"
+	"github.com/Fesaa/go-reliquary/pb"
+	"github.com/fatedier/kcp-go"
+	"github.com/google/gopacket"
+	"google.golang.org/protobuf/proto"
+	"io"
+	"net"
+	"net/netip"
+	"sync"
+	"time"
+)
+
+// MOCK_SERVER_ADDR is where NewMockServer listens by default, on the first of the game's default ports
+const MOCK_SERVER_ADDR = "127.0.0.1:23301"
+
+// Reply is a command the MockServer answers a request with
+type Reply struct {
+	Id  uint16
+	Msg proto.Message
+}
+
+// Handler answers a request from the client, returning no replies is fine
+type Handler func(request *reliquary.GameCommand) []Reply
+
+// mockPeer is one side of a mock conversation, it segments outgoing commands and reassembles incoming ones.
+// Loopback doesn't lose packets, so there are no retransmits
+type mockPeer struct {
+	segmenter   reliquary.KcpSegmenter
+	dispatchKey *reliquary.Key
+	sessionKey  *reliquary.Key
+	start       time.Time
+
+	received map[uint32]mockSegment
+	message  []byte
+}
+
+type mockSegment struct {
+	frg     byte
+	content []byte
+}
+
+func newMockPeer(conv, token uint32, dispatchKey *reliquary.Key) (*mockPeer, error) {
+	if dispatchKey == nil {
+		var err error
+		if dispatchKey, err = reliquary.EmbeddedKeyStore().Key(DEFAULT_VERSION); err != nil {
+			return nil, err
+		}
+	}
+	return &mockPeer{
+		segmenter:   reliquary.KcpSegmenter{Conv: conv, Token: token},
+		dispatchKey: dispatchKey,
+		start:       time.Now(),
+		received:    make(map[uint32]mockSegment),
+	}, nil
+}
+
+func (p *mockPeer) key() (*reliquary.Key, reliquary.KeySource) {
+	if p.sessionKey != nil {
+		return p.sessionKey, reliquary.SessionKey
+	}
+	return p.dispatchKey, reliquary.DispatchKey
+}
+
+// encode returns the datagrams of a single command, and the Command for the log
+func (p *mockPeer) encode(fromClient bool, id uint16, msg proto.Message) ([][]byte, Command, error) {
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		return nil, Command{}, fmt.Errorf("could not marshal command %d: %w", id, err)
+	}
+	framed, err := reliquary.EncodeCommandData(id, nil, data)
+	if err != nil {
+		return nil, Command{}, err
+	}
+
+	key, source := p.key()
+	segments, err := p.segmenter.Segments(key.Encrypt(framed), uint32(time.Since(p.start).Milliseconds()))
+	if err != nil {
+		return nil, Command{}, err
+	}
+
+	// The response carrying the seed is the last command encrypted with the dispatch key
+	if rsp, ok := msg.(*pb.PlayerGetTokenScRsp); ok && id == reliquary.PlayerGetTokenScRsp {
+		p.sessionKey = reliquary.NewKey(reliquary.KeyFromSeed(rsp.SecretKeySeed))
+	}
+	return segments, Command{FromClient: fromClient, Id: id, ProtoData: data, KeySource: source}, nil
+}
+
+// receive reassembles the PUSH segments in a datagram, and returns every command that completed.
+// Commands that don't decrypt are dropped
+func (p *mockPeer) receive(fromClient bool, datagram []byte) ([]*reliquary.GameCommand, []Command) {
+	for len(datagram) >= reliquary.KCP_HEADER_LEN {
+		length := int(binary.LittleEndian.Uint32(datagram[24:28]))
+		if length > len(datagram)-reliquary.KCP_HEADER_LEN {
+			break
+		}
+
+		sn := binary.LittleEndian.Uint32(datagram[16:20])
+		if datagram[8] == kcp.IKCP_CMD_PUSH && sn >= p.segmenter.Una {
+			content := datagram[reliquary.KCP_HEADER_LEN : reliquary.KCP_HEADER_LEN+length]
+			p.received[sn] = mockSegment{frg: datagram[9], content: append([]byte(nil), content...)}
+		}
+		datagram = datagram[reliquary.KCP_HEADER_LEN+length:]
+	}
+
+	var commands []*reliquary.GameCommand
+	var log []Command
+	for {
+		segment, ok := p.received[p.segmenter.Una]
+		if !ok {
+			break
+		}
+		delete(p.received, p.segmenter.Una)
+		p.segmenter.Una++
+
+		p.message = append(p.message, segment.content...)
+		if segment.frg != 0 {
+			continue
+		}
+
+		key, source := p.key()
+		command, err := reliquary.DecodeCommand(key.Decrypt(p.message))
+		p.message = nil
+		if err != nil {
+			continue
+		}
+
+		if command.Id == reliquary.PlayerGetTokenScRsp {
+			rsp := &pb.PlayerGetTokenScRsp{}
+			if err = proto.Unmarshal(command.ProtoData, rsp); err == nil {
+				p.sessionKey = reliquary.NewKey(reliquary.KeyFromSeed(rsp.SecretKeySeed))
+			}
+		}
+
+		command.KeySource = source
+		commands = append(commands, command)
+		log = append(log, Command{
+			FromClient: fromClient,
+			Id:         command.Id,
+			HeaderData: command.HeaderData,
+			ProtoData:  command.ProtoData,
+			KeySource:  source,
+		})
+	}
+	return commands, log
+}
+
+// MockServer speaks the game's protocol over UDP, answering a single client at a time.
+// It records every datagram it sends or receives, so a capture is available without any privileges
+type MockServer struct {
+	Conv  uint32
+	Token uint32
+	// Seed is send in PlayerGetTokenScRsp by the default login handler
+	Seed uint64
+	// DispatchKey encrypts commands until the login, defaults to the key of DEFAULT_VERSION
+	DispatchKey *reliquary.Key
+
+	conn *net.UDPConn
+	addr netip.AddrPort
+
+	mu       sync.Mutex
+	handlers map[uint16]Handler
+	client   netip.AddrPort
+	peer     *mockPeer
+	frames   []Frame
+	commands []Command
+}
+
+// NewMockServer listens on addr, MOCK_SERVER_ADDR when empty. Call Serve to start answering
+func NewMockServer(addr string) (*MockServer, error) {
+	if addr == "" {
+		addr = MOCK_SERVER_ADDR
+	}
+	udpAddr, err := net.ResolveUDPAddr("udp", addr)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := net.ListenUDP("udp", udpAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &MockServer{
+		Conv:     0x12345678,
+		Token:    0x0BADF00D,
+		Seed:     0x0123456789ABCDEF,
+		conn:     conn,
+		addr:     conn.LocalAddr().(*net.UDPAddr).AddrPort(),
+		handlers: make(map[uint16]Handler),
+	}
+	s.handlers[reliquary.PlayerGetTokenCsReq] = s.login
+	return s, nil
+}
+
+func (s *MockServer) login(request *reliquary.GameCommand) []Reply {
+	req := &pb.PlayerGetTokenCsReq{}
+	if err := proto.Unmarshal(request.ProtoData, req); err != nil {
+		return nil
+	}
+	return []Reply{{Id: reliquary.PlayerGetTokenScRsp, Msg: &pb.PlayerGetTokenScRsp{Uid: req.Uid, SecretKeySeed: s.Seed}}}
+}
+
+// Addr returns the address the server listens on
+func (s *MockServer) Addr() netip.AddrPort {
+	return s.addr
+}
+
+// Handle sets the handler for requests with the given id, replacing the default login handler is allowed
+func (s *MockServer) Handle(id uint16, handler Handler) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.handlers[id] = handler
+}
+
+// Serve answers the client until ctx is done or the server is closed
+func (s *MockServer) Serve(ctx context.Context) error {
+	stop := context.AfterFunc(ctx, func() {
+		_ = s.conn.SetReadDeadline(time.Now())
+	})
+	defer stop()
+
+	buf := make([]byte, 65536)
+	for {
+		n, from, err := s.conn.ReadFromUDPAddrPort(buf)
+		if ctx.Err() != nil || errors.Is(err, net.ErrClosed) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err = s.handleDatagram(from, append([]byte(nil), buf[:n]...)); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *MockServer) handleDatagram(from netip.AddrPort, payload []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.record(true, from, s.addr, payload)
+
+	if len(payload) == reliquary.HANDSHAKE_LEN {
+		handshake, err := reliquary.DecodeHandshake(payload)
+		if err != nil {
+			return nil
+		}
+
+		switch t, _ := handshake.Type(); t {
+		case reliquary.HandshakeRequested:
+			if s.peer, err = newMockPeer(s.Conv, s.Token, s.DispatchKey); err != nil {
+				return err
+			}
+			s.client = from
+
+			established, err := reliquary.NewHandshake(reliquary.HandshakeEstablished, s.Conv, s.Token, handshake.Data)
+			if err != nil {
+				return err
+			}
+			return s.send(reliquary.EncodeHandshake(established))
+		case reliquary.Disconnected:
+			if from == s.client {
+				s.client, s.peer = netip.AddrPort{}, nil
+			}
+		}
+		return nil
+	}
+
+	if s.peer == nil || from != s.client {
+		return nil
+	}
+
+	requests, log := s.peer.receive(true, payload)
+	s.commands = append(s.commands, log...)
+	for _, request := range requests {
+		handler, ok := s.handlers[request.Id]
+		if !ok {
+			continue
+		}
+		for _, reply := range handler(request) {
+			datagrams, command, err := s.peer.encode(false, reply.Id, reply.Msg)
+			if err != nil {
+				return err
+			}
+			s.commands = append(s.commands, command)
+			for _, datagram := range datagrams {
+				if err = s.send(datagram); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (s *MockServer) send(payload []byte) error {
+	s.record(false, s.addr, s.client, payload)
+	_, err := s.conn.WriteToUDPAddrPort(payload, s.client)
+	return err
+}
+
+func (s *MockServer) record(fromClient bool, src, dst netip.AddrPort, payload []byte) {
+	frames, err := udpFrames(fromClient, src, dst, payload, 0, time.Now())
+	if err == nil {
+		s.frames = append(s.frames, frames...)
+	}
+}
+
+// Close stops Serve
+func (s *MockServer) Close() error {
+	return s.conn.Close()
+}
+
+// Commands returns every command exchanged so far, in the order the server handled them
+func (s *MockServer) Commands() []Command {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Command(nil), s.commands...)
+}
+
+// Frames returns every datagram the server send or received so far as Ethernet frames
+func (s *MockServer) Frames() []Frame {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Frame(nil), s.frames...)
+}
+
+// Packets decodes the frames, ready for Sniffer.ReadPacket
+func (s *MockServer) Packets() []gopacket.Packet {
+	return decodeFrames(s.Frames())
+}
+
+// WritePcapng writes the frames as a pcapng capture with an Ethernet link type
+func (s *MockServer) WritePcapng(w io.Writer) error {
+	return writePcapng(w, s.Frames())
+}
+
+// Capture starts reliquary.Live on the loopback interface, returning once the capture sees traffic to the server.
+// Until then throwaway Disconnected packets are sent to the server, so nothing the client sends afterward is missed.
+// AF_PACKET needs root or CAP_NET_RAW
+func (s *MockServer) Capture(ctx context.Context, sniffer *reliquary.Sniffer) (*reliquary.Capture, error) {
+	ifaces, err := reliquary.Interfaces(true)
+	if err != nil {
+		return nil, err
+	}
+	loopback := ""
+	for _, iface := range ifaces {
+		if iface.Flags&net.FlagLoopback != 0 {
+			loopback = iface.Name
+			break
+		}
+	}
+	if loopback == "" {
+		return nil, reliquary.NoInterfaceFound
+	}
+
+	conn, err := net.DialUDP("udp", nil, net.UDPAddrFromAddrPort(s.addr))
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	probeCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		disconnect, _ := reliquary.NewHandshake(reliquary.Disconnected, 0, 0, 0)
+		ticker := time.NewTicker(50 * time.Millisecond)
+		defer ticker.Stop()
+		for {
+			_, _ = conn.Write(reliquary.EncodeHandshake(disconnect))
+			select {
+			case <-probeCtx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return reliquary.Live(ctx, reliquary.LiveOptions{
+		Interface: loopback,
+		Ports:     []reliquary.PortRange{{Start: s.addr.Port(), End: s.addr.Port()}},
+		Sniffer:   sniffer,
+	})
+}
+
+// MockClient talks to a MockServer, or anything else speaking the game's protocol.
+// It's not safe for concurrent use
+type MockClient struct {
+	Uid uint32
+
+	conn   *net.UDPConn
+	peer   *mockPeer
+	queued []*reliquary.GameCommand
+}
+
+// DialMock connects to server and completes the handshake. dispatchKey encrypts commands until the login,
+// nil uses the key of DEFAULT_VERSION
+func DialMock(ctx context.Context, server netip.AddrPort, dispatchKey *reliquary.Key) (*MockClient, error) {
+	conn, err := net.DialUDP("udp", nil, net.UDPAddrFromAddrPort(server))
+	if err != nil {
+		return nil, err
+	}
+	c := &MockClient{Uid: 100000001, conn: conn}
+
+	requested, err := reliquary.NewHandshake(reliquary.HandshakeRequested, 0, 0, 1234567890)
+	if err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	if _, err = conn.Write(reliquary.EncodeHandshake(requested)); err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+
+	for {
+		payload, err := c.read(ctx)
+		if err != nil {
+			_ = conn.Close()
+			return nil, err
+		}
+		handshake, err := reliquary.DecodeHandshake(payload)
+		if err != nil {
+			continue
+		}
+		if t, _ := handshake.Type(); t != reliquary.HandshakeEstablished {
+			continue
+		}
+
+		if c.peer, err = newMockPeer(handshake.Conv, handshake.Token, dispatchKey); err != nil {
+			_ = conn.Close()
+			return nil, err
+		}
+		return c, nil
+	}
+}
+
+func (c *MockClient) read(ctx context.Context) ([]byte, error) {
+	stop := context.AfterFunc(ctx, func() {
+		_ = c.conn.SetReadDeadline(time.Now())
+	})
+	defer stop()
+
+	buf := make([]byte, 65536)
+	n, err := c.conn.Read(buf)
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	if err != nil {
+		return nil, err
+	}
+	return buf[:n], nil
+}
+
+// Send sends a single command to the server
+func (c *MockClient) Send(id uint16, msg proto.Message) error {
+	datagrams, _, err := c.peer.encode(true, id, msg)
+	if err != nil {
+		return err
+	}
+	for _, datagram := range datagrams {
+		if _, err = c.conn.Write(datagram); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Receive returns the next command from the server
+func (c *MockClient) Receive(ctx context.Context) (*reliquary.GameCommand, error) {
+	for len(c.queued) == 0 {
+		payload, err := c.read(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if len(payload) == reliquary.HANDSHAKE_LEN {
+			continue
+		}
+		commands, _ := c.peer.receive(false, payload)
+		c.queued = append(c.queued, commands...)
+	}
+
+	command := c.queued[0]
+	c.queued = c.queued[1:]
+	return command, nil
+}
+
+// Request sends a command and returns the first command the server answers with
+func (c *MockClient) Request(ctx context.Context, id uint16, msg proto.Message) (*reliquary.GameCommand, error) {
+	if err := c.Send(id, msg); err != nil {
+		return nil, err
+	}
+	return c.Receive(ctx)
+}
+
+// Login sends PlayerGetTokenCsReq and returns the seed of the session key, which is used for every later command
+func (c *MockClient) Login(ctx context.Context) (uint64, error) {
+	command, err := c.Request(ctx, reliquary.PlayerGetTokenCsReq, &pb.PlayerGetTokenCsReq{Uid: c.Uid})
+	if err != nil {
+		return 0, err
+	}
+	if command.Id != reliquary.PlayerGetTokenScRsp {
+		return 0, fmt.Errorf("expected PlayerGetTokenScRsp, got command %d", command.Id)
+	}
+
+	rsp := &pb.PlayerGetTokenScRsp{}
+	if err = proto.Unmarshal(command.ProtoData, rsp); err != nil {
+		return 0, err
+	}
+	return rsp.SecretKeySeed, nil
+}
+
+// Close sends a Disconnected packet and closes the connection
+func (c *MockClient) Close() error {
+	if c.peer != nil {
+		disconnect, err := reliquary.NewHandshake(reliquary.Disconnected, c.peer.segmenter.Conv, c.peer.segmenter.Token, 0)
+		if err == nil {
+			_, _ = c.conn.Write(reliquary.EncodeHandshake(disconnect))
+		}
+	}
+	return c.conn.Close()
+}
@@ -0,0 +1,104 @@
+package reliquarytest_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"github.com/Fesaa/go-reliquary"
+	"github.com/Fesaa/go-reliquary/pb"
+	"github.com/Fesaa/go-reliquary/reliquarytest"
+	"os"
+	"runtime"
+	"testing"
+	"time"
+)
+
+// TestLiveCapture runs a scripted session over loopback and compares what Live decoded with what the server exchanged.
+// Capturing needs AF_PACKET, so it only runs on Linux as root or with CAP_NET_RAW
+func TestLiveCapture(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skip("live capture tests need AF_PACKET")
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	server, err := reliquarytest.NewMockServer("127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer server.Close()
+
+	// Large enough to be split into several segments
+	bag := &pb.GetBagScRsp{}
+	for i := range uint32(200) {
+		bag.RelicList = append(bag.RelicList, &pb.Relic{ID: 61011, UniqueID: i + 1, Level: 15, MainAffixID: 1})
+	}
+	server.Handle(reliquary.GetBagCsReq, func(*reliquary.GameCommand) []reliquarytest.Reply {
+		return []reliquarytest.Reply{{Id: reliquary.GetBagScRsp, Msg: bag}}
+	})
+	go func() {
+		_ = server.Serve(ctx)
+	}()
+
+	capture, err := server.Capture(ctx, nil)
+	if errors.Is(err, os.ErrPermission) {
+		t.Skip("capturing needs root or CAP_NET_RAW")
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client, err := reliquarytest.DialMock(ctx, server.Addr(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if seed, err := client.Login(ctx); err != nil || seed != server.Seed {
+		t.Fatalf("login returned seed %d: %v", seed, err)
+	}
+	if _, err = client.Request(ctx, reliquary.GetBagCsReq, &pb.GetBagCsReq{}); err != nil {
+		t.Fatal(err)
+	}
+	if err = client.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	want := server.Commands()
+	if len(want) != 4 {
+		t.Fatalf("server exchanged %d commands, want 4", len(want))
+	}
+
+	// Keep reading a while after the last command, a capture seeing packets twice would return more
+	var got []reliquary.GameCommand
+	settle := time.After(time.Hour)
+read:
+	for {
+		select {
+		case packet, ok := <-capture.Packets:
+			if !ok {
+				t.Fatalf("capture stopped: %v", capture.Err())
+			}
+			got = append(got, packet.Commands...)
+			if len(got) == len(want) {
+				settle = time.After(500 * time.Millisecond)
+			}
+		case <-settle:
+			break read
+		case <-ctx.Done():
+			t.Fatalf("captured %d of %d commands", len(got), len(want))
+		}
+	}
+
+	if len(got) != len(want) {
+		t.Fatalf("captured %d commands, want %d", len(got), len(want))
+	}
+	for i := range want {
+		switch {
+		case got[i].Id != want[i].Id:
+			t.Errorf("command %d: id %d, want %d", i, got[i].Id, want[i].Id)
+		case got[i].KeySource != want[i].KeySource:
+			t.Errorf("command %d: decrypted with %s, want %s", i, got[i].KeySource, want[i].KeySource)
+		case !bytes.Equal(got[i].ProtoData, want[i].ProtoData):
+			t.Errorf("command %d: data differs", i)
+		}
+	}
+}
@@ -0,0 +1,234 @@
+package reliquarytest
+
+import (
+	"encoding/binary"
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	"github.com/google/gopacket/pcapgo"
+	"io"
+	"net"
+	"net/netip"
+	"time"
+)
+
+var (
+	clientMac = net.HardwareAddr{0x02, 0x00, 0x00, 0x00, 0x00, 0x01}
+	serverMac = net.HardwareAddr{0x02, 0x00, 0x00, 0x00, 0x00, 0x02}
+)
+
+// Frame is a single captured Ethernet frame
+type Frame struct {
+	CaptureInfo gopacket.CaptureInfo
+	Data        []byte
+}
+
+// Impairment changes how the segments of a single command are put on the wire
+type Impairment func(*impairment)
+
+type impairment struct {
+	reorder   bool
+	duplicate bool
+	lost      []int
+	mtu       int
+}
+
+// Reordered sends the segments of a command in reverse order
+func Reordered() Impairment {
+	return func(i *impairment) { i.reorder = true }
+}
+
+// Duplicated sends every segment of a command twice
+func Duplicated() Impairment {
+	return func(i *impairment) { i.duplicate = true }
+}
+
+// Lost drops the first transmission of the given segments, they're retransmitted after the other segments
+func Lost(segments ...int) Impairment {
+	return func(i *impairment) { i.lost = append(i.lost, segments...) }
+}
+
+// Fragmented splits every datagram into IP fragments of at most mtu bytes
+func Fragmented(mtu int) Impairment {
+	return func(i *impairment) { i.mtu = mtu }
+}
+
+func (i impairment) schedule(segments [][]byte) [][]byte {
+	ordered := append([][]byte(nil), segments...)
+	if i.reorder {
+		for l, r := 0, len(ordered)-1; l < r; l, r = l+1, r-1 {
+			ordered[l], ordered[r] = ordered[r], ordered[l]
+		}
+	}
+
+	var out, retransmit [][]byte
+	for idx, segment := range ordered {
+		lost := false
+		for _, l := range i.lost {
+			lost = lost || l == idx
+		}
+		if lost {
+			retransmit = append(retransmit, segment)
+			continue
+		}
+
+		out = append(out, segment)
+		if i.duplicate {
+			out = append(out, segment)
+		}
+	}
+	return append(out, retransmit...)
+}
+
+// udpFrames builds the Ethernet frames of a single UDP datagram, fragmenting it when it's larger than mtu
+func udpFrames(fromClient bool, src, dst netip.AddrPort, payload []byte, mtu int, ts time.Time) ([]Frame, error) {
+	srcMac, dstMac := serverMac, clientMac
+	if fromClient {
+		srcMac, dstMac = clientMac, serverMac
+	}
+
+	udp := &layers.UDP{SrcPort: layers.UDPPort(src.Port()), DstPort: layers.UDPPort(dst.Port())}
+	eth := &layers.Ethernet{SrcMAC: srcMac, DstMAC: dstMac}
+
+	var network gopacket.SerializableLayer
+	headerLen := 0
+	if src.Addr().Is4() {
+		eth.EthernetType = layers.EthernetTypeIPv4
+		ip := &layers.IPv4{
+			Version:  4,
+			IHL:      5,
+			TTL:      64,
+			Id:       uint16(ts.UnixNano() / int64(time.Millisecond)),
+			Protocol: layers.IPProtocolUDP,
+			SrcIP:    src.Addr().AsSlice(),
+			DstIP:    dst.Addr().AsSlice(),
+		}
+		_ = udp.SetNetworkLayerForChecksum(ip)
+		network, headerLen = ip, 20
+	} else {
+		eth.EthernetType = layers.EthernetTypeIPv6
+		ip := &layers.IPv6{
+			Version:    6,
+			HopLimit:   64,
+			NextHeader: layers.IPProtocolUDP,
+			SrcIP:      src.Addr().AsSlice(),
+			DstIP:      dst.Addr().AsSlice(),
+		}
+		_ = udp.SetNetworkLayerForChecksum(ip)
+		network, headerLen = ip, 40
+	}
+
+	if mtu <= 0 || headerLen+8+len(payload) <= mtu {
+		data, err := serialize(eth, network, udp, gopacket.Payload(payload))
+		if err != nil {
+			return nil, err
+		}
+		return []Frame{newFrame(data, ts)}, nil
+	}
+
+	transport, err := serialize(udp, gopacket.Payload(payload))
+	if err != nil {
+		return nil, err
+	}
+
+	var frames []Frame
+	switch ip := network.(type) {
+	case *layers.IPv4:
+		chunk := (mtu - headerLen) &^ 7
+		for offset := 0; offset < len(transport); offset += chunk {
+			end := min(offset+chunk, len(transport))
+			fragment := *ip
+			fragment.FragOffset = uint16(offset / 8)
+			if end < len(transport) {
+				fragment.Flags = layers.IPv4MoreFragments
+			}
+
+			data, err := serialize(eth, &fragment, gopacket.Payload(transport[offset:end]))
+			if err != nil {
+				return nil, err
+			}
+			frames = append(frames, newFrame(data, ts))
+		}
+	case *layers.IPv6:
+		chunk := (mtu - headerLen - 8) &^ 7
+		fragment := *ip
+		fragment.NextHeader = layers.IPProtocolIPv6Fragment
+		id := uint32(ts.UnixNano())
+		for offset := 0; offset < len(transport); offset += chunk {
+			end := min(offset+chunk, len(transport))
+			header := make([]byte, 8, 8+end-offset)
+			header[0] = byte(layers.IPProtocolUDP)
+			flags := uint16(offset)
+			if end < len(transport) {
+				flags |= 1
+			}
+			binary.BigEndian.PutUint16(header[2:4], flags)
+			binary.BigEndian.PutUint32(header[4:8], id)
+
+			data, err := serialize(eth, &fragment, gopacket.Payload(append(header, transport[offset:end]...)))
+			if err != nil {
+				return nil, err
+			}
+			frames = append(frames, newFrame(data, ts))
+		}
+	}
+	return frames, nil
+}
+
+func serialize(toSerialize ...gopacket.SerializableLayer) ([]byte, error) {
+	buf := gopacket.NewSerializeBuffer()
+	opts := gopacket.SerializeOptions{FixLengths: true, ComputeChecksums: true}
+	if err := gopacket.SerializeLayers(buf, opts, toSerialize...); err != nil {
+		return nil, err
+	}
+	return append([]byte(nil), buf.Bytes()...), nil
+}
+
+func newFrame(data []byte, ts time.Time) Frame {
+	return Frame{
+		CaptureInfo: gopacket.CaptureInfo{
+			Timestamp:     ts,
+			CaptureLength: len(data),
+			Length:        len(data),
+		},
+		Data: data,
+	}
+}
+
+// Packets decodes the frames, ready for Sniffer.ReadPacket
+func (s *Session) Packets() ([]gopacket.Packet, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	return decodeFrames(s.frames), nil
+}
+
+// WritePcapng writes the frames as a pcapng capture with an Ethernet link type
+func (s *Session) WritePcapng(w io.Writer) error {
+	if s.err != nil {
+		return s.err
+	}
+	return writePcapng(w, s.frames)
+}
+
+func decodeFrames(frames []Frame) []gopacket.Packet {
+	packets := make([]gopacket.Packet, 0, len(frames))
+	for _, frame := range frames {
+		packet := gopacket.NewPacket(frame.Data, layers.LinkTypeEthernet, gopacket.Default)
+		packet.Metadata().CaptureInfo = frame.CaptureInfo
+		packets = append(packets, packet)
+	}
+	return packets
+}
+
+func writePcapng(w io.Writer, frames []Frame) error {
+	writer, err := pcapgo.NewNgWriter(w, layers.LinkTypeEthernet)
+	if err != nil {
+		return err
+	}
+	for _, frame := range frames {
+		if err = writer.WritePacket(frame.CaptureInfo, frame.Data); err != nil {
+			return err
+		}
+	}
+	return writer.Flush()
+}
@@ -0,0 +1,277 @@
+// Package reliquarytest builds synthetic game captures for tests, using the same encoding the Sniffer decodes
+//
+//	session := reliquarytest.NewSession()
+//	session.Handshake().Login(1234)
+//	session.FromServer(reliquary.GetBagScRsp, &pb.GetBagScRsp{}, reliquarytest.Reordered())
+//	err := session.WritePcapng(file)
+//
+// MockServer and MockClient exchange the same traffic over loopback UDP instead, for testing live captures
+package reliquarytest
+
+import (
+	"errors"
+	"fmt"
+	"github.com/Fesaa/go-reliquary"
+	"github.com/Fesaa/go-reliquary/pb"
+	"google.golang.org/protobuf/proto"
+	"net/netip"
+	"time"
+)
+
+// DEFAULT_VERSION has a key in reliquary.EmbeddedKeyStore
+const DEFAULT_VERSION uint32 = 2648100823
+
+// Command is a command as written into the capture, before encryption
+type Command struct {
+	FromClient bool
+	Id         uint16
+	HeaderData []byte
+	ProtoData  []byte
+	// KeySource is the key the Sniffer is expected to decrypt the command with
+	KeySource reliquary.KeySource
+	// Time is the timestamp of the last frame of a scripted command
+	Time time.Time
+}
+
+// Direction returns the Direction the Sniffer reports for the command
+func (c Command) Direction() reliquary.Direction {
+	if c.FromClient {
+		return reliquary.Received
+	}
+	return reliquary.Send
+}
+
+// GameCommand returns the command as the Sniffer reads it
+func (c Command) GameCommand() reliquary.GameCommand {
+	return reliquary.GameCommand{
+		Id:         c.Id,
+		Name:       reliquary.PacketName(c.Id),
+		HeaderLen:  uint16(len(c.HeaderData)),
+		DataLen:    uint32(len(c.ProtoData)),
+		HeaderData: c.HeaderData,
+		ProtoData:  c.ProtoData,
+		KeySource:  c.KeySource,
+		Timestamp:  c.Time,
+	}
+}
+
+// Session is a scripted conversation between a client and the game server.
+// Methods record errors instead of returning them, check Err or the result of Frames
+type Session struct {
+	Client netip.AddrPort
+	Server netip.AddrPort
+	Conv   uint32
+	Token  uint32
+	Uid    uint32
+	// DispatchKey encrypts commands until Login, defaults to the key of DEFAULT_VERSION
+	DispatchKey *reliquary.Key
+	// Start is the timestamp of the first frame, every frame is 1ms after the previous
+	Start time.Time
+
+	sessionKey *reliquary.Key
+	client     reliquary.KcpSegmenter
+	server     reliquary.KcpSegmenter
+	frames     []Frame
+	commands   []Command
+	now        time.Time
+	err        error
+}
+
+func NewSession() *Session {
+	return &Session{
+		Client: netip.MustParseAddrPort("192.168.1.10:51234"),
+		Server: netip.MustParseAddrPort("47.100.1.1:23301"),
+		Conv:   0x12345678,
+		Token:  0x0BADF00D,
+		Uid:    100000001,
+		Start:  time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
+	}
+}
+
+// Err returns the first error any scripted step ran into
+func (s *Session) Err() error {
+	return s.err
+}
+
+// Commands returns every command in the order it was scripted, the order the Sniffer returns them per direction
+func (s *Session) Commands() []Command {
+	return s.commands
+}
+
+// GameCommands returns every command as the Sniffer reads it, to test what consumes commands without a capture
+func (s *Session) GameCommands() []reliquary.GameCommand {
+	commands := make([]reliquary.GameCommand, 0, len(s.commands))
+	for _, command := range s.commands {
+		commands = append(commands, command.GameCommand())
+	}
+	return commands
+}
+
+// Frames returns the captured frames
+func (s *Session) Frames() ([]Frame, error) {
+	return s.frames, s.err
+}
+
+func (s *Session) fail(err error) *Session {
+	if s.err == nil {
+		s.err = err
+	}
+	return s
+}
+
+func (s *Session) clock() time.Time {
+	if s.now.IsZero() {
+		s.now = s.Start
+		s.client.Conv, s.client.Token = s.Conv, s.Token
+		s.server.Conv, s.server.Token = s.Conv, s.Token
+	}
+	s.now = s.now.Add(time.Millisecond)
+	return s.now
+}
+
+// Wait advances the capture clock
+func (s *Session) Wait(d time.Duration) *Session {
+	s.clock()
+	s.now = s.now.Add(d)
+	return s
+}
+
+// Handshake adds the HandshakeRequested and HandshakeEstablished control packets
+func (s *Session) Handshake() *Session {
+	requested, err := reliquary.NewHandshake(reliquary.HandshakeRequested, 0, 0, 1234567890)
+	if err != nil {
+		return s.fail(err)
+	}
+	established, err := reliquary.NewHandshake(reliquary.HandshakeEstablished, s.Conv, s.Token, 1234567890)
+	if err != nil {
+		return s.fail(err)
+	}
+
+	s.datagram(true, reliquary.EncodeHandshake(requested))
+	s.datagram(false, reliquary.EncodeHandshake(established))
+	return s
+}
+
+// Disconnect adds a Disconnected control packet from the client
+func (s *Session) Disconnect(reason uint32) *Session {
+	h, err := reliquary.NewHandshake(reliquary.Disconnected, s.Conv, s.Token, reason)
+	if err != nil {
+		return s.fail(err)
+	}
+	s.datagram(true, reliquary.EncodeHandshake(h))
+	return s
+}
+
+// Login adds PlayerGetTokenCsReq and a PlayerGetTokenScRsp carrying seed, both encrypted with the dispatch key.
+// Every later command is encrypted with the session key derived from seed
+func (s *Session) Login(seed uint64, impairments ...Impairment) *Session {
+	s.FromClient(reliquary.PlayerGetTokenCsReq, &pb.PlayerGetTokenCsReq{Uid: s.Uid}, impairments...)
+	s.FromServer(reliquary.PlayerGetTokenScRsp, &pb.PlayerGetTokenScRsp{Uid: s.Uid, SecretKeySeed: seed}, impairments...)
+	s.sessionKey = reliquary.NewKey(reliquary.KeyFromSeed(seed))
+	return s
+}
+
+// FromClient adds a command send by the client
+func (s *Session) FromClient(id uint16, msg proto.Message, impairments ...Impairment) *Session {
+	return s.command(true, id, msg, impairments)
+}
+
+// FromServer adds a command send by the server
+func (s *Session) FromServer(id uint16, msg proto.Message, impairments ...Impairment) *Session {
+	return s.command(false, id, msg, impairments)
+}
+
+// FromClientData adds a command send by the client with already marshalled proto data
+func (s *Session) FromClientData(id uint16, data []byte, impairments ...Impairment) *Session {
+	return s.commandData(true, id, data, impairments)
+}
+
+// FromServerData adds a command send by the server with already marshalled proto data
+func (s *Session) FromServerData(id uint16, data []byte, impairments ...Impairment) *Session {
+	return s.commandData(false, id, data, impairments)
+}
+
+func (s *Session) command(fromClient bool, id uint16, msg proto.Message, impairments []Impairment) *Session {
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		return s.fail(fmt.Errorf("could not marshal command %d: %w", id, err))
+	}
+	return s.commandData(fromClient, id, data, impairments)
+}
+
+func (s *Session) commandData(fromClient bool, id uint16, data []byte, impairments []Impairment) *Session {
+	framed, err := reliquary.EncodeCommandData(id, nil, data)
+	if err != nil {
+		return s.fail(err)
+	}
+
+	key, source := s.key()
+	if key == nil {
+		return s.fail(errors.New("no dispatch key to encrypt with"))
+	}
+
+	segmenter := &s.server
+	if fromClient {
+		segmenter = &s.client
+	}
+	ts := uint32(s.clock().Sub(s.Start).Milliseconds())
+	segments, err := segmenter.Segments(key.Encrypt(framed), ts)
+	if err != nil {
+		return s.fail(err)
+	}
+
+	var cfg impairment
+	for _, apply := range impairments {
+		apply(&cfg)
+	}
+	for _, segment := range cfg.schedule(segments) {
+		s.datagramWith(fromClient, segment, cfg.mtu)
+	}
+
+	s.commands = append(s.commands, Command{
+		FromClient: fromClient,
+		Id:         id,
+		ProtoData:  data,
+		KeySource:  source,
+		Time:       s.now,
+	})
+	return s
+}
+
+func (s *Session) key() (*reliquary.Key, reliquary.KeySource) {
+	if s.sessionKey != nil {
+		return s.sessionKey, reliquary.SessionKey
+	}
+	if s.DispatchKey != nil {
+		return s.DispatchKey, reliquary.DispatchKey
+	}
+
+	key, err := reliquary.EmbeddedKeyStore().Key(DEFAULT_VERSION)
+	if err != nil {
+		return nil, reliquary.UnknownKey
+	}
+	return key, reliquary.DispatchKey
+}
+
+func (s *Session) datagram(fromClient bool, payload []byte) {
+	s.datagramWith(fromClient, payload, 0)
+}
+
+func (s *Session) datagramWith(fromClient bool, payload []byte, mtu int) {
+	src, dst := s.Server, s.Client
+	if fromClient {
+		src, dst = s.Client, s.Server
+	}
+
+	frames, err := udpFrames(fromClient, src, dst, payload, mtu, s.clock())
+	if err != nil {
+		s.fail(err)
+		return
+	}
+	for i := range frames {
+		if i > 0 {
+			frames[i].CaptureInfo.Timestamp = s.clock()
+		}
+	}
+	s.frames = append(s.frames, frames...)
+}
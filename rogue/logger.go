@@ -0,0 +1,348 @@
+// Package rogue logs Simulated Universe runs and their successors from the commands a reliquary.Sniffer reads.
+// A run is a timeline of every rogue command and battle from its start to its settlement, each tagged with the
+// kind of event it is, like a dice roll, a blessing or a change of money, and kept with its message as JSON.
+//
+//	logger := rogue.NewLogger(func(run rogue.Run) {
+//		_ = run.Save("runs")
+//	})
+//	for packet := range capture.Packets {
+//		_ = logger.Apply(packet.Commands...)
+//	}
+//
+// The modes send too many commands to model each of them, so the timeline keeps them as the game sent them
+package rogue
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/Fesaa/go-reliquary"
+	"github.com/Fesaa/go-reliquary/internal/atomicfile"
+	"github.com/Fesaa/go-reliquary/internal/battleinfo"
+	"github.com/Fesaa/go-reliquary/internal/dispatch"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"io"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// MAX_RUNS is how many finished runs a Logger keeps
+const MAX_RUNS = 64
+
+// Family is the mode of a run, named after the prefix of its commands
+type Family string
+
+const (
+	// ROGUE is the Simulated Universe
+	ROGUE Family = "Rogue"
+	// CHESS_ROGUE is Swarm Disaster and Gold and Gears
+	CHESS_ROGUE Family = "ChessRogue"
+	// ROGUE_TOURN is the Divergent Universe
+	ROGUE_TOURN Family = "RogueTourn"
+	// ROGUE_MAGIC is the Unknowable Domain
+	ROGUE_MAGIC Family = "RogueMagic"
+)
+
+// Status is how a run ended
+type Status string
+
+const (
+	RUNNING Status = "running"
+	SETTLED Status = "settled"
+	QUIT    Status = "quit"
+	// LEFT runs can be entered again, that starts a new run in the log
+	LEFT Status = "left"
+	// REPLACED runs saw another run start before they ended
+	REPLACED Status = "replaced"
+)
+
+// Kind is what an event is about, by the name of its command
+type Kind string
+
+const (
+	START    Kind = "start"
+	END      Kind = "end"
+	DICE     Kind = "dice"
+	CELL     Kind = "cell"
+	MONEY    Kind = "money"
+	BLESSING Kind = "blessing"
+	CURIO    Kind = "curio"
+	PATH     Kind = "path"
+	CHOICE   Kind = "choice"
+	RESULT   Kind = "result"
+	MODIFIER Kind = "modifier"
+	ROOM     Kind = "room"
+	BATTLE   Kind = "battle"
+	SETTLE   Kind = "settle"
+	OTHER    Kind = "other"
+)
+
+// kinds are matched against command names in order, the first match wins
+var kinds = []struct {
+	kind      Kind
+	fragments []string
+}{
+	{DICE, []string{"Dice", "Roll"}},
+	{CELL, []string{"Cell", "Board"}},
+	{MONEY, []string{"Money", "VirtualItem"}},
+	{BLESSING, []string{"Buff", "Bless"}},
+	{CURIO, []string{"Miracle"}},
+	{PATH, []string{"Aeon"}},
+	{CHOICE, []string{"PendingAction", "Dialogue"}},
+	{RESULT, []string{"ActionResult", "GetItem"}},
+	{MODIFIER, []string{"Modifier"}},
+	{ROOM, []string{"Room", "Layer", "Area", "Level"}},
+	{BATTLE, []string{"Battle"}},
+	{SETTLE, []string{"Settle", "Finish"}},
+}
+
+// starts begin a run. Entering one that was left continues it in a new run, or in the current run
+// when it's of the same family
+var starts = map[uint16]Family{
+	reliquary.StartRogueScRsp:      ROGUE,
+	reliquary.EnterRogueScRsp:      ROGUE,
+	reliquary.ChessRogueStartScRsp: CHESS_ROGUE,
+	reliquary.ChessRogueEnterScRsp: CHESS_ROGUE,
+	reliquary.RogueTournStartScRsp: ROGUE_TOURN,
+	reliquary.RogueTournEnterScRsp: ROGUE_TOURN,
+	reliquary.RogueMagicStartScRsp: ROGUE_MAGIC,
+	reliquary.RogueMagicEnterScRsp: ROGUE_MAGIC,
+}
+
+var ends = map[uint16]Status{
+	reliquary.SyncRogueFinishScNotify: SETTLED,
+	reliquary.RogueTournSettleScRsp:   SETTLED,
+	reliquary.RogueMagicSettleScRsp:   SETTLED,
+	reliquary.QuitRogueScRsp:          QUIT,
+	reliquary.ChessRogueQuitScRsp:     QUIT,
+	reliquary.ChessRogueGiveUpScRsp:   QUIT,
+	reliquary.LeaveRogueScRsp:         LEFT,
+	reliquary.ChessRogueLeaveScRsp:    LEFT,
+	reliquary.RogueTournLeaveScRsp:    LEFT,
+	reliquary.RogueMagicLeaveScRsp:    LEFT,
+}
+
+// battles are the commands of the battles fought during a run, logged as BATTLE events although their names
+// don't mention the mode. The ones marked true only count when they carry the battle they started
+var battles = map[uint16]bool{
+	reliquary.SceneCastSkillScRsp:   true,
+	reliquary.SceneEnterStageScRsp:  true,
+	reliquary.GetCurBattleInfoScRsp: false,
+	reliquary.PVEBattleResultCsReq:  false,
+	reliquary.PVEBattleResultScRsp:  false,
+	reliquary.QuitBattleScRsp:       false,
+	reliquary.QuitBattleScNotify:    false,
+}
+
+// Event is a command of a run
+type Event struct {
+	Time    time.Time       `json:"time"`
+	Kind    Kind            `json:"kind"`
+	Id      uint16          `json:"id"`
+	Name    string          `json:"name"`
+	Message json.RawMessage `json:"message,omitempty"`
+}
+
+type Run struct {
+	// Id is the start time in milliseconds, unique within a Logger
+	Id        int64     `json:"id"`
+	Family    Family    `json:"family"`
+	Status    Status    `json:"status"`
+	StartedBy string    `json:"started_by"`
+	EndedBy   string    `json:"ended_by,omitempty"`
+	Start     time.Time `json:"start"`
+	End       time.Time `json:"end"`
+	// Counts are the events of every kind
+	Counts map[Kind]int `json:"counts"`
+	Events []Event      `json:"events"`
+}
+
+// Filter returns the events of the given kinds, in order
+func (r Run) Filter(kinds ...Kind) []Event {
+	var events []Event
+	for _, event := range r.Events {
+		if slices.Contains(kinds, event.Kind) {
+			events = append(events, event)
+		}
+	}
+	return events
+}
+
+// WriteJSON writes the run as indented JSON
+func (r Run) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(r)
+}
+
+// Save writes the run to <dir>/<family>_<id>.json, replacing an earlier save of it
+func (r Run) Save(dir string) error {
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	return atomicfile.Write(filepath.Join(dir, fmt.Sprintf("%s_%d.json", r.Family, r.Id)), append(data, '\n'))
+}
+
+// Logger groups rogue commands into runs, safe for concurrent use
+type Logger struct {
+	// OnRun is called once a run ends
+	OnRun func(run Run)
+
+	mu      sync.RWMutex
+	current *Run
+	runs    []Run
+	lastId  int64
+	// finished are collected while applying, and passed to OnRun once done
+	finished dispatch.Queue[Run]
+}
+
+func NewLogger(onRun func(run Run)) *Logger {
+	return &Logger{
+		OnRun: onRun,
+	}
+}
+
+// Apply logs the commands of the runs
+func (l *Logger) Apply(commands ...reliquary.GameCommand) error {
+	l.mu.Lock()
+	// Packets without a known proto are logged without their message
+	err := dispatch.Each(commands, l.accepts, l.apply)
+	finished := l.finished.Take()
+	l.mu.Unlock()
+
+	dispatch.Notify(finished, l.OnRun)
+	return err
+}
+
+// apply logs a command, msg is nil when it didn't unmarshal
+func (l *Logger) apply(command reliquary.GameCommand, msg proto.Message) {
+	now := command.Timestamp
+	kind := kindOf(command.Name)
+	if started, ok := battles[command.Id]; ok {
+		if started && battleinfo.Of(msg) == nil {
+			return
+		}
+		kind = BATTLE
+	}
+
+	family, isStart := starts[command.Id]
+	if isStart && !failed(msg) {
+		entered := strings.Contains(command.Name, "Enter")
+		if l.current != nil && !(entered && l.current.Family == family) {
+			l.finish(REPLACED, "", now)
+		}
+		if l.current == nil {
+			l.start(family, command.Name, now)
+		}
+		kind = START
+	}
+	if l.current == nil {
+		return
+	}
+
+	status, isEnd := ends[command.Id]
+	isEnd = isEnd && !failed(msg)
+	if isEnd {
+		kind = END
+	}
+
+	event := Event{Time: now, Kind: kind, Id: command.Id, Name: command.Name}
+	if msg != nil {
+		if data, err := protojson.Marshal(msg); err == nil {
+			event.Message = data
+		}
+	}
+	l.current.Events = append(l.current.Events, event)
+	l.current.Counts[kind]++
+
+	if isEnd {
+		l.finish(status, command.Name, now)
+	}
+}
+
+// accepts the commands of the modes, and the battles during a run
+func (l *Logger) accepts(command reliquary.GameCommand) bool {
+	_, battle := battles[command.Id]
+	return strings.Contains(command.Name, "Rogue") || battle && l.current != nil
+}
+
+func kindOf(name string) Kind {
+	for _, candidate := range kinds {
+		for _, fragment := range candidate.fragments {
+			if strings.Contains(name, fragment) {
+				return candidate.kind
+			}
+		}
+	}
+	return OTHER
+}
+
+// failed returns whether a response has a retcode other than 0
+func failed(msg proto.Message) bool {
+	if msg == nil {
+		return false
+	}
+	fd := msg.ProtoReflect().Descriptor().Fields().ByName("retcode")
+	if fd == nil || fd.Kind() != protoreflect.Uint32Kind {
+		return false
+	}
+	return msg.ProtoReflect().Get(fd).Uint() != 0
+}
+
+func (l *Logger) start(family Family, startedBy string, now time.Time) {
+	l.lastId = max(l.lastId+1, now.UnixMilli())
+	l.current = &Run{
+		Id:        l.lastId,
+		Family:    family,
+		Status:    RUNNING,
+		StartedBy: startedBy,
+		Start:     now,
+		Counts:    make(map[Kind]int),
+		Events:    []Event{},
+	}
+}
+
+// finish ends the current run
+func (l *Logger) finish(status Status, endedBy string, now time.Time) {
+	run := *l.current
+	run.Status = status
+	run.EndedBy = endedBy
+	run.End = now
+
+	l.current = nil
+	l.runs = append(l.runs, run)
+	if len(l.runs) > MAX_RUNS {
+		l.runs = slices.Delete(l.runs, 0, len(l.runs)-MAX_RUNS)
+	}
+	l.finished.Push(run)
+}
+
+// Runs returns the finished runs, oldest first
+func (l *Logger) Runs() []Run {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return slices.Clone(l.runs)
+}
+
+// Current returns the run in progress, false when there is none
+func (l *Logger) Current() (Run, bool) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	if l.current == nil {
+		return Run{}, false
+	}
+	run := *l.current
+	run.Counts = maps.Clone(run.Counts)
+	return run, true
+}
@@ -0,0 +1,141 @@
+package rogue_test
+
+import (
+	"fmt"
+	"github.com/Fesaa/go-reliquary"
+	"github.com/Fesaa/go-reliquary/pb"
+	"github.com/Fesaa/go-reliquary/reliquarytest"
+	"github.com/Fesaa/go-reliquary/rogue"
+	"slices"
+	"testing"
+)
+
+// send sends commands without a body, the rogue modes are logged whether their proto is known or not
+func send(s *reliquarytest.Session, ids ...uint16) {
+	for _, id := range ids {
+		s.FromServerData(id, nil)
+	}
+}
+
+func battle(s *reliquarytest.Session, battleId uint32) {
+	s.FromServer(reliquary.SceneEnterStageScRsp, &pb.SceneEnterStageScRsp{BattleInfo: &pb.SceneBattleInfo{BattleId: battleId}})
+}
+
+// describe writes a run as <family> <status> <started by>-<ended by> [kinds of its events]
+func describe(run rogue.Run) string {
+	kinds := make([]rogue.Kind, 0, len(run.Events))
+	for _, event := range run.Events {
+		kinds = append(kinds, event.Kind)
+	}
+	return fmt.Sprintf("%s %s %s-%s %v", run.Family, run.Status, run.StartedBy, run.EndedBy, kinds)
+}
+
+func TestLoggerRuns(t *testing.T) {
+	tests := []struct {
+		name   string
+		script func(s *reliquarytest.Session)
+		runs   []string
+		// current is the family of the run in progress, empty for none
+		current rogue.Family
+	}{
+		{"settled", func(s *reliquarytest.Session) {
+			send(s, reliquary.StartRogueScRsp, reliquary.EnterRogueMapRoomScRsp, reliquary.SyncRogueFinishScNotify)
+		}, []string{"Rogue settled StartRogueScRsp-SyncRogueFinishScNotify [start room end]"}, ""},
+		{"quit", func(s *reliquarytest.Session) {
+			send(s, reliquary.ChessRogueStartScRsp, reliquary.ChessRogueNousDiceUpdateNotify, reliquary.ChessRogueQuitScRsp)
+		}, []string{"ChessRogue quit ChessRogueStartScRsp-ChessRogueQuitScRsp [start dice end]"}, ""},
+		{"commands outside runs", func(s *reliquarytest.Session) {
+			send(s, reliquary.EnterRogueMapRoomScRsp, reliquary.StartRogueScRsp)
+			s.FromClientData(reliquary.PlayerHeartBeatCsReq, nil)
+			send(s, reliquary.SyncRogueFinishScNotify, reliquary.EnterRogueMapRoomScRsp)
+		}, []string{"Rogue settled StartRogueScRsp-SyncRogueFinishScNotify [start end]"}, ""},
+		{"in progress", func(s *reliquarytest.Session) {
+			send(s, reliquary.RogueTournStartScRsp, reliquary.RogueTournAreaUpdateScNotify)
+		}, nil, rogue.ROGUE_TOURN},
+		{"left and entered again", func(s *reliquarytest.Session) {
+			send(s, reliquary.StartRogueScRsp, reliquary.LeaveRogueScRsp, reliquary.EnterRogueScRsp, reliquary.SyncRogueFinishScNotify)
+		}, []string{
+			"Rogue left StartRogueScRsp-LeaveRogueScRsp [start end]",
+			"Rogue settled EnterRogueScRsp-SyncRogueFinishScNotify [start end]",
+		}, ""},
+		{"enter continues a run of the same family", func(s *reliquarytest.Session) {
+			send(s, reliquary.StartRogueScRsp, reliquary.EnterRogueScRsp, reliquary.SyncRogueFinishScNotify)
+		}, []string{"Rogue settled StartRogueScRsp-SyncRogueFinishScNotify [start start end]"}, ""},
+		{"enter of another family", func(s *reliquarytest.Session) {
+			send(s, reliquary.StartRogueScRsp, reliquary.ChessRogueEnterScRsp)
+		}, []string{"Rogue replaced StartRogueScRsp- [start]"}, rogue.CHESS_ROGUE},
+		{"start replaces a run of the same family", func(s *reliquarytest.Session) {
+			send(s, reliquary.RogueMagicStartScRsp, reliquary.RogueMagicStartScRsp)
+		}, []string{"RogueMagic replaced RogueMagicStartScRsp- [start]"}, rogue.ROGUE_MAGIC},
+		{"battles", func(s *reliquarytest.Session) {
+			send(s, reliquary.StartRogueScRsp)
+			battle(s, 1)
+			// Casting a skill only starts a battle when it hits
+			s.FromServer(reliquary.SceneCastSkillScRsp, &pb.SceneCastSkillScRsp{})
+			s.FromServer(reliquary.PVEBattleResultScRsp, &pb.PVEBattleResultScRsp{BattleId: 1, EndStatus: 1})
+			send(s, reliquary.SyncRogueFinishScNotify)
+		}, []string{"Rogue settled StartRogueScRsp-SyncRogueFinishScNotify [start battle battle end]"}, ""},
+		{"battles outside runs", func(s *reliquarytest.Session) {
+			battle(s, 1)
+			s.FromServer(reliquary.PVEBattleResultScRsp, &pb.PVEBattleResultScRsp{BattleId: 1, EndStatus: 1})
+		}, nil, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var notified []string
+			logger := rogue.NewLogger(func(run rogue.Run) {
+				notified = append(notified, describe(run))
+			})
+			session := reliquarytest.NewSession()
+			tt.script(session)
+			if err := session.Err(); err != nil {
+				t.Fatal(err)
+			}
+			if err := logger.Apply(session.GameCommands()...); err != nil {
+				t.Fatal(err)
+			}
+
+			var runs []string
+			for _, run := range logger.Runs() {
+				runs = append(runs, describe(run))
+				total := 0
+				for _, count := range run.Counts {
+					total += count
+				}
+				if total != len(run.Events) {
+					t.Errorf("run %d counts %v for %d events", run.Id, run.Counts, len(run.Events))
+				}
+			}
+			if !slices.Equal(runs, tt.runs) {
+				t.Errorf("runs %q, want %q", runs, tt.runs)
+			}
+			if !slices.Equal(notified, tt.runs) {
+				t.Errorf("notified %q, want %q", notified, tt.runs)
+			}
+			current, ok := logger.Current()
+			if ok != (tt.current != "") || current.Family != tt.current {
+				t.Errorf("current run %q, want %q", current.Family, tt.current)
+			}
+		})
+	}
+}
+
+func TestLoggerRunTimes(t *testing.T) {
+	logger := rogue.NewLogger(nil)
+	session := reliquarytest.NewSession()
+	send(session, reliquary.StartRogueScRsp, reliquary.LeaveRogueScRsp, reliquary.EnterRogueScRsp)
+	if err := logger.Apply(session.GameCommands()...); err != nil {
+		t.Fatal(err)
+	}
+
+	commands := session.Commands()
+	runs := logger.Runs()
+	current, _ := logger.Current()
+	if len(runs) != 1 || !runs[0].Start.Equal(commands[0].Time) || !runs[0].End.Equal(commands[1].Time) {
+		t.Fatalf("runs %+v", runs)
+	}
+	if !current.Start.Equal(commands[2].Time) || current.Id <= runs[0].Id {
+		t.Errorf("run %d from %v after run %d", current.Id, current.Start, runs[0].Id)
+	}
+}
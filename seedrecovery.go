@@ -0,0 +1,183 @@
+package reliquary
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/goark/mt/mt19937"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const maxPendingCommands = 64
+
+var SeedNotRecovered = errors.New("session seed not found in search range")
+
+// SeedSearch is the inclusive range of seeds RecoverSessionSeed tries
+type SeedSearch struct {
+	From    uint64
+	To      uint64
+	Workers int
+}
+
+// TimestampSeedSearch searches millisecond timestamps within window of t,
+// use the timestamp of the first captured packet when the login was missed
+func TimestampSeedSearch(t time.Time, window time.Duration) SeedSearch {
+	center, w := uint64(t.UnixMilli()), uint64(window.Milliseconds())
+	from := uint64(0)
+	if center > w {
+		from = center - w
+	}
+	return SeedSearch{From: from, To: center + w}
+}
+
+// RecoverSessionSeed searches the seed range for a session key that decrypts all commands.
+// Candidates are checked against the head magic of the first command, which only needs the first MT19937 output,
+// and then against the full framing of every command
+func RecoverSessionSeed(ctx context.Context, commands [][]byte, search SeedSearch) (uint64, error) {
+	if len(commands) == 0 {
+		return 0, errors.New("no commands to check seeds against")
+	}
+	if search.To < search.From {
+		return 0, fmt.Errorf("invalid seed range %d-%d", search.From, search.To)
+	}
+	workers := search.Workers
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+
+	for _, command := range commands {
+		if len(command) < HEADER_OVERHEAD {
+			return 0, errors.New("command too short to check seeds against")
+		}
+	}
+	// key[0:4] of the session key, the same for every command
+	want := version(commands[0])
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var found atomic.Bool
+	var seed uint64
+	var next atomic.Uint64
+	next.Store(search.From)
+	const batch = 4096
+
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			src := mt19937.New(0)
+			for ctx.Err() == nil {
+				start := next.Add(batch) - batch
+				if start > search.To || start < search.From {
+					return
+				}
+				end := min(start+batch-1, search.To)
+
+				for candidate := start; ; candidate++ {
+					src.Seed(int64(candidate))
+					if uint32(src.Uint64()>>32) == want && validSeed(candidate, commands) {
+						if found.CompareAndSwap(false, true) {
+							seed = candidate
+							cancel()
+						}
+						return
+					}
+					if candidate == end {
+						break
+					}
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if found.Load() {
+		logger.Info().Uint64("seed", seed).Msg("recovered session seed")
+		return seed, nil
+	}
+	if err := ctx.Err(); err != nil && !errors.Is(err, context.Canceled) {
+		return 0, err
+	}
+	return 0, SeedNotRecovered
+}
+
+func validSeed(seed uint64, commands [][]byte) bool {
+	key := KeyFromSeed(seed)
+	buf := make([]byte, 0, len(commands[0]))
+	for _, command := range commands {
+		buf = append(buf[:0], command...)
+		for i := range buf {
+			buf[i] ^= key[i%len(key)]
+		}
+		if !validFraming(buf) {
+			return false
+		}
+	}
+	return true
+}
+
+// TimestampSeed returns the millisecond timestamp of a seed, for seeds found by TimestampSeedSearch
+func TimestampSeed(seed uint64) time.Time {
+	return time.UnixMilli(int64(seed))
+}
+
+// pendingCommand is a command that could not be decrypted, still encrypted
+type pendingCommand struct {
+	direction Direction
+	data      []byte
+	timestamp time.Time
+}
+
+// PendingCommands returns the commands that could not be decrypted because no key was known,
+// these are the input for RecoverSessionSeed
+func (s *Sniffer) PendingCommands() [][]byte {
+	commands := make([][]byte, len(s.pending))
+	for i, pending := range s.pending {
+		commands[i] = pending.data
+	}
+	return commands
+}
+
+// RecoverSessionSeed searches for the seed of the current session using the pending commands, and sets it on success.
+// The pending commands are returned decrypted, a packet for every run of commands in the same direction.
+// It may take a while, and must not be called concurrently with ReadPacket
+func (s *Sniffer) RecoverSessionSeed(ctx context.Context, search SeedSearch) (uint64, []CommandsPacket, error) {
+	seed, err := RecoverSessionSeed(ctx, s.PendingCommands(), search)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	s.SetSessionSeed(seed)
+	s.storeSeed(SessionSeed{Conv: s.sessionConv(), Seed: seed, Time: s.pending[len(s.pending)-1].timestamp})
+
+	var packets []CommandsPacket
+	for _, pending := range s.pending {
+		command, err := decryptCommandWith(s.key, pending.data)
+		if err != nil {
+			logger.Warn().Err(err).Msg("pending command not decrypted with the recovered seed")
+			continue
+		}
+		command.KeySource = SessionKey
+		command.Timestamp = pending.timestamp
+
+		if len(packets) == 0 || packets[len(packets)-1].Direction != pending.direction {
+			packets = append(packets, CommandsPacket{Direction: pending.direction})
+		}
+		last := &packets[len(packets)-1]
+		last.Commands = append(last.Commands, *command)
+	}
+	s.pending = nil
+	return seed, packets, nil
+}
+
+func (s *Sniffer) addPending(direction Direction, data []byte, timestamp time.Time) {
+	if len(s.pending) >= maxPendingCommands {
+		return
+	}
+	s.pending = append(s.pending, pendingCommand{direction: direction, data: append([]byte(nil), data...), timestamp: timestamp})
+}
@@ -0,0 +1,74 @@
+package reliquary_test
+
+import (
+	"bytes"
+	"context"
+	"github.com/Fesaa/go-reliquary"
+	"github.com/Fesaa/go-reliquary/reliquarytest"
+	"testing"
+)
+
+func TestSnifferRecoverSessionSeed(t *testing.T) {
+	const seed uint64 = 1700000000123
+
+	session := reliquarytest.NewSession()
+	session.Handshake().Login(seed)
+	login, err := session.Packets()
+	if err != nil {
+		t.Fatal(err)
+	}
+	skip := len(session.Commands())
+	session.FromClientData(reliquary.GetBagCsReq, nil).
+		FromServerData(reliquary.GetBagScRsp, bytes.Repeat([]byte{0x42}, 100)).
+		FromServerData(reliquary.PlayerSyncScNotify, []byte("sync")).
+		FromClientData(reliquary.GetAvatarDataCsReq, nil)
+	packets, err := session.Packets()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sniffer := &reliquary.Sniffer{}
+	for _, p := range packets[len(login):] {
+		if gp, _ := sniffer.ReadPacket(p); gp != nil && gp.PacketType() == reliquary.CommandsPacketType {
+			t.Fatal("decrypted a command without the seed")
+		}
+	}
+	if len(sniffer.PendingCommands()) != 4 {
+		t.Fatalf("%d pending commands, want 4", len(sniffer.PendingCommands()))
+	}
+
+	got, recovered, err := sniffer.RecoverSessionSeed(context.Background(), reliquary.SeedSearch{From: seed - 100, To: seed + 100})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != seed {
+		t.Fatalf("recovered seed %d, want %d", got, seed)
+	}
+	if len(sniffer.PendingCommands()) != 0 {
+		t.Fatal("pending commands were kept")
+	}
+
+	// The server commands are one run, the client ones around it are not merged
+	want := session.Commands()[skip:]
+	if len(recovered) != 3 {
+		t.Fatalf("got %d packets, want 3", len(recovered))
+	}
+	i := 0
+	for _, packet := range recovered {
+		for _, command := range packet.Commands {
+			if command.Id != want[i].Id || !bytes.Equal(command.ProtoData, want[i].ProtoData) {
+				t.Fatalf("command %d decrypted to id %d with %d bytes", i, command.Id, len(command.ProtoData))
+			}
+			if packet.Direction != want[i].Direction() {
+				t.Fatalf("command %d in a %v packet, want %v", i, packet.Direction, want[i].Direction())
+			}
+			if command.KeySource != reliquary.SessionKey {
+				t.Fatalf("command %d decrypted with %s", i, command.KeySource)
+			}
+			i++
+		}
+	}
+	if i != len(want) {
+		t.Fatalf("recovered %d commands, want %d", i, len(want))
+	}
+}
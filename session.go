@@ -0,0 +1,88 @@
+package reliquary
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/Fesaa/go-reliquary/internal/atomicfile"
+	"os"
+	"slices"
+	"sync"
+	"time"
+)
+
+const maxStoredSeeds = 64
+
+var SeedNotFound = errors.New("no seed found for conversation")
+
+// SessionSeed is the SecretKeySeed of PlayerGetTokenScRsp, with enough context to find it again
+// when a capture restarts in the middle of the session
+type SessionSeed struct {
+	Conv uint32    `json:"conv"`
+	Uid  uint32    `json:"uid,omitempty"`
+	Seed uint64    `json:"seed"`
+	Time time.Time `json:"time"`
+}
+
+// SeedStore persists session seeds, keyed by the KCP conversation id
+// Seed must return SeedNotFound (wrapped) when the conversation is unknown
+type SeedStore interface {
+	Seed(conv uint32) (SessionSeed, error)
+	StoreSeed(seed SessionSeed) error
+}
+
+// FileSeedStore keeps the last 64 session seeds in a JSON file
+type FileSeedStore struct {
+	mu    sync.RWMutex
+	path  string
+	seeds []SessionSeed
+}
+
+func NewFileSeedStore(path string) (*FileSeedStore, error) {
+	fss := &FileSeedStore{path: path}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return fss, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err = json.Unmarshal(data, &fss.seeds); err != nil {
+		return nil, fmt.Errorf("could not read seeds from %s: %w", path, err)
+	}
+	return fss, nil
+}
+
+func (f *FileSeedStore) Seed(conv uint32) (SessionSeed, error) {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	// Newest first, conversation ids may be reused eventually
+	for i := len(f.seeds) - 1; i >= 0; i-- {
+		if f.seeds[i].Conv == conv {
+			return f.seeds[i], nil
+		}
+	}
+	return SessionSeed{}, fmt.Errorf("%w: %d", SeedNotFound, conv)
+}
+
+func (f *FileSeedStore) StoreSeed(seed SessionSeed) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.seeds = slices.DeleteFunc(f.seeds, func(s SessionSeed) bool {
+		return s.Conv == seed.Conv
+	})
+	f.seeds = append(f.seeds, seed)
+	if len(f.seeds) > maxStoredSeeds {
+		f.seeds = f.seeds[len(f.seeds)-maxStoredSeeds:]
+	}
+
+	data, err := json.MarshalIndent(f.seeds, "", "\t")
+	if err != nil {
+		return err
+	}
+	return atomicfile.Write(f.path, append(data, '\n'))
+}
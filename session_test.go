@@ -0,0 +1,102 @@
+package reliquary_test
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/Fesaa/go-reliquary"
+	"github.com/Fesaa/go-reliquary/reliquarytest"
+	"testing"
+)
+
+// seedStore is an in memory SeedStore counting its lookups
+type seedStore struct {
+	seeds   map[uint32]reliquary.SessionSeed
+	lookups int
+}
+
+func (s *seedStore) Seed(conv uint32) (reliquary.SessionSeed, error) {
+	s.lookups++
+	seed, ok := s.seeds[conv]
+	if !ok {
+		return reliquary.SessionSeed{}, fmt.Errorf("%w: %d", reliquary.SeedNotFound, conv)
+	}
+	return seed, nil
+}
+
+func (s *seedStore) StoreSeed(seed reliquary.SessionSeed) error {
+	s.seeds[seed.Conv] = seed
+	return nil
+}
+
+func TestSnifferSeedStore(t *testing.T) {
+	const seed uint64 = 1700000000123
+
+	tests := []struct {
+		name string
+		// midSession drops the handshake and login from the capture
+		midSession bool
+		stored     uint64
+		lookups    bool
+	}{
+		{"resumes mid-session", true, seed, true},
+		{"ignores stale seed after handshake", false, seed + 1, false},
+		{"unknown conversation", true, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			session := reliquarytest.NewSession()
+			session.Handshake().Login(seed)
+			login, err := session.Packets()
+			if err != nil {
+				t.Fatal(err)
+			}
+			session.FromClientData(reliquary.GetBagCsReq, nil).
+				FromServerData(reliquary.GetBagScRsp, bytes.Repeat([]byte{0x42}, 100))
+			packets, err := session.Packets()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.midSession {
+				packets = packets[len(login):]
+			}
+
+			store := &seedStore{seeds: make(map[uint32]reliquary.SessionSeed)}
+			if tt.stored != 0 {
+				store.seeds[session.Conv] = reliquary.SessionSeed{Conv: session.Conv, Seed: tt.stored}
+			}
+			sniffer := &reliquary.Sniffer{}
+			sniffer.SetSeedStore(store)
+
+			var got []reliquary.GameCommand
+			for _, p := range packets {
+				gp, _ := sniffer.ReadPacket(p)
+				if cp, ok := gp.(*reliquary.CommandsPacket); ok {
+					got = append(got, cp.Commands...)
+				}
+			}
+
+			if (store.lookups > 0) != tt.lookups {
+				t.Fatalf("looked up %d seeds, want lookups %t", store.lookups, tt.lookups)
+			}
+			if tt.stored == 0 {
+				if len(got) != 0 {
+					t.Fatalf("decrypted %d commands without a seed", len(got))
+				}
+				return
+			}
+
+			if len(got) < 2 {
+				t.Fatalf("decrypted %d commands, want at least 2", len(got))
+			}
+			for _, command := range got[len(got)-2:] {
+				if command.KeySource != reliquary.SessionKey {
+					t.Fatalf("command %d decrypted with %s, want %s", command.Id, command.KeySource, reliquary.SessionKey)
+				}
+			}
+			if stored := store.seeds[session.Conv].Seed; !tt.midSession && stored != seed {
+				t.Fatalf("stored seed %d, want %d", stored, seed)
+			}
+		})
+	}
+}
@@ -7,26 +7,152 @@ import (
 	"github.com/Fesaa/go-reliquary/pb"
 	"github.com/google/gopacket"
 	"google.golang.org/protobuf/proto"
+	"maps"
+	"slices"
+	"time"
 )
 
 type Sniffer struct {
 	sentKcp     *kcpSniffer
 	recvKcp     *kcpSniffer
 	key         *Key
-	initialKeys map[uint32]*Key
+	prevKey     *Key
+	initialKeys *MemoryKeyStore
+	keyStore    KeyStore
+	seedStore   SeedStore
+	// pending holds commands that could not be decrypted, for RecoverSessionSeed
+	pending []pendingCommand
+	// recoveries is nil unless EnableKeyRecovery was called, it's cleared by every HandshakeRequested
+	recoveries map[uint32]*KeyRecovery
+	// conv is the conversation id announced by the last HandshakeEstablished packet, 0 if none was seen
+	conv    uint32
+	matcher serverMatcher
+	defrag  *Defragmenter
 }
 
+// AddKey adds a dispatch key for the version, it takes precedence over any KeyStore
 func (s *Sniffer) AddKey(version uint32, key Key) {
-	if _, ok := s.initialKeys[version]; ok {
-		logger.Debug().Uint32("version", version).Msg("Replacing key")
+	if s.initialKeys == nil {
+		s.initialKeys = NewMemoryKeyStore()
 	}
-	s.initialKeys[version] = &key
+	_ = s.initialKeys.Store(version, &key)
+}
+
+// SetKeyStore replaces the store dispatch keys are looked up in, EmbeddedKeyStore is used when none is set
+func (s *Sniffer) SetKeyStore(ks KeyStore) {
+	s.keyStore = ks
+}
+
+// SetSessionSeed sets the session key from a known SecretKeySeed, for captures started after PlayerGetTokenScRsp
+// The key is reset by the next HandshakeRequested packet
+func (s *Sniffer) SetSessionSeed(seed uint64) {
+	s.setSessionKey(NewKey(KeyFromSeed(seed)))
+	logger.Info().Uint64("seed", seed).Msg("new session Key was set")
+}
+
+// SetSessionKey sets the session key directly, see SetSessionSeed
+func (s *Sniffer) SetSessionKey(key *Key) {
+	s.setSessionKey(key)
+	logger.Info().Msg("new session Key was set")
+}
+
+// setSessionKey keeps the replaced key around as a fallback
+func (s *Sniffer) setSessionKey(key *Key) {
+	if s.key != nil {
+		s.prevKey = s.key
+	}
+	s.key = key
+}
+
+// SetSeedStore makes the Sniffer persist every session seed it sees, and look up the seed of
+// the current conversation when the capture started mid-session, without its HandshakeEstablished
+func (s *Sniffer) SetSeedStore(store SeedStore) {
+	s.seedStore = store
+}
+
+// sessionConv returns the id of the current conversation, 0 if unknown
+func (s *Sniffer) sessionConv() uint32 {
+	switch {
+	case s.conv != 0:
+		return s.conv
+	case s.recvKcp != nil:
+		return s.recvKcp.ConvID
+	case s.sentKcp != nil:
+		return s.sentKcp.ConvID
+	}
+	return 0
+}
+
+func (s *Sniffer) storeSeed(seed SessionSeed) {
+	if s.seedStore == nil || seed.Conv == 0 {
+		return
+	}
+	if err := s.seedStore.StoreSeed(seed); err != nil {
+		logger.Warn().Err(err).Uint32("conv", seed.Conv).Msg("could not store session seed")
+	}
+}
+
+// EnableKeyRecovery makes the Sniffer recover dispatch keys for unknown versions from the login commands it can't decrypt,
+// only conversations whose HandshakeEstablished was captured are used.
+// Commands are decrypted with the partially recovered key once it's long enough, the complete key is stored in the KeyStore
+func (s *Sniffer) EnableKeyRecovery() {
+	if s.recoveries == nil {
+		s.recoveries = make(map[uint32]*KeyRecovery)
+	}
+}
+
+// KeyStore returns the KeyStore dispatch keys are looked up in
+func (s *Sniffer) KeyStore() KeyStore {
+	if s.keyStore == nil {
+		return EmbeddedKeyStore()
+	}
+	return s.keyStore
+}
+
+// SetPorts replaces the server port ranges used to classify direction, DEFAULT_PORTS is used when none are set
+func (s *Sniffer) SetPorts(ranges ...PortRange) {
+	s.matcher.ranges = ranges
+}
+
+// AutoDetectServer makes the Sniffer learn the server endpoint from the destination of each HandshakeRequested packet
+// and classify direction by that endpoint instead of by port. Packets seen before the first handshake have an Unknown direction
+func (s *Sniffer) AutoDetectServer() {
+	s.matcher.autoDetect = true
+}
+
+// Server returns the learned server endpoint, if any
+func (s *Sniffer) Server() (Endpoint, bool) {
+	if s.matcher.server == nil {
+		return Endpoint{}, false
+	}
+	return *s.matcher.server, true
+}
+
+// PcapFilter returns the BPF filter for the configured ports, matching all UDP traffic when auto-detecting
+func (s *Sniffer) PcapFilter() string {
+	if s.matcher.autoDetect {
+		return PcapFilter()
+	}
+	return PcapFilter(s.matcher.portRanges()...)
 }
 
 // ReadPacket reads a packet, and returns the correct GamePacket
 // You can handle pb conversion yourself by checking the PacketType against CommandsPacketType
 func (s *Sniffer) ReadPacket(packet gopacket.Packet) (GamePacket, error) {
-	connPacket, err := parseConnectionPacket(packet)
+	if s.defrag == nil {
+		s.defrag = NewDefragmenter()
+	}
+
+	packet, err := s.defrag.Defrag(packet)
+	if err != nil {
+		return nil, err
+	}
+	// Fragment of a larger datagram
+	if packet == nil {
+		return &ContinuePacket{}, nil
+	}
+
+	connPacket, err := parseConnectionPacket(packet, &s.matcher)
 	if err != nil {
 		return nil, err
 	}
@@ -48,16 +174,29 @@ func (s *Sniffer) ReadPacket(packet gopacket.Packet) (GamePacket, error) {
 	case HandshakeRequested:
 		s.sentKcp = nil
 		s.recvKcp = nil
+		if s.key != nil {
+			s.prevKey = s.key
+		}
 		s.key = nil
+		s.conv = 0
+		s.pending = nil
+		clear(s.recoveries)
 		l.Info().Msg("state reset after HandshakeRequested packet")
 		return connPacket, nil
 	case HandshakeEstablished:
+		s.conv = connPacket.Handshake.Conv
+		l.Info().Uint32("conv", s.conv).Msg("conversation established")
 		return connPacket, nil
 	case Disconnected:
+		l.Info().
+			Uint32("conv", connPacket.Handshake.Conv).
+			Uint32("reason", connPacket.Handshake.Reason()).
+			Msg("conversation disconnected")
 		return connPacket, nil
 	case SegmentData:
 		var commands []GameCommand
-		if commands, err = s.read(connPacket.Direction, connPacket.Payload); err != nil {
+		timestamp := packet.Metadata().Timestamp
+		if commands, err = s.read(connPacket.Direction, connPacket.Payload, timestamp); err != nil {
 			return nil, err
 		}
 		if commands == nil {
@@ -72,6 +211,7 @@ func (s *Sniffer) ReadPacket(packet gopacket.Packet) (GamePacket, error) {
 			_conn:     connPacket,
 			Commands:  commands,
 			Direction: connPacket.Direction,
+			Timestamp: timestamp,
 		}, nil
 	}
 
@@ -79,14 +219,24 @@ func (s *Sniffer) ReadPacket(packet gopacket.Packet) (GamePacket, error) {
 	return nil, errors.New("unhandled packet")
 }
 
-func (s *Sniffer) read(direction Direction, segment []byte) ([]GameCommand, error) {
+func (s *Sniffer) read(direction Direction, segment []byte, timestamp time.Time) ([]GameCommand, error) {
+	if direction == Unknown {
+		return nil, UnknownDirection
+	}
 	dKcp := s.getKCP(direction)
 
 	if dKcp == nil {
-		nKcp, err := newKcpSniffer(segment)
+		nKcp, err := newKcpSniffer(segment, s.conv == 0)
 		if err != nil {
 			return nil, err
 		}
+		if s.conv != 0 && nKcp.ConvID != s.conv {
+			logger.Warn().
+				Uint32("expected", s.conv).
+				Uint32("got", nKcp.ConvID).
+				Msg("segment does not belong to the established conversation")
+			return nil, PacketNotFromConversation
+		}
 		dKcp = nKcp
 		s.setKCP(direction, nKcp)
 	}
@@ -107,7 +257,7 @@ func (s *Sniffer) read(direction Direction, segment []byte) ([]GameCommand, erro
 	}
 
 	for _, data := range splitData {
-		command, err := s.readCommand(data)
+		command, err := s.readCommand(direction, data, timestamp)
 		if err != nil {
 			return nil, err
 		}
@@ -143,26 +293,181 @@ func (s *Sniffer) setKCP(direction Direction, kcp *kcpSniffer) {
 	}
 }
 
-func (s *Sniffer) getKey(data []byte) *Key {
+// getKey returns the key that should decrypt data, the session key once known and the dispatch key of the version before
+func (s *Sniffer) getKey(direction Direction, data []byte) (*Key, KeySource, error) {
 	if s.key != nil {
-		return s.key
+		return s.key, SessionKey, nil
+	}
+
+	// With the HandshakeEstablished captured the login follows, a stored seed can only be stale
+	if s.seedStore != nil && s.conv == 0 {
+		if conv := s.sessionConv(); conv != 0 {
+			if seed, err := s.seedStore.Seed(conv); err == nil {
+				logger.Info().Uint32("conv", conv).Uint32("uid", seed.Uid).Msg("resuming session from stored seed")
+				s.SetSessionSeed(seed.Seed)
+				return s.key, SessionKey, nil
+			}
+		}
 	}
 
-	if key, ok := s.initialKeys[version(data)]; ok {
-		return key
+	v := version(data)
+	key, err := s.dispatchKey(v)
+	// Mid-session, commands encrypted with the session key would look like unknown versions
+	if errors.Is(err, KeyNotFound) && s.recoveries != nil && s.conv != 0 {
+		if key, err = s.recoverKey(v, direction, data); err == nil {
+			return key, RecoveredKey, nil
+		}
+	}
+	if err != nil {
+		logger.Error().Err(err).Uint32("version", v).Msg("no key found")
+		return nil, UnknownKey, err
+	}
+
+	logger.Info().Uint32("version", v).Msg("using preset decryption Key")
+	return key, DispatchKey, nil
+}
+
+func (s *Sniffer) dispatchKey(v uint32) (*Key, error) {
+	if s.initialKeys != nil {
+		if key, err := s.initialKeys.Key(v); err == nil {
+			return key, nil
+		}
+	}
+	return s.KeyStore().Key(v)
+}
+
+type keyCandidate struct {
+	key    *Key
+	source KeySource
+}
+
+// fallbackKeys returns the keys to try when the key from getKey did not decrypt data
+func (s *Sniffer) fallbackKeys(data []byte, tried *Key) []keyCandidate {
+	var candidates []keyCandidate
+	add := func(key *Key, source KeySource) {
+		if key == nil || key == tried {
+			return
+		}
+		for _, c := range candidates {
+			if c.key == key {
+				return
+			}
+		}
+		candidates = append(candidates, keyCandidate{key: key, source: source})
+	}
+
+	add(s.key, SessionKey)
+	if key, err := s.dispatchKey(version(data)); err == nil {
+		add(key, DispatchKey)
+	}
+	add(s.prevKey, PreviousSessionKey)
+	if s.initialKeys != nil {
+		s.initialKeys.mu.RLock()
+		for _, v := range slices.Sorted(maps.Keys(s.initialKeys.keys)) {
+			add(s.initialKeys.keys[v], ConfiguredKey)
+		}
+		s.initialKeys.mu.RUnlock()
 	}
+	return candidates
+}
 
-	return getKnownKey(data)
+// loginIds are the commands encrypted with the dispatch key, by direction. Nothing else is, so their ids are known plaintext
+var loginIds = map[Direction]uint16{
+	Received: PlayerGetTokenCsReq,
+	Send:     PlayerGetTokenScRsp,
 }
 
-func (s *Sniffer) readCommand(data []byte) (*GameCommand, error) {
-	key := s.getKey(data)
+func (s *Sniffer) recoverKey(v uint32, direction Direction, data []byte) (*Key, error) {
+	recovery, ok := s.recoveries[v]
+	if !ok {
+		if len(s.recoveries) >= maxKeyRecoveries {
+			return nil, fmt.Errorf("%w: %d", KeyNotFound, v)
+		}
+		logger.Warn().Uint32("version", v).Msg("unknown version, recovering key from traffic")
+		recovery = NewKeyRecovery(v)
+		s.recoveries[v] = recovery
+	}
+
+	if err := recovery.Observe(data, loginIds[direction]); err != nil {
+		logger.Debug().Err(err).Uint32("version", v).Msg("could not use command for key recovery")
+	}
+
+	key, err := recovery.Key()
+	if err != nil {
+		if partial := recovery.PartialKey(); partial != nil && len(data) <= len(partial.Bytes()) {
+			logger.Debug().Uint32("version", v).Int("len", len(partial.Bytes())).Msg("using partially recovered key")
+			return partial, nil
+		}
+		logger.Debug().Err(err).Uint32("version", v).Msg("key not recovered yet")
+		return nil, fmt.Errorf("%w: %d", KeyNotFound, v)
+	}
+
+	if err = s.KeyStore().Store(v, key); err != nil {
+		logger.Warn().Err(err).Uint32("version", v).Msg("could not persist recovered key")
+		s.AddKey(v, *key)
+	}
+	delete(s.recoveries, v)
+	logger.Info().Uint32("version", v).Msg("recovered dispatch key from traffic")
+	return key, nil
+}
 
+// decryptCommandWith parses data decrypted with key, data itself is retained as GameCommand.Raw
+// Returns ErrKeyMismatch if the decrypted data is not framed by the magics
+func decryptCommandWith(key *Key, data []byte) (*GameCommand, error) {
 	decryptedCommandData := key.decryptCommand(data)
+	if !validFraming(decryptedCommandData) {
+		return nil, ErrKeyMismatch
+	}
+
 	command, err := gameCommandFromData(decryptedCommandData)
 	if err != nil {
 		return nil, err
 	}
+	command.Raw = data
+	return command, nil
+}
+
+func (s *Sniffer) readCommand(direction Direction, data []byte, timestamp time.Time) (*GameCommand, error) {
+	if len(data) < HEADER_OVERHEAD {
+		return nil, fmt.Errorf("command too short: %d bytes", len(data))
+	}
+
+	key, source, err := s.getKey(direction, data)
+	if err != nil && !errors.Is(err, KeyNotFound) {
+		return nil, err
+	}
+
+	tried := 0
+	var command *GameCommand
+	if key != nil {
+		tried++
+		command, err = decryptCommandWith(key, data)
+	}
+
+	if command == nil {
+		for _, candidate := range s.fallbackKeys(data, key) {
+			tried++
+			if command, err = decryptCommandWith(candidate.key, data); err == nil {
+				logger.Warn().
+					Stringer("expected", source).
+					Stringer("used", candidate.source).
+					Msg("command decrypted with fallback key")
+				source = candidate.source
+				break
+			}
+		}
+	}
+
+	if command == nil {
+		s.addPending(direction, data, timestamp)
+		if tried == 0 {
+			return nil, fmt.Errorf("%w: %d", KeyNotFound, version(data))
+		}
+		logger.Warn().Int("tried", tried).Uint32("version", version(data)).Msg("no key decrypted command")
+		return nil, fmt.Errorf("%w: tried %d keys", ErrKeyMismatch, tried)
+	}
+	command.KeySource = source
+	command.Timestamp = timestamp
 
 	if isTraceEnabled() {
 		logger.Trace().Str("data", base64.StdEncoding.EncodeToString(command.ProtoData)).Msg("received")
@@ -175,9 +480,13 @@ func (s *Sniffer) readCommand(data []byte) (*GameCommand, error) {
 		}
 		seed := playerGetTokenScRsp.SecretKeySeed
 
-		keyBytes := KeyFromSeed(seed)
-		s.key = &Key{_bytes: keyBytes}
-		logger.Info().Uint64("seed", seed).Msg("new session Key was set")
+		s.SetSessionSeed(seed)
+		s.storeSeed(SessionSeed{
+			Conv: s.sessionConv(),
+			Uid:  playerGetTokenScRsp.Uid,
+			Seed: seed,
+			Time: timestamp,
+		})
 	}
 
 	return command, nil
@@ -0,0 +1,172 @@
+package reliquary_test
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"github.com/Fesaa/go-reliquary"
+	"github.com/Fesaa/go-reliquary/reliquarytest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files in testdata")
+
+const goldenSeed = 0x0123456789ABCDEF
+
+// goldenSessions are read end-to-end, the result of every packet is compared with testdata/golden/<name>.golden.
+// Impairments are only applied to commands with raw data, the size of marshalled messages differs between game versions
+var goldenSessions = []struct {
+	name   string
+	script func(s *reliquarytest.Session)
+}{
+	{"handshake", func(s *reliquarytest.Session) {
+		s.Handshake().Disconnect(5)
+	}},
+	{"login", func(s *reliquarytest.Session) {
+		s.Handshake().Login(goldenSeed).
+			FromClientData(reliquary.GetBagCsReq, nil).
+			FromServerData(reliquary.GetBagScRsp, payload(100)).
+			FromClientData(reliquary.PlayerHeartBeatCsReq, payload(8)).
+			FromServerData(reliquary.PlayerHeartBeatScRsp, payload(8)).
+			Disconnect(5)
+	}},
+	{"large", func(s *reliquarytest.Session) {
+		s.Handshake().Login(goldenSeed).
+			FromClientData(reliquary.GetBagCsReq, payload(3000)).
+			FromServerData(reliquary.GetBagScRsp, payload(4000))
+	}},
+	{"reordered", func(s *reliquarytest.Session) {
+		s.Handshake().Login(goldenSeed).
+			FromServerData(reliquary.GetBagScRsp, payload(4000), reliquarytest.Reordered()).
+			FromClientData(reliquary.GetBagCsReq, payload(3000), reliquarytest.Reordered())
+	}},
+	{"duplicated", func(s *reliquarytest.Session) {
+		s.Handshake().Login(goldenSeed, reliquarytest.Duplicated()).
+			FromClientData(reliquary.GetBagCsReq, nil, reliquarytest.Duplicated()).
+			FromServerData(reliquary.GetBagScRsp, payload(4000), reliquarytest.Duplicated())
+	}},
+	{"lost", func(s *reliquarytest.Session) {
+		s.Handshake().Login(goldenSeed).
+			FromServerData(reliquary.GetBagScRsp, payload(4000), reliquarytest.Lost(0)).
+			FromClientData(reliquary.GetBagCsReq, payload(3000), reliquarytest.Lost(1))
+	}},
+	{"fragmented", func(s *reliquarytest.Session) {
+		s.Handshake().Login(goldenSeed).
+			FromServerData(reliquary.GetBagScRsp, payload(4000), reliquarytest.Fragmented(500)).
+			FromClientData(reliquary.GetBagCsReq, payload(1000), reliquarytest.Fragmented(500))
+	}},
+	{"impaired", func(s *reliquarytest.Session) {
+		s.Handshake().Login(goldenSeed).
+			FromServerData(reliquary.GetBagScRsp, payload(4000),
+				reliquarytest.Reordered(), reliquarytest.Lost(1), reliquarytest.Duplicated(), reliquarytest.Fragmented(600))
+	}},
+}
+
+func payload(n int) []byte {
+	data := make([]byte, n)
+	for i := range data {
+		data[i] = byte(i)
+	}
+	return data
+}
+
+func TestSnifferGolden(t *testing.T) {
+	for _, tt := range goldenSessions {
+		t.Run(tt.name, func(t *testing.T) {
+			session := reliquarytest.NewSession()
+			tt.script(session)
+			packets, err := session.Packets()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			sniffer := &reliquary.Sniffer{}
+			var out strings.Builder
+			got := make(map[reliquary.Direction][]reliquary.GameCommand)
+			for i, p := range packets {
+				gp, err := sniffer.ReadPacket(p)
+				if err != nil {
+					t.Fatalf("packet %d: %v", i, err)
+				}
+				fmt.Fprintf(&out, "%d: %s\n", i, describe(gp))
+
+				if cp, ok := gp.(*reliquary.CommandsPacket); ok {
+					got[cp.Direction] = append(got[cp.Direction], cp.Commands...)
+					captured := p.Metadata().Timestamp
+					if !cp.Timestamp.Equal(captured) || !cp.Commands[len(cp.Commands)-1].Timestamp.Equal(captured) {
+						t.Errorf("packet %d: stamped %v, captured %v", i, cp.Timestamp, captured)
+					}
+				}
+			}
+
+			want := make(map[reliquary.Direction][]reliquarytest.Command)
+			for _, command := range session.Commands() {
+				want[command.Direction()] = append(want[command.Direction()], command)
+			}
+			for _, direction := range []reliquary.Direction{reliquary.Received, reliquary.Send} {
+				compareCommands(t, direction, got[direction], want[direction])
+			}
+
+			compareGolden(t, filepath.Join("testdata", "golden", tt.name+".golden"), out.String())
+		})
+	}
+}
+
+func describe(gp reliquary.GamePacket) string {
+	switch p := gp.(type) {
+	case *reliquary.ConnectionPacket:
+		return fmt.Sprintf("%s %s conv=%d", p.Type, p.Direction, p.Handshake.Conv)
+	case *reliquary.CommandsPacket:
+		names := make([]string, 0, len(p.Commands))
+		for _, command := range p.Commands {
+			names = append(names, fmt.Sprintf("%s(%s)", command.Name, command.KeySource))
+		}
+		return fmt.Sprintf("Commands %s %s", p.Direction, strings.Join(names, " "))
+	case *reliquary.ContinuePacket:
+		return "Continue"
+	}
+	return fmt.Sprintf("%T", gp)
+}
+
+func compareCommands(t *testing.T, direction reliquary.Direction, got []reliquary.GameCommand, want []reliquarytest.Command) {
+	t.Helper()
+
+	if len(got) != len(want) {
+		t.Fatalf("%s: read %d commands, want %d", direction, len(got), len(want))
+	}
+	for i := range want {
+		switch {
+		case got[i].Id != want[i].Id:
+			t.Errorf("%s command %d: id %d, want %d", direction, i, got[i].Id, want[i].Id)
+		case got[i].KeySource != want[i].KeySource:
+			t.Errorf("%s command %d: decrypted with %s, want %s", direction, i, got[i].KeySource, want[i].KeySource)
+		case !bytes.Equal(got[i].ProtoData, want[i].ProtoData):
+			t.Errorf("%s command %d: data differs", direction, i)
+		}
+	}
+}
+
+func compareGolden(t *testing.T, path string, got string) {
+	t.Helper()
+
+	if *update {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(got), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("%v, run the tests with -update to create it", err)
+	}
+	if got != string(want) {
+		t.Errorf("%s differs, run the tests with -update to see the changes\ngot:\n%s\nwant:\n%s", path, got, want)
+	}
+}
@@ -0,0 +1,112 @@
+// Package state keeps a model of the player's account up to date from the commands a reliquary.Sniffer reads.
+//
+//	account := state.NewAccount()
+//	for packet := range capture.Packets {
+//		_ = account.Apply(packet.Commands...)
+//	}
+//	relics := account.Snapshot().Relics()
+package state
+
+import (
+	"github.com/Fesaa/go-reliquary"
+	"github.com/Fesaa/go-reliquary/internal/dispatch"
+	"github.com/Fesaa/go-reliquary/pb"
+	"maps"
+	"sync"
+)
+
+// handlers maps command ids to the change they make, commands without an entry are never unmarshalled
+var handlers = dispatch.Handlers[*Account]{
+	reliquary.PlayerGetTokenScRsp: dispatch.Do((*Account).applyLogin),
+
+	reliquary.GetBagScRsp:           dispatch.Do((*Account).applyBag),
+	reliquary.PlayerSyncScNotify:    dispatch.Do((*Account).applySync),
+	reliquary.AddEquipmentScNotify:  dispatch.Do((*Account).applyAddEquipment),
+	reliquary.DiscardRelicScRsp:     dispatch.Do((*Account).applyDiscardRelic),
+	reliquary.LockRelicScRsp:        dispatch.Do((*Account).applyLockRelic),
+	reliquary.LockEquipmentScRsp:    dispatch.Do((*Account).applyLockEquipment),
+	reliquary.ExpUpRelicScRsp:       dispatch.Do((*Account).applyExpUpRelic),
+	reliquary.ExpUpEquipmentScRsp:   dispatch.Do((*Account).applyExpUpEquipment),
+	reliquary.RankUpEquipmentScRsp:  dispatch.Do((*Account).applyRankUpEquipment),
+	reliquary.PromoteEquipmentScRsp: dispatch.Do((*Account).applyPromoteEquipment),
+	reliquary.SellItemScRsp:         dispatch.Do((*Account).applySellItem),
+
+	reliquary.GetAvatarDataScRsp:         dispatch.Do((*Account).applyAvatarData),
+	reliquary.AddAvatarScNotify:          dispatch.Do((*Account).applyAddAvatar),
+	reliquary.AddMultiPathAvatarScNotify: dispatch.Do((*Account).applyAddMultiPathAvatar),
+	reliquary.AvatarPathChangedNotify:    dispatch.Do((*Account).applyPathChanged),
+	reliquary.UnlockSkilltreeScRsp:       dispatch.Do((*Account).applyUnlockSkillTree),
+	reliquary.DressRelicAvatarScRsp:      dispatch.Do((*Account).applyDressRelic),
+	reliquary.TakeOffRelicScRsp:          dispatch.Do((*Account).applyTakeOffRelic),
+
+	// Some responses only carry a retcode, the change is in the request
+	reliquary.LockRelicCsReq:        dispatch.Remember(reliquary.LockRelicCsReq, requests),
+	reliquary.LockEquipmentCsReq:    dispatch.Remember(reliquary.LockEquipmentCsReq, requests),
+	reliquary.ExpUpRelicCsReq:       dispatch.Remember(reliquary.ExpUpRelicCsReq, requests),
+	reliquary.ExpUpEquipmentCsReq:   dispatch.Remember(reliquary.ExpUpEquipmentCsReq, requests),
+	reliquary.RankUpEquipmentCsReq:  dispatch.Remember(reliquary.RankUpEquipmentCsReq, requests),
+	reliquary.PromoteEquipmentCsReq: dispatch.Remember(reliquary.PromoteEquipmentCsReq, requests),
+	reliquary.SellItemCsReq:         dispatch.Remember(reliquary.SellItemCsReq, requests),
+	reliquary.DressRelicAvatarCsReq: dispatch.Remember(reliquary.DressRelicAvatarCsReq, requests),
+	reliquary.TakeOffRelicCsReq:     dispatch.Remember(reliquary.TakeOffRelicCsReq, requests),
+}
+
+// Account is the state of a single account, safe for concurrent use
+type Account struct {
+	mu sync.RWMutex
+
+	uid       uint32
+	inventory inventory
+	roster    roster
+	// requests holds the last request of every type, until its response is applied
+	requests dispatch.Requests
+}
+
+func NewAccount() *Account {
+	return &Account{
+		inventory: newInventory(),
+		roster:    newRoster(),
+		requests:  make(dispatch.Requests),
+	}
+}
+
+// Apply updates the account with the given commands, in order. Commands that don't change the account are ignored.
+// The first command that fails to unmarshal is returned, the commands after it are still applied
+func (a *Account) Apply(commands ...reliquary.GameCommand) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return handlers.Apply(a, commands)
+}
+
+func (a *Account) applyLogin(rsp *pb.PlayerGetTokenScRsp) {
+	if rsp.GetRetcode() == 0 {
+		a.uid = rsp.GetUid()
+	}
+}
+
+func (a *Account) applySync(notify *pb.PlayerSyncScNotify) {
+	a.syncInventory(notify)
+	a.roster.putAvatars(notify.GetAvatarSync().GetAvatarList())
+}
+
+func requests(a *Account) dispatch.Requests {
+	return a.requests
+}
+
+// Snapshot returns a copy of the current state, later commands don't change it
+func (a *Account) Snapshot() Snapshot {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	return Snapshot{
+		Uid:           a.uid,
+		Seeded:        a.inventory.seeded,
+		AvatarsSeeded: a.roster.seeded,
+		relics:        maps.Clone(a.inventory.relics),
+		lightCones:    maps.Clone(a.inventory.lightCones),
+		materials:     maps.Clone(a.inventory.materials),
+		avatars:       maps.Clone(a.roster.avatars),
+		currentPaths:  maps.Clone(a.roster.current),
+	}
+}
@@ -0,0 +1,244 @@
+package state
+
+import (
+	"github.com/Fesaa/go-reliquary"
+	"github.com/Fesaa/go-reliquary/gamedata"
+	"github.com/Fesaa/go-reliquary/internal/dispatch"
+	"github.com/Fesaa/go-reliquary/pb"
+)
+
+type Relic struct {
+	UniqueId    uint32
+	Id          uint32
+	Level       uint32
+	Exp         uint32
+	MainAffixId uint32
+	SubAffixes  []SubAffix
+	Locked      bool
+	Discarded   bool
+	// EquippedAvatarId is 0 when the relic isn't equipped
+	EquippedAvatarId uint32
+}
+
+type SubAffix struct {
+	Id uint32
+	// Count is the number of rolls, 1 for the initial roll
+	Count uint32
+	// Step is the sum of the roll tiers, each roll adds 0, 1 or 2
+	Step uint32
+}
+
+// RolledAffixes returns the sub affixes for gamedata.Resolver.RelicRolls
+func (r Relic) RolledAffixes() []gamedata.RolledAffix {
+	affixes := make([]gamedata.RolledAffix, 0, len(r.SubAffixes))
+	for _, sub := range r.SubAffixes {
+		affixes = append(affixes, gamedata.RolledAffix{AffixId: sub.Id, Count: sub.Count, Step: sub.Step})
+	}
+	return affixes
+}
+
+type LightCone struct {
+	UniqueId uint32
+	Id       uint32
+	Level    uint32
+	Exp      uint32
+	// Rank is the superimposition, starting at 1
+	Rank      uint32
+	Promotion uint32
+	Locked    bool
+	// EquippedAvatarId is 0 when the light cone isn't equipped
+	EquippedAvatarId uint32
+}
+
+type Material struct {
+	Id    uint32
+	Count uint32
+}
+
+type inventory struct {
+	// seeded is set by GetBagScRsp, before that only the items changed during the session are known
+	seeded     bool
+	relics     map[uint32]Relic
+	lightCones map[uint32]LightCone
+	materials  map[uint32]uint32
+}
+
+func newInventory() inventory {
+	return inventory{
+		relics:     make(map[uint32]Relic),
+		lightCones: make(map[uint32]LightCone),
+		materials:  make(map[uint32]uint32),
+	}
+}
+
+func relicFromProto(r *pb.Relic) Relic {
+	subAffixes := make([]SubAffix, 0, len(r.GetSubAffixList()))
+	for _, affix := range r.GetSubAffixList() {
+		subAffixes = append(subAffixes, SubAffix{
+			Id:    affix.GetAffixID(),
+			Count: affix.GetCnt(),
+			Step:  affix.GetStep(),
+		})
+	}
+
+	return Relic{
+		UniqueId:         r.GetUniqueID(),
+		Id:               r.GetID(),
+		Level:            r.GetLevel(),
+		Exp:              r.GetExp(),
+		MainAffixId:      r.GetMainAffixID(),
+		SubAffixes:       subAffixes,
+		Locked:           r.GetLocked(),
+		Discarded:        r.GetDiscard(),
+		EquippedAvatarId: r.GetEquippedAvatarID(),
+	}
+}
+
+func lightConeFromProto(lc *pb.LightCone) LightCone {
+	return LightCone{
+		UniqueId:         lc.GetUniqueID(),
+		Id:               lc.GetID(),
+		Level:            lc.GetLevel(),
+		Exp:              lc.GetExp(),
+		Rank:             lc.GetRank(),
+		Promotion:        lc.GetPromotion(),
+		Locked:           lc.GetLocked(),
+		EquippedAvatarId: lc.GetEquippedAvatarID(),
+	}
+}
+
+func (inv *inventory) putRelics(relics []*pb.Relic) {
+	for _, r := range relics {
+		inv.relics[r.GetUniqueID()] = relicFromProto(r)
+	}
+}
+
+func (inv *inventory) putLightCones(lightCones []*pb.LightCone) {
+	for _, lc := range lightCones {
+		inv.lightCones[lc.GetUniqueID()] = lightConeFromProto(lc)
+	}
+}
+
+// putMaterials sets the counts, the game always sends the new total
+func (inv *inventory) putMaterials(materials []*pb.Material) {
+	for _, m := range materials {
+		if m.GetNum() == 0 {
+			delete(inv.materials, m.GetTid())
+			continue
+		}
+		inv.materials[m.GetTid()] = m.GetNum()
+	}
+}
+
+// consume removes the relics and light cones spent as cost. Materials aren't subtracted,
+// PlayerSyncScNotify sends their new totals, usually before the response
+func (inv *inventory) consume(cost *pb.ItemCostData) {
+	for _, item := range cost.GetItemList() {
+		if id := item.GetRelicUniqueId(); id != 0 {
+			delete(inv.relics, id)
+		}
+		if id := item.GetEquipmentUniqueId(); id != 0 {
+			delete(inv.lightCones, id)
+		}
+	}
+}
+
+// applyBag replaces the whole inventory
+func (a *Account) applyBag(rsp *pb.GetBagScRsp) {
+	if rsp.GetRetcode() != 0 {
+		return
+	}
+
+	a.inventory = newInventory()
+	a.inventory.seeded = true
+	a.inventory.putRelics(rsp.GetRelicList())
+	a.inventory.putLightCones(rsp.GetLightConeList())
+	a.inventory.putMaterials(rsp.GetMaterialList())
+}
+
+func (a *Account) syncInventory(notify *pb.PlayerSyncScNotify) {
+	a.inventory.putRelics(notify.GetRelicList())
+	a.inventory.putLightCones(notify.GetLightConeList())
+	a.inventory.putMaterials(notify.GetMaterialList())
+
+	for _, id := range notify.GetDelRelicList() {
+		delete(a.inventory.relics, id)
+	}
+	for _, id := range notify.GetDelEquipmentList() {
+		delete(a.inventory.lightCones, id)
+	}
+}
+
+func (a *Account) applyAddEquipment(notify *pb.AddEquipmentScNotify) {
+	a.inventory.putLightCones(notify.GetLightConeList())
+}
+
+func (a *Account) applyDiscardRelic(rsp *pb.DiscardRelicScRsp) {
+	if rsp.GetRetcode() != 0 {
+		return
+	}
+	for _, id := range rsp.GetRelicUniqueIdList() {
+		if relic, ok := a.inventory.relics[id]; ok {
+			relic.Discarded = rsp.GetDiscard()
+			a.inventory.relics[id] = relic
+		}
+	}
+}
+
+func (a *Account) applyLockRelic(rsp *pb.LockRelicScRsp) {
+	req, ok := dispatch.Take[*pb.LockRelicCsReq](a.requests, reliquary.LockRelicCsReq)
+	if !ok || rsp.GetRetcode() != 0 {
+		return
+	}
+	for _, id := range req.GetRelicUniqueIdList() {
+		if relic, ok := a.inventory.relics[id]; ok {
+			relic.Locked = req.GetLocked()
+			a.inventory.relics[id] = relic
+		}
+	}
+}
+
+func (a *Account) applyLockEquipment(rsp *pb.LockEquipmentScRsp) {
+	req, ok := dispatch.Take[*pb.LockEquipmentCsReq](a.requests, reliquary.LockEquipmentCsReq)
+	if !ok || rsp.GetRetcode() != 0 {
+		return
+	}
+	for _, id := range req.GetEquipmentUniqueIdList() {
+		if lightCone, ok := a.inventory.lightCones[id]; ok {
+			lightCone.Locked = req.GetLocked()
+			a.inventory.lightCones[id] = lightCone
+		}
+	}
+}
+
+// The upgraded item itself is updated by PlayerSyncScNotify, the responses only confirm the fodder is gone
+
+func (a *Account) applyExpUpRelic(rsp *pb.ExpUpRelicScRsp) {
+	if req, ok := dispatch.Take[*pb.ExpUpRelicCsReq](a.requests, reliquary.ExpUpRelicCsReq); ok && rsp.GetRetcode() == 0 {
+		a.inventory.consume(req.GetCostData())
+	}
+}
+
+func (a *Account) applyExpUpEquipment(rsp *pb.ExpUpEquipmentScRsp) {
+	if req, ok := dispatch.Take[*pb.ExpUpEquipmentCsReq](a.requests, reliquary.ExpUpEquipmentCsReq); ok && rsp.GetRetcode() == 0 {
+		a.inventory.consume(req.GetCostData())
+	}
+}
+
+func (a *Account) applyRankUpEquipment(rsp *pb.RankUpEquipmentScRsp) {
+	if req, ok := dispatch.Take[*pb.RankUpEquipmentCsReq](a.requests, reliquary.RankUpEquipmentCsReq); ok && rsp.GetRetcode() == 0 {
+		a.inventory.consume(req.GetCostData())
+	}
+}
+
+func (a *Account) applyPromoteEquipment(rsp *pb.PromoteEquipmentScRsp) {
+	if req, ok := dispatch.Take[*pb.PromoteEquipmentCsReq](a.requests, reliquary.PromoteEquipmentCsReq); ok && rsp.GetRetcode() == 0 {
+		a.inventory.consume(req.GetCostData())
+	}
+}
+
+func (a *Account) applySellItem(rsp *pb.SellItemScRsp) {
+	if req, ok := dispatch.Take[*pb.SellItemCsReq](a.requests, reliquary.SellItemCsReq); ok && rsp.GetRetcode() == 0 {
+		a.inventory.consume(req.GetCostData())
+	}
+}
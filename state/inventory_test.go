@@ -0,0 +1,183 @@
+package state_test
+
+import (
+	"github.com/Fesaa/go-reliquary"
+	"github.com/Fesaa/go-reliquary/pb"
+	"github.com/Fesaa/go-reliquary/reliquarytest"
+	"github.com/Fesaa/go-reliquary/state"
+	"slices"
+	"testing"
+)
+
+func apply(t *testing.T, account *state.Account, s *reliquarytest.Session) {
+	t.Helper()
+
+	if err := s.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if err := account.Apply(s.GameCommands()...); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func bag(s *reliquarytest.Session, relics []uint32, lightCones []uint32, materials ...*pb.Material) {
+	rsp := &pb.GetBagScRsp{MaterialList: materials}
+	for _, id := range relics {
+		rsp.RelicList = append(rsp.RelicList, &pb.Relic{UniqueID: id, ID: 61011})
+	}
+	for _, id := range lightCones {
+		rsp.LightConeList = append(rsp.LightConeList, &pb.LightCone{UniqueID: id, ID: 20000})
+	}
+	s.FromServer(reliquary.GetBagScRsp, rsp)
+}
+
+// cost spends relics and light cones, like fodder or sold items
+func cost(relics []uint32, lightCones []uint32) *pb.ItemCostData {
+	data := &pb.ItemCostData{}
+	for _, id := range relics {
+		data.ItemList = append(data.ItemList, &pb.ItemCost{RelicUniqueId: id})
+	}
+	for _, id := range lightCones {
+		data.ItemList = append(data.ItemList, &pb.ItemCost{EquipmentUniqueId: id})
+	}
+	return data
+}
+
+func TestAccountInventory(t *testing.T) {
+	tests := []struct {
+		name       string
+		script     func(s *reliquarytest.Session)
+		seeded     bool
+		relics     []uint32
+		lightCones []uint32
+		materials  []state.Material
+	}{
+		{"bag", func(s *reliquarytest.Session) {
+			bag(s, []uint32{1, 2}, []uint32{10}, &pb.Material{Tid: 1, Num: 5}, &pb.Material{Tid: 2})
+		}, true, []uint32{1, 2}, []uint32{10}, []state.Material{{Id: 1, Count: 5}}},
+		{"sync before bag", func(s *reliquarytest.Session) {
+			s.FromServer(reliquary.PlayerSyncScNotify, &pb.PlayerSyncScNotify{RelicList: []*pb.Relic{{UniqueID: 3}}})
+		}, false, []uint32{3}, nil, nil},
+		{"bag replaces", func(s *reliquarytest.Session) {
+			s.FromServer(reliquary.PlayerSyncScNotify, &pb.PlayerSyncScNotify{RelicList: []*pb.Relic{{UniqueID: 3}}})
+			bag(s, []uint32{1}, nil)
+		}, true, []uint32{1}, nil, nil},
+		{"sync adds and deletes", func(s *reliquarytest.Session) {
+			bag(s, []uint32{1, 2}, []uint32{10}, &pb.Material{Tid: 1, Num: 5})
+			s.FromServer(reliquary.PlayerSyncScNotify, &pb.PlayerSyncScNotify{
+				RelicList:        []*pb.Relic{{UniqueID: 4}},
+				MaterialList:     []*pb.Material{{Tid: 1}, {Tid: 2, Num: 3}},
+				DelRelicList:     []uint32{1},
+				DelEquipmentList: []uint32{10},
+			})
+		}, true, []uint32{2, 4}, nil, []state.Material{{Id: 2, Count: 3}}},
+		{"added light cone", func(s *reliquarytest.Session) {
+			bag(s, nil, []uint32{10})
+			s.FromServer(reliquary.AddEquipmentScNotify, &pb.AddEquipmentScNotify{LightConeList: []*pb.LightCone{{UniqueID: 11}}})
+		}, true, nil, []uint32{10, 11}, nil},
+		{"exp up consumes fodder", func(s *reliquarytest.Session) {
+			bag(s, []uint32{1, 2, 3}, nil)
+			s.FromClient(reliquary.ExpUpRelicCsReq, &pb.ExpUpRelicCsReq{RelicUniqueId: 1, CostData: cost([]uint32{2}, nil)})
+			s.FromServer(reliquary.ExpUpRelicScRsp, &pb.ExpUpRelicScRsp{})
+		}, true, []uint32{1, 3}, nil, nil},
+		{"failed exp up", func(s *reliquarytest.Session) {
+			bag(s, []uint32{1, 2, 3}, nil)
+			s.FromClient(reliquary.ExpUpRelicCsReq, &pb.ExpUpRelicCsReq{RelicUniqueId: 1, CostData: cost([]uint32{2}, nil)})
+			s.FromServer(reliquary.ExpUpRelicScRsp, &pb.ExpUpRelicScRsp{Retcode: 1})
+		}, true, []uint32{1, 2, 3}, nil, nil},
+		{"response without request", func(s *reliquarytest.Session) {
+			bag(s, []uint32{1, 2, 3}, nil)
+			s.FromServer(reliquary.ExpUpRelicScRsp, &pb.ExpUpRelicScRsp{})
+		}, true, []uint32{1, 2, 3}, nil, nil},
+		{"request is used once", func(s *reliquarytest.Session) {
+			bag(s, []uint32{1, 2, 3}, nil)
+			s.FromClient(reliquary.ExpUpRelicCsReq, &pb.ExpUpRelicCsReq{RelicUniqueId: 1, CostData: cost([]uint32{2}, nil)})
+			s.FromServer(reliquary.ExpUpRelicScRsp, &pb.ExpUpRelicScRsp{})
+			bag(s, []uint32{1, 2, 3}, nil)
+			s.FromServer(reliquary.ExpUpRelicScRsp, &pb.ExpUpRelicScRsp{})
+		}, true, []uint32{1, 2, 3}, nil, nil},
+		{"sell", func(s *reliquarytest.Session) {
+			bag(s, []uint32{1, 2}, []uint32{10, 11})
+			s.FromClient(reliquary.SellItemCsReq, &pb.SellItemCsReq{CostData: cost([]uint32{1}, []uint32{11})})
+			s.FromServer(reliquary.SellItemScRsp, &pb.SellItemScRsp{})
+		}, true, []uint32{2}, []uint32{10}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			account := state.NewAccount()
+			session := reliquarytest.NewSession()
+			tt.script(session)
+			apply(t, account, session)
+
+			snapshot := account.Snapshot()
+			if snapshot.Seeded != tt.seeded {
+				t.Errorf("seeded %t, want %t", snapshot.Seeded, tt.seeded)
+			}
+			var relics, lightCones []uint32
+			for _, relic := range snapshot.Relics() {
+				relics = append(relics, relic.UniqueId)
+			}
+			for _, lightCone := range snapshot.LightCones() {
+				lightCones = append(lightCones, lightCone.UniqueId)
+			}
+			if !slices.Equal(relics, tt.relics) {
+				t.Errorf("relics %v, want %v", relics, tt.relics)
+			}
+			if !slices.Equal(lightCones, tt.lightCones) {
+				t.Errorf("light cones %v, want %v", lightCones, tt.lightCones)
+			}
+			if materials := snapshot.Materials(); !slices.Equal(materials, tt.materials) {
+				t.Errorf("materials %v, want %v", materials, tt.materials)
+			}
+		})
+	}
+}
+
+func TestAccountRelic(t *testing.T) {
+	account := state.NewAccount()
+	session := reliquarytest.NewSession()
+	session.Login(1)
+	session.FromServer(reliquary.GetBagScRsp, &pb.GetBagScRsp{RelicList: []*pb.Relic{{
+		UniqueID:         1,
+		ID:               61011,
+		Level:            12,
+		MainAffixID:      1,
+		SubAffixList:     []*pb.SubAffix{{AffixID: 4, Cnt: 2, Step: 3}, {AffixID: 9, Cnt: 1}},
+		EquippedAvatarID: 1102,
+	}}})
+	apply(t, account, session)
+	before := account.Snapshot()
+
+	changes := reliquarytest.NewSession()
+	changes.FromClient(reliquary.LockRelicCsReq, &pb.LockRelicCsReq{RelicUniqueIdList: []uint32{1}, Locked: true})
+	changes.FromServer(reliquary.LockRelicScRsp, &pb.LockRelicScRsp{})
+	changes.FromServer(reliquary.DiscardRelicScRsp, &pb.DiscardRelicScRsp{RelicUniqueIdList: []uint32{1, 2}, Discard: true})
+	apply(t, account, changes)
+	after := account.Snapshot()
+
+	if after.Uid != session.Uid {
+		t.Errorf("uid %d, want %d", after.Uid, session.Uid)
+	}
+	relic, ok := after.Relic(1)
+	if !ok {
+		t.Fatal("relic 1 is gone")
+	}
+	want := []state.SubAffix{{Id: 4, Count: 2, Step: 3}, {Id: 9, Count: 1}}
+	if relic.Id != 61011 || relic.Level != 12 || relic.MainAffixId != 1 || !slices.Equal(relic.SubAffixes, want) {
+		t.Errorf("relic %+v", relic)
+	}
+	if !relic.Locked || !relic.Discarded {
+		t.Errorf("locked %t, discarded %t", relic.Locked, relic.Discarded)
+	}
+	if _, ok = after.Relic(2); ok {
+		t.Error("discarding an unknown relic added it")
+	}
+	if relics, _ := after.Equipment(1102); len(relics) != 1 || relics[0].UniqueId != 1 {
+		t.Errorf("equipped relics %+v", relics)
+	}
+
+	if relic, _ = before.Relic(1); relic.Locked || relic.Discarded {
+		t.Error("a later command changed the earlier snapshot")
+	}
+}
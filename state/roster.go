@@ -0,0 +1,263 @@
+package state
+
+import (
+	"github.com/Fesaa/go-reliquary"
+	"github.com/Fesaa/go-reliquary/internal/dispatch"
+	"github.com/Fesaa/go-reliquary/pb"
+	"maps"
+)
+
+const RELIC_SLOTS = 6
+
+// multiPathBases maps the paths of multi-path avatars to the avatar their level is shared with
+var multiPathBases = map[uint32]uint32{
+	8001: 8001, 8002: 8001, 8003: 8001, 8004: 8001,
+	8005: 8001, 8006: 8001, 8007: 8001, 8008: 8001,
+	1001: 1001, 1224: 1001,
+}
+
+// baseAvatarId returns the avatar id a path shares its level with, id itself for single path avatars
+func baseAvatarId(id uint32) uint32 {
+	if base, ok := multiPathBases[id]; ok {
+		return base
+	}
+	return id
+}
+
+func isMultiPath(baseId uint32) bool {
+	_, ok := multiPathBases[baseId]
+	return ok
+}
+
+// Avatar is a character, multi-path characters have an Avatar for every path they unlocked
+type Avatar struct {
+	Id uint32
+	// BaseId is the avatar the level is shared with, 8001 for every Trailblazer path. Equal to Id for single path avatars
+	BaseId    uint32
+	Level     uint32
+	Exp       uint32
+	Promotion uint32
+	// Rank is the number of eidolons
+	Rank uint32
+	// Traces maps skill tree point ids to their level
+	Traces map[uint32]uint32
+	// LightCone is the unique id of the equipped light cone, 0 if none
+	LightCone uint32
+	// Relics holds the unique id of the relic in every slot, 0 if empty. Slot 1 is at index 0
+	Relics [RELIC_SLOTS]uint32
+}
+
+// progress is shared between the paths of an avatar
+type progress struct {
+	level     uint32
+	exp       uint32
+	promotion uint32
+}
+
+type roster struct {
+	// seeded is set by a complete GetAvatarDataScRsp
+	seeded  bool
+	avatars map[uint32]Avatar
+	bases   map[uint32]progress
+	// current maps base ids of multi-path avatars to the path in use
+	current map[uint32]uint32
+}
+
+func newRoster() roster {
+	return roster{
+		avatars: make(map[uint32]Avatar),
+		bases:   make(map[uint32]progress),
+		current: make(map[uint32]uint32),
+	}
+}
+
+// update stores a changed copy of an avatar, Traces is cloned so snapshots keep their copy
+func (r *roster) update(id uint32, change func(avatar *Avatar)) {
+	avatar, ok := r.avatars[id]
+	if !ok {
+		return
+	}
+	avatar.Traces = maps.Clone(avatar.Traces)
+	change(&avatar)
+	r.avatars[id] = avatar
+}
+
+func (r *roster) setProgress(baseId uint32, p progress) {
+	r.bases[baseId] = p
+	for id, avatar := range r.avatars {
+		if avatar.BaseId == baseId {
+			avatar.Level, avatar.Exp, avatar.Promotion = p.level, p.exp, p.promotion
+			r.avatars[id] = avatar
+		}
+	}
+}
+
+func (r *roster) newAvatar(id uint32) Avatar {
+	base := baseAvatarId(id)
+	p := r.bases[base]
+	return Avatar{
+		Id:        id,
+		BaseId:    base,
+		Level:     max(p.level, 1),
+		Exp:       p.exp,
+		Promotion: p.promotion,
+		Traces:    make(map[uint32]uint32),
+	}
+}
+
+func equipment(avatar *Avatar, relics []*pb.EquippedRelic, skillTree []*pb.SkillTreeNode, lightCone uint32) {
+	avatar.LightCone = lightCone
+	for _, relic := range relics {
+		if slot := relic.GetSlot(); slot >= 1 && slot <= RELIC_SLOTS {
+			avatar.Relics[slot-1] = relic.GetRelicUniqueId()
+		}
+	}
+	for _, node := range skillTree {
+		avatar.Traces[node.GetPointId()] = node.GetLevel()
+	}
+}
+
+// putAvatars stores the avatars of AvatarList, for multi-path avatars only their shared progress
+func (r *roster) putAvatars(avatars []*pb.Avatar) {
+	for _, a := range avatars {
+		id := a.GetCharacterId()
+		p := progress{level: a.GetLevel(), exp: a.GetExp(), promotion: a.GetPromotion()}
+		if isMultiPath(id) {
+			r.setProgress(id, p)
+			continue
+		}
+
+		avatar := r.newAvatar(id)
+		avatar.Level, avatar.Exp, avatar.Promotion = p.level, p.exp, p.promotion
+		avatar.Rank = a.GetRank()
+		equipment(&avatar, a.GetEquippedRelicList(), a.GetSkillTreeList(), a.GetEquipmentUniqueId())
+		r.bases[id] = p
+		r.avatars[id] = avatar
+	}
+}
+
+func (r *roster) putPaths(paths []*pb.MultiPathAvatar) {
+	for _, m := range paths {
+		avatar := r.newAvatar(uint32(m.GetAvatarId()))
+		avatar.Rank = m.GetRank()
+		equipment(&avatar, m.GetEquippedRelicList(), m.GetSkillTreeList(), m.GetEquipmentUniqueId())
+		r.avatars[avatar.Id] = avatar
+	}
+}
+
+// dressRelic puts a relic into a slot. Like the game it swaps with the relic's previous owner
+func (a *Account) dressRelic(avatarId uint32, slot uint32, relicId uint32) {
+	if slot < 1 || slot > RELIC_SLOTS {
+		return
+	}
+	idx := slot - 1
+
+	previous := uint32(0)
+	if avatar, ok := a.roster.avatars[avatarId]; ok {
+		previous = avatar.Relics[idx]
+	}
+
+	owner := uint32(0)
+	for id, avatar := range a.roster.avatars {
+		if id != avatarId && avatar.Relics[idx] == relicId {
+			owner = id
+		}
+	}
+	if owner != 0 {
+		a.roster.update(owner, func(avatar *Avatar) { avatar.Relics[idx] = previous })
+		a.setRelicOwner(previous, owner)
+	} else {
+		a.setRelicOwner(previous, 0)
+	}
+
+	a.roster.update(avatarId, func(avatar *Avatar) { avatar.Relics[idx] = relicId })
+	a.setRelicOwner(relicId, avatarId)
+}
+
+func (a *Account) takeOffRelic(avatarId uint32, slot uint32) {
+	if slot < 1 || slot > RELIC_SLOTS {
+		return
+	}
+	if avatar, ok := a.roster.avatars[avatarId]; ok {
+		a.setRelicOwner(avatar.Relics[slot-1], 0)
+	}
+	a.roster.update(avatarId, func(avatar *Avatar) { avatar.Relics[slot-1] = 0 })
+}
+
+// setRelicOwner keeps the inventory in line with the roster
+func (a *Account) setRelicOwner(relicId uint32, avatarId uint32) {
+	if relic, ok := a.inventory.relics[relicId]; ok {
+		relic.EquippedAvatarId = avatarId
+		a.inventory.relics[relicId] = relic
+	}
+}
+
+func (a *Account) applyAvatarData(rsp *pb.GetAvatarDataScRsp) {
+	if rsp.GetRetcode() != 0 {
+		return
+	}
+
+	if rsp.GetIsGetAll() {
+		a.roster = newRoster()
+		a.roster.seeded = true
+	}
+	a.roster.putAvatars(rsp.GetAvatarList())
+	a.roster.putPaths(rsp.GetMultiPathAvatarList())
+	for base, path := range rsp.GetCurrentMultiPathAvatarIds() {
+		a.roster.current[base] = uint32(path)
+	}
+}
+
+func (a *Account) applyAddAvatar(notify *pb.AddAvatarScNotify) {
+	id := notify.GetCharacterId()
+	if isMultiPath(id) {
+		if _, ok := a.roster.bases[id]; !ok {
+			a.roster.bases[id] = progress{level: 1}
+		}
+		return
+	}
+	if _, ok := a.roster.avatars[id]; !ok {
+		a.roster.avatars[id] = a.roster.newAvatar(id)
+	}
+}
+
+func (a *Account) applyAddMultiPathAvatar(notify *pb.AddMultiPathAvatarScNotify) {
+	id := uint32(notify.GetAvatarId())
+	if _, ok := a.roster.avatars[id]; !ok {
+		a.roster.avatars[id] = a.roster.newAvatar(id)
+	}
+}
+
+func (a *Account) applyPathChanged(notify *pb.AvatarPathChangedNotify) {
+	a.roster.current[notify.GetCharacterId()] = uint32(notify.GetCurMultiPathAvatarType())
+}
+
+func (a *Account) applyUnlockSkillTree(rsp *pb.UnlockSkilltreeScRsp) {
+	if rsp.GetRetcode() != 0 {
+		return
+	}
+	// Point ids are the avatar id followed by three digits
+	a.roster.update(rsp.GetPointId()/1000, func(avatar *Avatar) {
+		avatar.Traces[rsp.GetPointId()] = rsp.GetLevel()
+	})
+}
+
+func (a *Account) applyDressRelic(rsp *pb.DressRelicAvatarScRsp) {
+	req, ok := dispatch.Take[*pb.DressRelicAvatarCsReq](a.requests, reliquary.DressRelicAvatarCsReq)
+	if !ok || rsp.GetRetcode() != 0 {
+		return
+	}
+	for _, param := range req.GetSwitchList() {
+		a.dressRelic(req.GetAvatarId(), param.GetSlot(), param.GetRelicUniqueId())
+	}
+}
+
+func (a *Account) applyTakeOffRelic(rsp *pb.TakeOffRelicScRsp) {
+	req, ok := dispatch.Take[*pb.TakeOffRelicCsReq](a.requests, reliquary.TakeOffRelicCsReq)
+	if !ok || rsp.GetRetcode() != 0 {
+		return
+	}
+	for _, slot := range req.GetSlotList() {
+		a.takeOffRelic(req.GetAvatarId(), slot)
+	}
+}
@@ -0,0 +1,185 @@
+package state_test
+
+import (
+	"github.com/Fesaa/go-reliquary"
+	"github.com/Fesaa/go-reliquary/pb"
+	"github.com/Fesaa/go-reliquary/reliquarytest"
+	"github.com/Fesaa/go-reliquary/state"
+	"testing"
+)
+
+// equipped builds an avatar wearing a relic in slot 1
+func equipped(id uint32, relic uint32) *pb.Avatar {
+	avatar := &pb.Avatar{CharacterId: id, Level: 80}
+	if relic != 0 {
+		avatar.EquippedRelicList = []*pb.EquippedRelic{{Slot: 1, RelicUniqueId: relic}}
+	}
+	return avatar
+}
+
+func TestAccountDressRelic(t *testing.T) {
+	tests := []struct {
+		name   string
+		script func(s *reliquarytest.Session)
+		// slots are the relics in slot 1 of avatars 1102, 1005 and 1309
+		slots [3]uint32
+		// owners are the EquippedAvatarId of relics 101, 102 and 103
+		owners [3]uint32
+	}{
+		{"free relic", func(s *reliquarytest.Session) {
+			s.FromClient(reliquary.DressRelicAvatarCsReq, &pb.DressRelicAvatarCsReq{
+				AvatarId:   1102,
+				SwitchList: []*pb.RelicParam{{Slot: 1, RelicUniqueId: 103}},
+			})
+			s.FromServer(reliquary.DressRelicAvatarScRsp, &pb.DressRelicAvatarScRsp{})
+		}, [3]uint32{103, 102, 0}, [3]uint32{0, 1005, 1102}},
+		{"swap with owner", func(s *reliquarytest.Session) {
+			s.FromClient(reliquary.DressRelicAvatarCsReq, &pb.DressRelicAvatarCsReq{
+				AvatarId:   1102,
+				SwitchList: []*pb.RelicParam{{Slot: 1, RelicUniqueId: 102}},
+			})
+			s.FromServer(reliquary.DressRelicAvatarScRsp, &pb.DressRelicAvatarScRsp{})
+		}, [3]uint32{102, 101, 0}, [3]uint32{1005, 1102, 0}},
+		{"empty slot takes from owner", func(s *reliquarytest.Session) {
+			s.FromClient(reliquary.DressRelicAvatarCsReq, &pb.DressRelicAvatarCsReq{
+				AvatarId:   1309,
+				SwitchList: []*pb.RelicParam{{Slot: 1, RelicUniqueId: 102}},
+			})
+			s.FromServer(reliquary.DressRelicAvatarScRsp, &pb.DressRelicAvatarScRsp{})
+		}, [3]uint32{101, 0, 102}, [3]uint32{1102, 1309, 0}},
+		{"failed dress", func(s *reliquarytest.Session) {
+			s.FromClient(reliquary.DressRelicAvatarCsReq, &pb.DressRelicAvatarCsReq{
+				AvatarId:   1102,
+				SwitchList: []*pb.RelicParam{{Slot: 1, RelicUniqueId: 102}},
+			})
+			s.FromServer(reliquary.DressRelicAvatarScRsp, &pb.DressRelicAvatarScRsp{Retcode: 1})
+		}, [3]uint32{101, 102, 0}, [3]uint32{1102, 1005, 0}},
+		{"take off", func(s *reliquarytest.Session) {
+			s.FromClient(reliquary.TakeOffRelicCsReq, &pb.TakeOffRelicCsReq{AvatarId: 1102, SlotList: []uint32{1, 7}})
+			s.FromServer(reliquary.TakeOffRelicScRsp, &pb.TakeOffRelicScRsp{})
+		}, [3]uint32{0, 102, 0}, [3]uint32{0, 1005, 0}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			account := state.NewAccount()
+			session := reliquarytest.NewSession()
+			session.FromServer(reliquary.GetBagScRsp, &pb.GetBagScRsp{RelicList: []*pb.Relic{
+				{UniqueID: 101, EquippedAvatarID: 1102},
+				{UniqueID: 102, EquippedAvatarID: 1005},
+				{UniqueID: 103},
+			}})
+			session.FromServer(reliquary.GetAvatarDataScRsp, &pb.GetAvatarDataScRsp{
+				IsGetAll:   true,
+				AvatarList: []*pb.Avatar{equipped(1102, 101), equipped(1005, 102), equipped(1309, 0)},
+			})
+			tt.script(session)
+			apply(t, account, session)
+
+			snapshot := account.Snapshot()
+			for i, id := range []uint32{1102, 1005, 1309} {
+				avatar, _ := snapshot.Avatar(id)
+				if avatar.Relics[0] != tt.slots[i] {
+					t.Errorf("avatar %d wears %d, want %d", id, avatar.Relics[0], tt.slots[i])
+				}
+			}
+			for i, id := range []uint32{101, 102, 103} {
+				relic, _ := snapshot.Relic(id)
+				if relic.EquippedAvatarId != tt.owners[i] {
+					t.Errorf("relic %d is equipped by %d, want %d", id, relic.EquippedAvatarId, tt.owners[i])
+				}
+			}
+		})
+	}
+}
+
+func TestAccountMultiPath(t *testing.T) {
+	account := state.NewAccount()
+	session := reliquarytest.NewSession()
+	session.FromServer(reliquary.GetAvatarDataScRsp, &pb.GetAvatarDataScRsp{
+		IsGetAll:   true,
+		AvatarList: []*pb.Avatar{{CharacterId: 8001, Level: 70, Promotion: 5}, {CharacterId: 1102, Level: 60}},
+		MultiPathAvatarList: []*pb.MultiPathAvatar{
+			{AvatarId: 8001, Rank: 1},
+			{AvatarId: 8002, Rank: 2, SkillTreeList: []*pb.SkillTreeNode{{PointId: 8002001, Level: 3}}},
+		},
+	})
+	session.FromServer(reliquary.AvatarPathChangedNotify, &pb.AvatarPathChangedNotify{CharacterId: 8001, CurMultiPathAvatarType: 8002})
+	apply(t, account, session)
+	before := account.Snapshot()
+
+	if !before.AvatarsSeeded {
+		t.Error("complete avatar data didn't seed the roster")
+	}
+	if _, ok := before.Avatar(8001); !ok {
+		t.Fatal("path 8001 is missing")
+	}
+	paths := before.Paths(8001)
+	if len(paths) != 2 {
+		t.Fatalf("%d paths, want 2", len(paths))
+	}
+	for _, path := range paths {
+		if path.BaseId != 8001 || path.Level != 70 || path.Promotion != 5 {
+			t.Errorf("path %d: base %d, level %d, promotion %d", path.Id, path.BaseId, path.Level, path.Promotion)
+		}
+	}
+	if current, ok := before.CurrentPath(8001); !ok || current.Id != 8002 || current.Rank != 2 || current.Traces[8002001] != 3 {
+		t.Errorf("current path %+v", current)
+	}
+	if current, ok := before.CurrentPath(1102); !ok || current.Id != 1102 {
+		t.Errorf("current path of a single path avatar %+v", current)
+	}
+
+	// Levelling any path levels all of them, paths unlocked later start at the shared level
+	changes := reliquarytest.NewSession()
+	changes.FromServer(reliquary.PlayerSyncScNotify, &pb.PlayerSyncScNotify{
+		AvatarSync: &pb.AvatarSync{AvatarList: []*pb.Avatar{{CharacterId: 8001, Level: 72, Promotion: 6}}},
+	})
+	changes.FromServer(reliquary.AddMultiPathAvatarScNotify, &pb.AddMultiPathAvatarScNotify{AvatarId: 8005})
+	changes.FromServer(reliquary.AddAvatarScNotify, &pb.AddAvatarScNotify{CharacterId: 1005})
+	apply(t, account, changes)
+	after := account.Snapshot()
+
+	paths = after.Paths(8001)
+	if len(paths) != 3 {
+		t.Fatalf("%d paths, want 3", len(paths))
+	}
+	for _, path := range paths {
+		if path.Level != 72 || path.Promotion != 6 {
+			t.Errorf("path %d: level %d, promotion %d", path.Id, path.Level, path.Promotion)
+		}
+	}
+	if avatar, ok := after.Avatar(1005); !ok || avatar.Level != 1 {
+		t.Errorf("added avatar %+v", avatar)
+	}
+	if path, _ := before.Avatar(8002); path.Level != 70 {
+		t.Error("a later command changed the earlier snapshot")
+	}
+}
+
+func TestSnapshotTraces(t *testing.T) {
+	account := state.NewAccount()
+	session := reliquarytest.NewSession()
+	session.FromServer(reliquary.GetAvatarDataScRsp, &pb.GetAvatarDataScRsp{
+		IsGetAll:   true,
+		AvatarList: []*pb.Avatar{{CharacterId: 1102, SkillTreeList: []*pb.SkillTreeNode{{PointId: 1102001, Level: 1}}}},
+	})
+	apply(t, account, session)
+	before := account.Snapshot()
+
+	changes := reliquarytest.NewSession()
+	changes.FromServer(reliquary.UnlockSkilltreeScRsp, &pb.UnlockSkilltreeScRsp{PointId: 1102001, Level: 2})
+	changes.FromServer(reliquary.UnlockSkilltreeScRsp, &pb.UnlockSkilltreeScRsp{PointId: 1102101, Level: 1})
+	changes.FromServer(reliquary.UnlockSkilltreeScRsp, &pb.UnlockSkilltreeScRsp{Retcode: 1, PointId: 1102002, Level: 1})
+	apply(t, account, changes)
+	after := account.Snapshot()
+
+	old, _ := before.Avatar(1102)
+	if len(old.Traces) != 1 || old.Traces[1102001] != 1 {
+		t.Errorf("earlier snapshot has traces %v", old.Traces)
+	}
+	avatar, _ := after.Avatar(1102)
+	if len(avatar.Traces) != 2 || avatar.Traces[1102001] != 2 || avatar.Traces[1102101] != 1 {
+		t.Errorf("traces %v", avatar.Traces)
+	}
+}
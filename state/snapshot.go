@@ -0,0 +1,113 @@
+package state
+
+import (
+	"cmp"
+	"maps"
+	"slices"
+)
+
+// Snapshot is a consistent copy of an Account at a single point in the session
+type Snapshot struct {
+	// Uid is 0 when the login wasn't captured
+	Uid uint32
+	// Seeded is false until GetBagScRsp was seen, only items changed during the session are known before that
+	Seeded bool
+	// AvatarsSeeded is false until a complete GetAvatarDataScRsp was seen
+	AvatarsSeeded bool
+
+	relics       map[uint32]Relic
+	lightCones   map[uint32]LightCone
+	materials    map[uint32]uint32
+	avatars      map[uint32]Avatar
+	currentPaths map[uint32]uint32
+}
+
+func (s Snapshot) Relic(uniqueId uint32) (Relic, bool) {
+	r, ok := s.relics[uniqueId]
+	return r, ok
+}
+
+// Relics returns every relic ordered by unique id, optionally only those matching all filters
+func (s Snapshot) Relics(filters ...func(Relic) bool) []Relic {
+	return collect(s.relics, filters, func(r Relic) uint32 { return r.UniqueId })
+}
+
+func (s Snapshot) LightCone(uniqueId uint32) (LightCone, bool) {
+	lc, ok := s.lightCones[uniqueId]
+	return lc, ok
+}
+
+// LightCones returns every light cone ordered by unique id, optionally only those matching all filters
+func (s Snapshot) LightCones(filters ...func(LightCone) bool) []LightCone {
+	return collect(s.lightCones, filters, func(lc LightCone) uint32 { return lc.UniqueId })
+}
+
+// Material returns how many of a material the account has
+func (s Snapshot) Material(id uint32) uint32 {
+	return s.materials[id]
+}
+
+// Materials returns every material the account has at least one of, ordered by id
+func (s Snapshot) Materials() []Material {
+	out := make([]Material, 0, len(s.materials))
+	for _, id := range slices.Sorted(maps.Keys(s.materials)) {
+		out = append(out, Material{Id: id, Count: s.materials[id]})
+	}
+	return out
+}
+
+// Equipment returns the relics and light cone an avatar has equipped
+func (s Snapshot) Equipment(avatarId uint32) ([]Relic, *LightCone) {
+	if avatarId == 0 {
+		return nil, nil
+	}
+	relics := s.Relics(func(r Relic) bool { return r.EquippedAvatarId == avatarId })
+	lightCones := s.LightCones(func(lc LightCone) bool { return lc.EquippedAvatarId == avatarId })
+	if len(lightCones) == 0 {
+		return relics, nil
+	}
+	return relics, &lightCones[0]
+}
+
+// Avatar returns an avatar by id, for multi-path avatars the id of a path
+func (s Snapshot) Avatar(id uint32) (Avatar, bool) {
+	avatar, ok := s.avatars[id]
+	return avatar, ok
+}
+
+// Avatars returns every avatar ordered by id, optionally only those matching all filters.
+// Multi-path avatars are returned once per path
+func (s Snapshot) Avatars(filters ...func(Avatar) bool) []Avatar {
+	return collect(s.avatars, filters, func(a Avatar) uint32 { return a.Id })
+}
+
+// Paths returns every unlocked path of an avatar, a single avatar for single path avatars
+func (s Snapshot) Paths(baseId uint32) []Avatar {
+	return s.Avatars(func(a Avatar) bool { return a.BaseId == baseId })
+}
+
+// CurrentPath returns the path a multi-path avatar currently uses, and the avatar itself for single path avatars
+func (s Snapshot) CurrentPath(baseId uint32) (Avatar, bool) {
+	if path, ok := s.currentPaths[baseId]; ok {
+		return s.Avatar(path)
+	}
+	if isMultiPath(baseId) {
+		return Avatar{}, false
+	}
+	return s.Avatar(baseId)
+}
+
+func collect[T any](m map[uint32]T, filters []func(T) bool, key func(T) uint32) []T {
+	out := make([]T, 0, len(m))
+outer:
+	for _, v := range m {
+		for _, filter := range filters {
+			if !filter(v) {
+				continue outer
+			}
+		}
+		out = append(out, v)
+	}
+	slices.SortFunc(out, func(a, b T) int { return cmp.Compare(key(a), key(b)) })
+	return out
+}
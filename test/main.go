@@ -2,9 +2,11 @@ package main
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"github.com/Fesaa/go-reliquary"
+	"github.com/Fesaa/go-reliquary/rogue"
 	"github.com/google/gopacket"
 	"github.com/google/gopacket/pcap"
 	"github.com/rs/zerolog"
@@ -16,6 +18,7 @@ import (
 	"slices"
 	"strconv"
 	"sync"
+	"time"
 )
 
 var (
@@ -32,33 +35,18 @@ var (
 	}
 	mu sync.Mutex
 
-	logIds = []uint16{
-		reliquary.ChessRogueRollDiceScRsp,
-		reliquary.ChessRogueReRollDiceScRsp,
-		reliquary.ChessRogueRollDiceCsReq,
-		reliquary.ChessRogueReRollDiceCsReq,
-		reliquary.ChessRogueUpdateDiceInfoScNotify,
-		reliquary.ChessRogueCheatRollCsReq,
-		reliquary.ChessRogueCheatRollScRsp,
-		reliquary.ChessRogueConfirmRollCsReq,
-		reliquary.RogueModifierSelectCellCsReq,
-		reliquary.RogueModifierUpdateNotify,
-		reliquary.ChessRogueCellUpdateNotify,
-		reliquary.RogueModifierSelectCellScRsp,
-		reliquary.ChessRogueUpdateMoneyInfoScNotify,
-		reliquary.ChessRogueStartScRsp,
-		reliquary.ChessRogueStartCsReq,
-		reliquary.GetPlayerBoardDataScRsp,
-		reliquary.PlayerLoginCsReq,
-	}
+	// logIds are logged with their message, add them with /add/<id>
+	logIds []uint16
 )
 
-var sniffer *reliquary.Sniffer
+var (
+	sniffer *reliquary.Sniffer
+	runs    *rogue.Logger
+)
 
 func main() {
 	go startHttpServer()
 
-	//handle, err := connect()
 	handle, err := read("./hsr.pcapng")
 	if err != nil {
 		panic(err)
@@ -68,8 +56,14 @@ func main() {
 
 	reliquary.SetLogLevel(zerolog.InfoLevel)
 	sniffer = &reliquary.Sniffer{}
+	runs = rogue.NewLogger(func(run rogue.Run) {
+		slog.Info("rogue run ended", "family", run.Family, "status", run.Status, "events", len(run.Events))
+		if err := run.Save("runs"); err != nil {
+			slog.Error("encountered an error while saving a rogue run", "error", err)
+		}
+	})
 
-	src := gopacket.NewPacketSource(handle, handle.LinkType())
+	src := gopacket.NewPacketSource(handle, reliquary.LinkDecoder(handle.LinkType()))
 	slog.Info("starting sniffer")
 	for packet := range src.Packets() {
 		var p reliquary.GamePacket
@@ -83,6 +77,9 @@ func main() {
 		}
 
 		commandsPacket := p.(*reliquary.CommandsPacket)
+		if err = runs.Apply(commandsPacket.Commands...); err != nil {
+			slog.Error("encountered an error while logging rogue commands", "error", err)
+		}
 		for i, cmd := range commandsPacket.Commands {
 			handleCmd(i, cmd)
 		}
@@ -140,17 +137,10 @@ func read(path string) (*pcap.Handle, error) {
 	return handle, nil
 }
 
-func connect() (*pcap.Handle, error) {
-	live, err := pcap.OpenLive("en0", 65536, true, pcap.BlockForever)
-	if err != nil {
-		return nil, err
-	}
-	err = live.SetBPFFilter(reliquary.PCAP_FILTER)
-	if err != nil {
-		return nil, err
-	}
-
-	return live, nil
+func connect(ctx context.Context) (*reliquary.Capture, error) {
+	return reliquary.Live(ctx, reliquary.LiveOptions{
+		DetectTimeout: time.Minute,
+	})
 }
 
 func startHttpServer() {